@@ -16,3 +16,15 @@ func GetVersion() string {
 	}
 	return version
 }
+
+// GetVersionNumber returns the version of tfplugindocs, without the name and
+// commit, e.g. 0.20.0, or dev for builds which are not released.
+func GetVersionNumber() string {
+	return version
+}
+
+// GetCommit returns the git commit which tfplugindocs was built from, or an
+// empty string if it is not known.
+func GetCommit() string {
+	return commit
+}
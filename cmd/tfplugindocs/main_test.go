@@ -55,6 +55,14 @@ func Test_SchemaJson_MigrateAcceptanceTests(t *testing.T) {
 	})
 }
 
+func Test_SchemaJson_ExtractExamplesAcceptanceTests(t *testing.T) {
+	t.Parallel()
+
+	testscript.Run(t, testscript.Params{
+		Dir: "testdata/scripts/schema-json/extract-examples",
+	})
+}
+
 func Test_SchemaJson_ValidateAcceptanceTests(t *testing.T) {
 	t.Parallel()
 
@@ -62,3 +70,19 @@ func Test_SchemaJson_ValidateAcceptanceTests(t *testing.T) {
 		Dir: "testdata/scripts/schema-json/validate",
 	})
 }
+
+func Test_SchemaJson_SchemaDiffAcceptanceTests(t *testing.T) {
+	t.Parallel()
+
+	testscript.Run(t, testscript.Params{
+		Dir: "testdata/scripts/schema-json/schema-diff",
+	})
+}
+
+func Test_SchemaJson_CoverageAcceptanceTests(t *testing.T) {
+	t.Parallel()
+
+	testscript.Run(t, testscript.Params{
+		Dir: "testdata/scripts/schema-json/coverage",
+	})
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package functionmd renders the signatures and arguments of Terraform
+// provider-defined functions, as exported by the terraform providers schema
+// -json command, as the Markdown of the function pages generated by
+// tfplugindocs, or as plain text, such as for editor hovers.
+package functionmd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+// Options configures how a function signature and its arguments are
+// rendered. A nil *Options renders them with the defaults.
+type Options struct {
+	// TypeSyntax is how the types of the signature and arguments are
+	// written, one of schemamd.TypeSyntaxes. Empty is equivalent to
+	// schemamd.TypeSyntaxDefault, which writes types as words, such as
+	// "list of string" in the signature, and "List of String" in arguments.
+	TypeSyntax string
+
+	// PlainSignature renders the signature as plain text, instead of in a
+	// text code block.
+	PlainSignature bool
+}
+
+func (o *Options) validate() error {
+	if o == nil || o.TypeSyntax == "" {
+		return nil
+	}
+
+	if !slices.Contains(schemamd.TypeSyntaxes, o.TypeSyntax) {
+		return fmt.Errorf("unsupported type syntax %q, expected one of: %s", o.TypeSyntax, strings.Join(schemamd.TypeSyntaxes, ", "))
+	}
+
+	return nil
+}
+
+func (o *Options) terraformTypes() bool {
+	return o != nil && o.TypeSyntax == schemamd.TypeSyntaxTerraform
+}
+
+// writeArgumentType writes the type of an argument in the configured type
+// syntax.
+func (o *Options) writeArgumentType(w io.Writer, ty cty.Type) error {
+	if o.terraformTypes() {
+		return schemamd.WriteTerraformType(w, ty)
+	}
+
+	return schemamd.WriteType(w, ty)
+}
+
+// signatureType returns the type of a parameter or of the return value in the
+// configured type syntax.
+func (o *Options) signatureType(ty cty.Type) (string, error) {
+	if !o.terraformTypes() {
+		return ty.FriendlyName(), nil
+	}
+
+	typeBuffer := bytes.NewBuffer(nil)
+	err := schemamd.WriteTerraformType(typeBuffer, ty)
+	if err != nil {
+		return "", err
+	}
+
+	return typeBuffer.String(), nil
+}
+
+// RenderArguments returns a Markdown formatted string of the function arguments.
+func RenderArguments(signature *tfjson.FunctionSignature, opts *Options) (string, error) {
+	err := opts.validate()
+	if err != nil {
+		return "", err
+	}
+
+	argBuffer := bytes.NewBuffer(nil)
+	for i, p := range signature.Parameters {
+		name := p.Name
+		desc := strings.TrimSpace(p.Description)
+
+		typeBuffer := bytes.NewBuffer(nil)
+		err := opts.writeArgumentType(typeBuffer, p.Type)
+		if err != nil {
+			return "", err
+		}
+
+		if p.IsNullable {
+			argBuffer.WriteString(fmt.Sprintf("1. `%s` (%s, Nullable) %s", name, typeBuffer.String(), desc))
+		} else {
+			argBuffer.WriteString(fmt.Sprintf("1. `%s` (%s) %s", name, typeBuffer.String(), desc))
+		}
+
+		if i != len(signature.Parameters)-1 {
+			argBuffer.WriteString("\n")
+		}
+
+	}
+	return argBuffer.String(), nil
+
+}
+
+// RenderSignature returns a Markdown formatted string of the function signature.
+func RenderSignature(funcName string, signature *tfjson.FunctionSignature, opts *Options) (string, error) {
+	err := opts.validate()
+	if err != nil {
+		return "", err
+	}
+
+	returnType, err := opts.signatureType(signature.ReturnType)
+	if err != nil {
+		return "", err
+	}
+
+	paramBuffer := bytes.NewBuffer(nil)
+	for i, p := range signature.Parameters {
+		if i != 0 {
+			paramBuffer.WriteString(", ")
+		}
+
+		paramType, err := opts.signatureType(p.Type)
+		if err != nil {
+			return "", err
+		}
+
+		paramBuffer.WriteString(fmt.Sprintf("%s %s", p.Name, paramType))
+	}
+
+	if signature.VariadicParameter != nil {
+		if signature.Parameters != nil {
+			paramBuffer.WriteString(", ")
+		}
+
+		paramType, err := opts.signatureType(signature.VariadicParameter.Type)
+		if err != nil {
+			return "", err
+		}
+
+		paramBuffer.WriteString(fmt.Sprintf("%s %s...", signature.VariadicParameter.Name, paramType))
+
+	}
+
+	funcSig := fmt.Sprintf("%s(%s) %s", funcName, paramBuffer.String(), returnType)
+
+	if opts != nil && opts.PlainSignature {
+		return funcSig, nil
+	}
+
+	return fmt.Sprintf("```text\n"+
+		"%s\n"+
+		"```",
+		funcSig), nil
+}
+
+// RenderVariadicArg returns a Markdown formatted string of the variadic argument if it exists,
+// otherwise an empty string.
+func RenderVariadicArg(signature *tfjson.FunctionSignature, opts *Options) (string, error) {
+	err := opts.validate()
+	if err != nil {
+		return "", err
+	}
+
+	if signature.VariadicParameter == nil {
+		return "", nil
+	}
+
+	name := signature.VariadicParameter.Name
+	desc := strings.TrimSpace(signature.VariadicParameter.Description)
+
+	typeBuffer := bytes.NewBuffer(nil)
+	err = opts.writeArgumentType(typeBuffer, signature.VariadicParameter.Type)
+	if err != nil {
+		return "", err
+	}
+
+	if signature.VariadicParameter.IsNullable {
+		return fmt.Sprintf("1. `%s` (Variadic, %s, Nullable) %s", name, typeBuffer.String(), desc), nil
+	} else {
+		return fmt.Sprintf("1. `%s` (Variadic, %s) %s", name, typeBuffer.String(), desc), nil
+	}
+
+}
@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package functionmd_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/functionmd"
+)
+
+func TestRenderArguments(t *testing.T) {
+	t.Parallel()
+
+	inputFile := "testdata/function_signature.schema.json"
+	expectedFile := "testdata/example_arguments.md"
+
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signature tfjson.FunctionSignature
+
+	err = json.Unmarshal(input, &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	argStr, err := functionmd.RenderArguments(&signature, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(argStr, "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+
+}
+
+func TestRenderSignature(t *testing.T) {
+	t.Parallel()
+
+	inputFile := "testdata/function_signature.schema.json"
+	expectedFile := "testdata/example_signature.md"
+
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signature tfjson.FunctionSignature
+
+	err = json.Unmarshal(input, &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	argStr, err := functionmd.RenderSignature("example", &signature, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(argStr, "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+
+}
+
+func TestRenderVariadicArg(t *testing.T) {
+	inputFile := "testdata/function_signature.schema.json"
+	expectedFile := "testdata/example_vararg.md"
+
+	t.Parallel()
+
+	input, err := os.ReadFile(inputFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected, err := os.ReadFile(expectedFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signature tfjson.FunctionSignature
+
+	err = json.Unmarshal(input, &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	argStr, err := functionmd.RenderVariadicArg(&signature, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Remove \r characters so tests don't fail on windows
+	expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+	// Remove trailing newlines before comparing (some text editors remove them).
+	expectedStr = strings.TrimRight(expectedStr, "\n")
+	actual := strings.TrimRight(argStr, "\n")
+	if diff := cmp.Diff(expectedStr, actual); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+
+}
+
+func TestRender_Options(t *testing.T) {
+	t.Parallel()
+
+	input, err := os.ReadFile("testdata/function_signature.schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var signature tfjson.FunctionSignature
+
+	err = json.Unmarshal(input, &signature)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		opts              *functionmd.Options
+		expectedSignature string
+		expectedArguments string
+		expectedVariadic  string
+		expectedError     string
+	}{
+		"plain-signature": {
+			opts: &functionmd.Options{
+				PlainSignature: true,
+			},
+			expectedSignature: "example(input string, int64Input number, listStringInput list of string, mapStringInput map of string, objectInput object, variadicInput string...) string",
+			expectedArguments: "1. `input` (String) Value to echo.\n" +
+				"1. `int64Input` (Number) Int64 Value to echo.\n" +
+				"1. `listStringInput` (List of String) List of strings to echo.\n" +
+				"1. `mapStringInput` (Map of String) Map of strings to echo.\n" +
+				"1. `objectInput` (Object) Object to echo.",
+			expectedVariadic: "1. `variadicInput` (Variadic, String) Variadic input to echo.",
+		},
+		"terraform-type-syntax": {
+			opts: &functionmd.Options{
+				TypeSyntax: "terraform",
+			},
+			expectedSignature: "```text\nexample(input string, int64Input number, listStringInput list(string), mapStringInput map(string), objectInput object, variadicInput string...) string\n```",
+			expectedArguments: "1. `input` (string) Value to echo.\n" +
+				"1. `int64Input` (number) Int64 Value to echo.\n" +
+				"1. `listStringInput` (list(string)) List of strings to echo.\n" +
+				"1. `mapStringInput` (map(string)) Map of strings to echo.\n" +
+				"1. `objectInput` (object) Object to echo.",
+			expectedVariadic: "1. `variadicInput` (Variadic, string) Variadic input to echo.",
+		},
+		"unsupported-type-syntax": {
+			opts: &functionmd.Options{
+				TypeSyntax: "go",
+			},
+			expectedError: `unsupported type syntax "go", expected one of: default, terraform`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			funcSig, err := functionmd.RenderSignature("example", &signature, testCase.opts)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			funcArgs, err := functionmd.RenderArguments(&signature, testCase.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			funcVarArg, err := functionmd.RenderVariadicArg(&signature, testCase.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(testCase.expectedSignature, funcSig); diff != "" {
+				t.Errorf("unexpected signature difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedArguments, funcArgs); diff != "" {
+				t.Errorf("unexpected arguments difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(testCase.expectedVariadic, funcVarArg); diff != "" {
+				t.Errorf("unexpected variadic argument difference: %s", diff)
+			}
+		})
+	}
+}
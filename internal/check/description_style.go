@@ -0,0 +1,186 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// todoComment matches TODO markers, which indicate unfinished descriptions.
+var todoComment = regexp.MustCompile(`\bTODO\b`)
+
+// DescriptionStyleOptions are the style rules of schema descriptions. Empty
+// descriptions are not checked.
+type DescriptionStyleOptions struct {
+	// Uppercase requires descriptions to start with an uppercase letter, if
+	// they start with a letter.
+	Uppercase bool
+
+	// Period requires descriptions to end with a period.
+	Period bool
+
+	// MaxLength is the maximum number of characters of descriptions. The
+	// length is not checked if zero.
+	MaxLength int
+
+	// NoTODO disallows TODO markers in descriptions.
+	NoTODO bool
+}
+
+// Enabled returns true if any style rule is set.
+func (opts DescriptionStyleOptions) Enabled() bool {
+	return opts.Uppercase || opts.Period || opts.MaxLength > 0 || opts.NoTODO
+}
+
+type DescriptionStyleCheck struct {
+	Options DescriptionStyleOptions
+}
+
+// NewDescriptionStyleCheck returns a check of schema descriptions against the
+// style rules of opts.
+func NewDescriptionStyleCheck(opts DescriptionStyleOptions) *DescriptionStyleCheck {
+	return &DescriptionStyleCheck{
+		Options: opts,
+	}
+}
+
+// RunSchema returns an error for each style rule violation of the
+// description of the schema, and its attributes and blocks, of the named
+// item of the given kind, such as "resource".
+func (check *DescriptionStyleCheck) RunSchema(kind, name string, schema *tfjson.Schema) error {
+	if schema == nil || schema.Block == nil {
+		return nil
+	}
+
+	item := fmt.Sprintf("%s %q", kind, name)
+
+	result := check.run(item, schema.Block.Description)
+
+	return errors.Join(result, check.runBlock(item, "", schema.Block))
+}
+
+// RunFunction returns an error for each style rule violation of the
+// description and summary of the named function, and its parameters.
+func (check *DescriptionStyleCheck) RunFunction(name string, signature *tfjson.FunctionSignature) error {
+	if signature == nil {
+		return nil
+	}
+
+	item := fmt.Sprintf("function %q", name)
+
+	result := check.run(item, signature.Description)
+	result = errors.Join(result, check.run(item+" summary", signature.Summary))
+
+	parameters := signature.Parameters
+	if signature.VariadicParameter != nil {
+		parameters = append(parameters[:len(parameters):len(parameters)], signature.VariadicParameter)
+	}
+
+	for _, parameter := range parameters {
+		if parameter == nil {
+			continue
+		}
+
+		result = errors.Join(result, check.run(fmt.Sprintf("%s parameter %q", item, parameter.Name), parameter.Description))
+	}
+
+	return result
+}
+
+func (check *DescriptionStyleCheck) runBlock(item, path string, block *tfjson.SchemaBlock) error {
+	var result error
+
+	for _, name := range sortedKeys(block.Attributes) {
+		result = errors.Join(result, check.runAttribute(item, joinAttributePath(path, name), block.Attributes[name]))
+	}
+
+	for _, name := range sortedKeys(block.NestedBlocks) {
+		nested := block.NestedBlocks[name]
+		if nested == nil || nested.Block == nil {
+			continue
+		}
+
+		childPath := joinAttributePath(path, name)
+
+		result = errors.Join(result, check.run(fmt.Sprintf("%s block %q", item, childPath), nested.Block.Description))
+		result = errors.Join(result, check.runBlock(item, childPath, nested.Block))
+	}
+
+	return result
+}
+
+func (check *DescriptionStyleCheck) runAttribute(item, path string, attribute *tfjson.SchemaAttribute) error {
+	if attribute == nil {
+		return nil
+	}
+
+	result := check.run(fmt.Sprintf("%s attribute %q", item, path), attribute.Description)
+
+	if attribute.AttributeNestedType == nil {
+		return result
+	}
+
+	for _, name := range sortedKeys(attribute.AttributeNestedType.Attributes) {
+		result = errors.Join(result, check.runAttribute(item, joinAttributePath(path, name), attribute.AttributeNestedType.Attributes[name]))
+	}
+
+	return result
+}
+
+// run returns an error for each style rule violation of description, in the
+// given location.
+func (check *DescriptionStyleCheck) run(location, description string) error {
+	description = strings.TrimSpace(description)
+	if description == "" {
+		return nil
+	}
+
+	var reasons []string
+
+	if check.Options.Uppercase {
+		if r, _ := utf8.DecodeRuneInString(description); unicode.IsLetter(r) && !unicode.IsUpper(r) {
+			reasons = append(reasons, "does not start with an uppercase letter")
+		}
+	}
+
+	if check.Options.Period && !strings.HasSuffix(description, ".") {
+		reasons = append(reasons, "does not end with a period")
+	}
+
+	if check.Options.MaxLength > 0 {
+		if length := utf8.RuneCountInString(description); length > check.Options.MaxLength {
+			reasons = append(reasons, fmt.Sprintf("exceeds maximum length (%d): %d", check.Options.MaxLength, length))
+		}
+	}
+
+	if check.Options.NoTODO && todoComment.MatchString(description) {
+		reasons = append(reasons, "contains TODO")
+	}
+
+	var result error
+
+	for _, reason := range reasons {
+		result = errors.Join(result, &RuleError{
+			Rule: RuleDescriptionStyle,
+			Err:  fmt.Errorf("%s: error checking description style: description %s", location, reason),
+		})
+	}
+
+	return result
+}
+
+func joinAttributePath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestDescriptionStyleCheck(t *testing.T) {
+	t.Parallel()
+
+	schema := func(description string, attributes map[string]*tfjson.SchemaAttribute) *tfjson.Schema {
+		return &tfjson.Schema{
+			Block: &tfjson.SchemaBlock{
+				Description: description,
+				Attributes:  attributes,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		Schema        *tfjson.Schema
+		Options       DescriptionStyleOptions
+		ExpectedError string
+	}{
+		"no rules": {
+			Schema: schema("example resource", map[string]*tfjson.SchemaAttribute{
+				"id": {AttributeType: cty.String, Description: "TODO"},
+			}),
+		},
+		"valid": {
+			Schema: schema("Example resource.", map[string]*tfjson.SchemaAttribute{
+				"id":   {AttributeType: cty.String, Description: "`id` of the resource."},
+				"name": {AttributeType: cty.String},
+			}),
+			Options: DescriptionStyleOptions{Uppercase: true, Period: true, MaxLength: 30, NoTODO: true},
+		},
+		"uppercase": {
+			Schema:        schema("example resource", nil),
+			Options:       DescriptionStyleOptions{Uppercase: true},
+			ExpectedError: `resource "scaffolding_example": error checking description style: description does not start with an uppercase letter`,
+		},
+		"period": {
+			Schema: schema("Example resource.", map[string]*tfjson.SchemaAttribute{
+				"id": {AttributeType: cty.String, Description: "Example identifier"},
+			}),
+			Options:       DescriptionStyleOptions{Period: true},
+			ExpectedError: `resource "scaffolding_example" attribute "id": error checking description style: description does not end with a period`,
+		},
+		"max length": {
+			Schema:        schema("Example resource with a long description.", nil),
+			Options:       DescriptionStyleOptions{MaxLength: 20},
+			ExpectedError: `resource "scaffolding_example": error checking description style: description exceeds maximum length (20): 41`,
+		},
+		"todo in nested attribute": {
+			Schema: schema("Example resource.", map[string]*tfjson.SchemaAttribute{
+				"settings": {
+					Description: "Settings.",
+					AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+						NestingMode: tfjson.SchemaNestingModeSingle,
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"enabled": {AttributeType: cty.Bool, Description: "TODO: describe."},
+						},
+					},
+				},
+			}),
+			Options:       DescriptionStyleOptions{NoTODO: true},
+			ExpectedError: `resource "scaffolding_example" attribute "settings.enabled": error checking description style: description contains TODO`,
+		},
+		"nested block": {
+			Schema: &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					Description: "Example resource.",
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"timeouts": {
+							NestingMode: tfjson.SchemaNestingModeSingle,
+							Block: &tfjson.SchemaBlock{
+								Description: "timeouts.",
+							},
+						},
+					},
+				},
+			},
+			Options:       DescriptionStyleOptions{Uppercase: true},
+			ExpectedError: `resource "scaffolding_example" block "timeouts": error checking description style: description does not start with an uppercase letter`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewDescriptionStyleCheck(testCase.Options).RunSchema("resource", "scaffolding_example", testCase.Schema)
+
+			if testCase.ExpectedError == "" {
+				if got != nil {
+					t.Errorf("expected no error, got error: %s", got)
+				}
+				return
+			}
+
+			if got == nil || got.Error() != testCase.ExpectedError {
+				t.Errorf("expected error: %s, got error: %v", testCase.ExpectedError, got)
+			}
+		})
+	}
+}
+
+func TestDescriptionStyleCheck_RunFunction(t *testing.T) {
+	t.Parallel()
+
+	signature := &tfjson.FunctionSignature{
+		Description: "Given a string value, returns the same value.",
+		Summary:     "Example function",
+		Parameters: []*tfjson.FunctionParameter{
+			{Name: "input", Description: "Value to echo."},
+		},
+		VariadicParameter: &tfjson.FunctionParameter{Name: "suffixes", Description: "suffixes to append."},
+	}
+
+	expected := `function "example" summary: error checking description style: description does not end with a period
+function "example" parameter "suffixes": error checking description style: description does not start with an uppercase letter`
+
+	got := NewDescriptionStyleCheck(DescriptionStyleOptions{Uppercase: true, Period: true}).RunFunction("example", signature)
+
+	if got == nil || got.Error() != expected {
+		t.Errorf("expected error: %s, got error: %v", expected, got)
+	}
+}
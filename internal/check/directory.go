@@ -12,37 +12,53 @@ import (
 const (
 	CdktfIndexDirectory = `cdktf`
 
-	LegacyIndexDirectory       = `website/docs`
-	LegacyDataSourcesDirectory = `d`
-	LegacyGuidesDirectory      = `guides`
-	LegacyResourcesDirectory   = `r`
-	LegacyFunctionsDirectory   = `functions`
-
-	RegistryIndexDirectory       = `docs`
-	RegistryDataSourcesDirectory = `data-sources`
-	RegistryGuidesDirectory      = `guides`
-	RegistryResourcesDirectory   = `resources`
-	RegistryFunctionsDirectory   = `functions`
+	LegacyIndexDirectory              = `website/docs`
+	LegacyActionsDirectory            = `actions`
+	LegacyDataSourcesDirectory        = `d`
+	LegacyEphemeralResourcesDirectory = `ephemeral-resources`
+	LegacyGuidesDirectory             = `guides`
+	LegacyListResourcesDirectory      = `list-resources`
+	LegacyResourcesDirectory          = `r`
+	LegacyFunctionsDirectory          = `functions`
+
+	RegistryIndexDirectory              = `docs`
+	RegistryActionsDirectory            = `actions`
+	RegistryDataSourcesDirectory        = `data-sources`
+	RegistryEphemeralResourcesDirectory = `ephemeral-resources`
+	RegistryGuidesDirectory             = `guides`
+	RegistryListResourcesDirectory      = `list-resources`
+	RegistryResourcesDirectory          = `resources`
+	RegistryFunctionsDirectory          = `functions`
 
 	// Terraform Registry Storage Limits
 	// https://www.terraform.io/docs/registry/providers/docs.html#storage-limits
 	RegistryMaximumNumberOfFiles = 2000
 	RegistryMaximumSizeOfFile    = 500000 // 500KB
 
+	// RegistryMaximumPathDepth is the maximum number of path segments of
+	// documentation files below the documentation directory, such as
+	// cdktf/typescript/resources/example.md.
+	RegistryMaximumPathDepth = 4
 )
 
 var ValidLegacyDirectories = []string{
 	LegacyIndexDirectory,
+	LegacyIndexDirectory + "/" + LegacyActionsDirectory,
 	LegacyIndexDirectory + "/" + LegacyDataSourcesDirectory,
+	LegacyIndexDirectory + "/" + LegacyEphemeralResourcesDirectory,
 	LegacyIndexDirectory + "/" + LegacyGuidesDirectory,
+	LegacyIndexDirectory + "/" + LegacyListResourcesDirectory,
 	LegacyIndexDirectory + "/" + LegacyResourcesDirectory,
 	LegacyIndexDirectory + "/" + LegacyFunctionsDirectory,
 }
 
 var ValidRegistryDirectories = []string{
 	RegistryIndexDirectory,
+	RegistryIndexDirectory + "/" + RegistryActionsDirectory,
 	RegistryIndexDirectory + "/" + RegistryDataSourcesDirectory,
+	RegistryIndexDirectory + "/" + RegistryEphemeralResourcesDirectory,
 	RegistryIndexDirectory + "/" + RegistryGuidesDirectory,
+	RegistryIndexDirectory + "/" + RegistryListResourcesDirectory,
 	RegistryIndexDirectory + "/" + RegistryResourcesDirectory,
 	RegistryIndexDirectory + "/" + RegistryFunctionsDirectory,
 }
@@ -57,15 +73,21 @@ var ValidCdktfLanguages = []string{
 
 var ValidLegacySubdirectories = []string{
 	LegacyIndexDirectory,
+	LegacyActionsDirectory,
 	LegacyDataSourcesDirectory,
+	LegacyEphemeralResourcesDirectory,
 	LegacyGuidesDirectory,
+	LegacyListResourcesDirectory,
 	LegacyResourcesDirectory,
 }
 
 var ValidRegistrySubdirectories = []string{
 	RegistryIndexDirectory,
+	RegistryActionsDirectory,
 	RegistryDataSourcesDirectory,
+	RegistryEphemeralResourcesDirectory,
 	RegistryGuidesDirectory,
+	RegistryListResourcesDirectory,
 	RegistryResourcesDirectory,
 }
 
@@ -82,14 +104,21 @@ func InvalidDirectoriesCheck(dirPath string) error {
 		return nil
 	}
 
-	return fmt.Errorf("invalid Terraform Provider documentation directory found: %s", dirPath)
+	return &RuleError{
+		Rule: RuleInvalidDirectories,
+		File: dirPath,
+		Err:  fmt.Errorf("invalid Terraform Provider documentation directory found: %s", dirPath),
+	}
 
 }
 
 func MixedDirectoriesCheck(docFiles []string) error {
 	var legacyDirectoryFound bool
 	var registryDirectoryFound bool
-	err := fmt.Errorf("mixed Terraform Provider documentation directory layouts found, must use only legacy or registry layout")
+	err := &RuleError{
+		Rule: RuleMixedDirectories,
+		Err:  fmt.Errorf("mixed Terraform Provider documentation directory layouts found, must use only legacy or registry layout"),
+	}
 
 	for _, file := range docFiles {
 		directory := filepath.Dir(file)
@@ -0,0 +1,52 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+type ExampleFormatCheck struct{}
+
+// NewExampleFormatCheck returns a check of Terraform example files which are
+// not formatted in the canonical style of terraform fmt.
+func NewExampleFormatCheck() *ExampleFormatCheck {
+	return &ExampleFormatCheck{}
+}
+
+// Run returns an error if the Terraform file at path, with content src, is
+// not formatted, at the first line which differs from the formatted content.
+func (check *ExampleFormatCheck) Run(path string, src []byte) error {
+	formatted := hclwrite.Format(src)
+	if bytes.Equal(src, formatted) {
+		return nil
+	}
+
+	lineNum := firstDifferentLine(src, formatted)
+
+	return &RuleError{
+		Rule: RuleExampleFormat,
+		File: path,
+		Line: lineNum,
+		Err:  fmt.Errorf("%s:%d: error checking example format: file is not formatted, run terraform fmt", path, lineNum),
+	}
+}
+
+// firstDifferentLine returns the 1-based number of the first line which
+// differs between a and b.
+func firstDifferentLine(a, b []byte) int {
+	aLines := bytes.Split(a, []byte("\n"))
+	bLines := bytes.Split(b, []byte("\n"))
+
+	for i := range aLines {
+		if i >= len(bLines) || !bytes.Equal(aLines[i], bLines[i]) {
+			return i + 1
+		}
+	}
+
+	return len(aLines)
+}
@@ -0,0 +1,55 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExampleFormatCheck(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		Source        string
+		ExpectedError string
+	}{
+		"formatted": {
+			Source: "resource \"scaffolding_example\" \"example\" {\n  name  = \"example\"\n  count = 1\n}\n",
+		},
+		"unaligned": {
+			Source:        "resource \"scaffolding_example\" \"example\" {\n  name = \"example\"\n  count = 1\n}\n",
+			ExpectedError: "examples/resources/scaffolding_example/resource.tf:2: error checking example format: file is not formatted, run terraform fmt",
+		},
+		"indentation": {
+			Source:        "# Manages an example.\nresource \"scaffolding_example\" \"example\" {\n    name = \"example\"\n}\n",
+			ExpectedError: "examples/resources/scaffolding_example/resource.tf:3: error checking example format: file is not formatted, run terraform fmt",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewExampleFormatCheck().Run("examples/resources/scaffolding_example/resource.tf", []byte(testCase.Source))
+
+			if testCase.ExpectedError == "" {
+				if got != nil {
+					t.Errorf("expected no error, got error: %s", got)
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected error: %s, got no error", testCase.ExpectedError)
+			}
+
+			if diff := cmp.Diff(testCase.ExpectedError, got.Error()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
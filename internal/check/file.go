@@ -22,17 +22,22 @@ func (opts *FileOptions) FullPath(path string) string {
 	return path
 }
 
-// FileSizeCheck verifies that documentation file is below the Terraform Registry storage limit.
-func FileSizeCheck(fullpath string) error {
+// FileSizeCheck verifies that documentation file is below the maximum size,
+// which defaults to the Terraform Registry storage limit if zero.
+func FileSizeCheck(fullpath string, maxSize int64) error {
+	if maxSize <= 0 {
+		maxSize = RegistryMaximumSizeOfFile
+	}
+
 	fi, err := os.Stat(fullpath)
 
 	if err != nil {
 		return err
 	}
 
-	log.Printf("[DEBUG] File %s size: %d (limit: %d)", fullpath, fi.Size(), RegistryMaximumSizeOfFile)
-	if fi.Size() >= int64(RegistryMaximumSizeOfFile) {
-		return fmt.Errorf("exceeded maximum (%d) size of documentation file for Terraform Registry: %d", RegistryMaximumSizeOfFile, fi.Size())
+	log.Printf("[DEBUG] File %s size: %d (limit: %d)", fullpath, fi.Size(), maxSize)
+	if fi.Size() >= maxSize {
+		return fmt.Errorf("exceeded maximum (%d) size of documentation file for Terraform Registry: %d", maxSize, fi.Size())
 	}
 
 	return nil
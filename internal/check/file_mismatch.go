@@ -8,7 +8,9 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path"
 	"sort"
+	"strings"
 
 	tfjson "github.com/hashicorp/terraform-json"
 )
@@ -26,8 +28,36 @@ type FileMismatchOptions struct {
 
 	ResourceEntries []os.DirEntry
 
+	EphemeralResourceEntries []os.DirEntry
+
+	ListResourceEntries []os.DirEntry
+
 	FunctionEntries []os.DirEntry
 
+	// DatasourceIgnore, ResourceIgnore, EphemeralResourceIgnore,
+	// ListResourceIgnore, and FunctionIgnore are the names of the items of
+	// each type which are ignored, in addition to IgnoreFileMismatch and
+	// IgnoreFileMissing, which apply to items of any type.
+	DatasourceIgnore        []string
+	ResourceIgnore          []string
+	EphemeralResourceIgnore []string
+	ListResourceIgnore      []string
+	FunctionIgnore          []string
+
+	// DatasourceDir, ResourceDir, EphemeralResourceDir, ListResourceDir, and
+	// FunctionDir are the slash-separated paths of the documentation
+	// directories of the entries, relative to the provider directory. They
+	// are the file locations of check errors.
+	DatasourceDir        string
+	ResourceDir          string
+	EphemeralResourceDir string
+	ListResourceDir      string
+	FunctionDir          string
+
+	// FileExtension is the extension of the expected path of missing
+	// documentation files. Defaults to .md.
+	FileExtension string
+
 	Schema *tfjson.ProviderSchema
 }
 
@@ -48,6 +78,10 @@ func NewFileMismatchCheck(opts *FileMismatchOptions) *FileMismatchCheck {
 		check.Options.FileOptions = &FileOptions{}
 	}
 
+	if check.Options.FileExtension == "" {
+		check.Options.FileExtension = FileExtensionMd
+	}
+
 	return check
 }
 
@@ -60,25 +94,37 @@ func (check *FileMismatchCheck) Run() error {
 	}
 
 	if check.Options.ResourceEntries != nil {
-		err := check.ResourceFileMismatchCheck(check.Options.ResourceEntries, "resource", check.Options.Schema.ResourceSchemas)
+		err := check.ResourceFileMismatchCheck(check.Options.ResourceEntries, "resource", check.Options.ResourceDir, check.Options.ResourceIgnore, check.Options.Schema.ResourceSchemas)
 		result = errors.Join(result, err)
 	}
 
 	if check.Options.DatasourceEntries != nil {
-		err := check.ResourceFileMismatchCheck(check.Options.DatasourceEntries, "datasource", check.Options.Schema.DataSourceSchemas)
+		err := check.ResourceFileMismatchCheck(check.Options.DatasourceEntries, "datasource", check.Options.DatasourceDir, check.Options.DatasourceIgnore, check.Options.Schema.DataSourceSchemas)
+		result = errors.Join(result, err)
+	}
+
+	if check.Options.EphemeralResourceEntries != nil {
+		err := check.ResourceFileMismatchCheck(check.Options.EphemeralResourceEntries, "ephemeral resource", check.Options.EphemeralResourceDir, check.Options.EphemeralResourceIgnore, check.Options.Schema.EphemeralResourceSchemas)
+		result = errors.Join(result, err)
+	}
+
+	if check.Options.ListResourceEntries != nil {
+		err := check.ResourceFileMismatchCheck(check.Options.ListResourceEntries, "list resource", check.Options.ListResourceDir, check.Options.ListResourceIgnore, check.Options.Schema.ListResourceSchemas)
 		result = errors.Join(result, err)
 	}
 
 	if check.Options.FunctionEntries != nil {
-		err := check.FunctionFileMismatchCheck(check.Options.FunctionEntries, check.Options.Schema.Functions)
+		err := check.FunctionFileMismatchCheck(check.Options.FunctionEntries, check.Options.FunctionDir, check.Options.FunctionIgnore, check.Options.Schema.Functions)
 		result = errors.Join(result, err)
 	}
 
 	return result
 }
 
-// ResourceFileMismatchCheck checks for mismatched files, either missing or extraneous, against the resource/datasouce schema
-func (check *FileMismatchCheck) ResourceFileMismatchCheck(files []os.DirEntry, resourceType string, schemas map[string]*tfjson.Schema) error {
+// ResourceFileMismatchCheck checks for mismatched files, either missing or extraneous, against the resource/datasouce/ephemeral resource/list resource schema
+// The errors are located in the documentation directory dir. The ignore names
+// only apply to this resource type.
+func (check *FileMismatchCheck) ResourceFileMismatchCheck(files []os.DirEntry, resourceType, dir string, ignore []string, schemas map[string]*tfjson.Schema) error {
 	if len(files) == 0 {
 		log.Printf("[DEBUG] Skipping %s file mismatch checks due to missing file list", resourceType)
 		return nil
@@ -98,7 +144,7 @@ func (check *FileMismatchCheck) ResourceFileMismatchCheck(files []os.DirEntry, r
 			continue
 		}
 
-		if check.IgnoreFileMismatch(file.Name()) {
+		if check.IgnoreFileMismatch(file.Name()) || containsName(ignore, FileResourceName(check.Options.ProviderShortName, file.Name())) {
 			continue
 		}
 
@@ -112,7 +158,7 @@ func (check *FileMismatchCheck) ResourceFileMismatchCheck(files []os.DirEntry, r
 			continue
 		}
 
-		if check.IgnoreFileMissing(resourceName) {
+		if check.IgnoreFileMissing(resourceName) || containsName(ignore, resourceName) {
 			continue
 		}
 
@@ -123,12 +169,20 @@ func (check *FileMismatchCheck) ResourceFileMismatchCheck(files []os.DirEntry, r
 	var result error
 
 	for _, extraFile := range extraFiles {
-		err := fmt.Errorf("matching %s for documentation file (%s) not found, file is extraneous or incorrectly named", resourceType, extraFile)
+		err := &RuleError{
+			Rule: RuleFileMismatch,
+			File: path.Join(dir, extraFile),
+			Err:  fmt.Errorf("matching %s for documentation file (%s) not found, file is extraneous or incorrectly named", resourceType, extraFile),
+		}
 		result = errors.Join(result, err)
 	}
 
 	for _, missingFile := range missingFiles {
-		err := fmt.Errorf("missing documentation file for %s: %s", resourceType, missingFile)
+		err := &RuleError{
+			Rule: RuleFileMismatch,
+			File: path.Join(dir, strings.TrimPrefix(missingFile, check.Options.ProviderShortName+"_")+check.Options.FileExtension),
+			Err:  fmt.Errorf("missing documentation file for %s: %s", resourceType, missingFile),
+		}
 		result = errors.Join(result, err)
 	}
 
@@ -137,7 +191,9 @@ func (check *FileMismatchCheck) ResourceFileMismatchCheck(files []os.DirEntry, r
 }
 
 // FunctionFileMismatchCheck checks for mismatched files, either missing or extraneous, against the function signature
-func (check *FileMismatchCheck) FunctionFileMismatchCheck(files []os.DirEntry, functions map[string]*tfjson.FunctionSignature) error {
+// The errors are located in the documentation directory dir. The ignore names
+// only apply to functions.
+func (check *FileMismatchCheck) FunctionFileMismatchCheck(files []os.DirEntry, dir string, ignore []string, functions map[string]*tfjson.FunctionSignature) error {
 	if len(files) == 0 {
 		log.Printf("[DEBUG] Skipping function file mismatch checks due to missing file list")
 		return nil
@@ -156,7 +212,7 @@ func (check *FileMismatchCheck) FunctionFileMismatchCheck(files []os.DirEntry, f
 			continue
 		}
 
-		if check.IgnoreFileMismatch(file.Name()) {
+		if check.IgnoreFileMismatch(file.Name()) || containsName(ignore, TrimFileExtension(file.Name())) {
 			continue
 		}
 
@@ -168,7 +224,7 @@ func (check *FileMismatchCheck) FunctionFileMismatchCheck(files []os.DirEntry, f
 			continue
 		}
 
-		if check.IgnoreFileMissing(functionName) {
+		if check.IgnoreFileMissing(functionName) || containsName(ignore, functionName) {
 			continue
 		}
 
@@ -178,12 +234,20 @@ func (check *FileMismatchCheck) FunctionFileMismatchCheck(files []os.DirEntry, f
 	var result error
 
 	for _, extraFile := range extraFiles {
-		err := fmt.Errorf("matching function for documentation file (%s) not found, file is extraneous or incorrectly named", extraFile)
+		err := &RuleError{
+			Rule: RuleFileMismatch,
+			File: path.Join(dir, extraFile),
+			Err:  fmt.Errorf("matching function for documentation file (%s) not found, file is extraneous or incorrectly named", extraFile),
+		}
 		result = errors.Join(result, err)
 	}
 
 	for _, missingFile := range missingFiles {
-		err := fmt.Errorf("missing documentation file for function: %s", missingFile)
+		err := &RuleError{
+			Rule: RuleFileMismatch,
+			File: path.Join(dir, missingFile+check.Options.FileExtension),
+			Err:  fmt.Errorf("missing documentation file for function: %s", missingFile),
+		}
 		result = errors.Join(result, err)
 	}
 
@@ -193,7 +257,7 @@ func (check *FileMismatchCheck) FunctionFileMismatchCheck(files []os.DirEntry, f
 
 func (check *FileMismatchCheck) IgnoreFileMismatch(file string) bool {
 	for _, ignoreResourceName := range check.Options.IgnoreFileMismatch {
-		if ignoreResourceName == fileResourceName(check.Options.ProviderShortName, file) {
+		if ignoreResourceName == FileResourceName(check.Options.ProviderShortName, file) {
 			return true
 		}
 	}
@@ -211,8 +275,18 @@ func (check *FileMismatchCheck) IgnoreFileMissing(resourceName string) bool {
 	return false
 }
 
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func fileHasResource(schemaResources map[string]*tfjson.Schema, providerName, file string) bool {
-	if _, ok := schemaResources[fileResourceName(providerName, file)]; ok {
+	if _, ok := schemaResources[FileResourceName(providerName, file)]; ok {
 		return true
 	}
 
@@ -227,7 +301,10 @@ func fileHasFunction(functions map[string]*tfjson.FunctionSignature, file string
 	return false
 }
 
-func fileResourceName(providerName, fileName string) string {
+// FileResourceName returns the name of the resource, data source, ephemeral
+// resource, or list resource documented by the file, such as
+// "aws_s3_bucket" for the file "s3_bucket.md" of the provider "aws".
+func FileResourceName(providerName, fileName string) string {
 	resourceSuffix := TrimFileExtension(fileName)
 
 	return fmt.Sprintf("%s_%s", providerName, resourceSuffix)
@@ -237,7 +314,7 @@ func resourceHasFile(files []os.DirEntry, providerName, resourceName string) boo
 	var found bool
 
 	for _, file := range files {
-		if fileResourceName(providerName, file.Name()) == resourceName {
+		if FileResourceName(providerName, file.Name()) == resourceName {
 			found = true
 			break
 		}
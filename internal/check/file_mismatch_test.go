@@ -82,7 +82,7 @@ func TestFileResourceName(t *testing.T) {
 		testCase := testCase
 		t.Run(name, func(t *testing.T) {
 			t.Parallel()
-			got := fileResourceName("test", testCase.File)
+			got := FileResourceName("test", testCase.File)
 			want := testCase.Expect
 
 			if got != want {
@@ -95,10 +95,12 @@ func TestFileResourceName(t *testing.T) {
 func TestFileMismatchCheck(t *testing.T) {
 	t.Parallel()
 	testCases := map[string]struct {
-		ResourceFiles fstest.MapFS
-		FunctionFiles fstest.MapFS
-		Options       *FileMismatchOptions
-		ExpectError   bool
+		ResourceFiles          fstest.MapFS
+		EphemeralResourceFiles fstest.MapFS
+		ListResourceFiles      fstest.MapFS
+		FunctionFiles          fstest.MapFS
+		Options                *FileMismatchOptions
+		ExpectError            bool
 	}{
 		"all found - resource": {
 			ResourceFiles: fstest.MapFS{
@@ -130,6 +132,36 @@ func TestFileMismatchCheck(t *testing.T) {
 				},
 			},
 		},
+		"all found - ephemeral resource": {
+			EphemeralResourceFiles: fstest.MapFS{
+				"ephemeral1.md": {},
+				"ephemeral2.md": {},
+			},
+			Options: &FileMismatchOptions{
+				ProviderShortName: "test",
+				Schema: &tfjson.ProviderSchema{
+					EphemeralResourceSchemas: map[string]*tfjson.Schema{
+						"test_ephemeral1": {},
+						"test_ephemeral2": {},
+					},
+				},
+			},
+		},
+		"all found - list resource": {
+			ListResourceFiles: fstest.MapFS{
+				"list1.md": {},
+				"list2.md": {},
+			},
+			Options: &FileMismatchOptions{
+				ProviderShortName: "test",
+				Schema: &tfjson.ProviderSchema{
+					ListResourceSchemas: map[string]*tfjson.Schema{
+						"test_list1": {},
+						"test_list2": {},
+					},
+				},
+			},
+		},
 		"extra file - resource": {
 			ResourceFiles: fstest.MapFS{
 				"resource1.md": {},
@@ -164,6 +196,36 @@ func TestFileMismatchCheck(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		"extra file - ephemeral resource": {
+			EphemeralResourceFiles: fstest.MapFS{
+				"ephemeral1.md": {},
+				"ephemeral2.md": {},
+			},
+			Options: &FileMismatchOptions{
+				ProviderShortName: "test",
+				Schema: &tfjson.ProviderSchema{
+					EphemeralResourceSchemas: map[string]*tfjson.Schema{
+						"test_ephemeral1": {},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		"extra file - list resource": {
+			ListResourceFiles: fstest.MapFS{
+				"list1.md": {},
+				"list2.md": {},
+			},
+			Options: &FileMismatchOptions{
+				ProviderShortName: "test",
+				Schema: &tfjson.ProviderSchema{
+					ListResourceSchemas: map[string]*tfjson.Schema{
+						"test_list1": {},
+					},
+				},
+			},
+			ExpectError: true,
+		},
 		"ignore extra file - resource": {
 			ResourceFiles: fstest.MapFS{
 				"resource1.md": {},
@@ -229,6 +291,36 @@ func TestFileMismatchCheck(t *testing.T) {
 			},
 			ExpectError: true,
 		},
+		"missing file - ephemeral resource": {
+			EphemeralResourceFiles: fstest.MapFS{
+				"ephemeral1.md": {},
+			},
+			Options: &FileMismatchOptions{
+				ProviderShortName: "test",
+				Schema: &tfjson.ProviderSchema{
+					EphemeralResourceSchemas: map[string]*tfjson.Schema{
+						"test_ephemeral1": {},
+						"test_ephemeral2": {},
+					},
+				},
+			},
+			ExpectError: true,
+		},
+		"missing file - list resource": {
+			ListResourceFiles: fstest.MapFS{
+				"list1.md": {},
+			},
+			Options: &FileMismatchOptions{
+				ProviderShortName: "test",
+				Schema: &tfjson.ProviderSchema{
+					ListResourceSchemas: map[string]*tfjson.Schema{
+						"test_list1": {},
+						"test_list2": {},
+					},
+				},
+			},
+			ExpectError: true,
+		},
 		"ignore missing file - resource": {
 			ResourceFiles: fstest.MapFS{
 				"resource1.md": {},
@@ -294,8 +386,12 @@ func TestFileMismatchCheck(t *testing.T) {
 			t.Parallel()
 
 			resourceFiles, _ := testCase.ResourceFiles.ReadDir(".")
+			ephemeralResourceFiles, _ := testCase.EphemeralResourceFiles.ReadDir(".")
+			listResourceFiles, _ := testCase.ListResourceFiles.ReadDir(".")
 			functionFiles, _ := testCase.FunctionFiles.ReadDir(".")
 			testCase.Options.ResourceEntries = resourceFiles
+			testCase.Options.EphemeralResourceEntries = ephemeralResourceFiles
+			testCase.Options.ListResourceEntries = listResourceFiles
 			testCase.Options.FunctionEntries = functionFiles
 			got := NewFileMismatchCheck(testCase.Options).Run()
 
@@ -13,6 +13,7 @@ func TestFileSizeCheck(t *testing.T) {
 	t.Parallel()
 	testCases := map[string]struct {
 		Size        int64
+		MaxSize     int64
 		ExpectError bool
 	}{
 		"under limit": {
@@ -26,6 +27,15 @@ func TestFileSizeCheck(t *testing.T) {
 			Size:        RegistryMaximumSizeOfFile + 1,
 			ExpectError: true,
 		},
+		"under custom limit": {
+			Size:    999,
+			MaxSize: 1000,
+		},
+		"over custom limit": {
+			Size:        1001,
+			MaxSize:     1000,
+			ExpectError: true,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -42,7 +52,7 @@ func TestFileSizeCheck(t *testing.T) {
 				t.Fatalf("error writing temporary file: %s", err)
 			}
 
-			got := FileSizeCheck(file.Name())
+			got := FileSizeCheck(file.Name(), testCase.MaxSize)
 
 			if got == nil && testCase.ExpectError {
 				t.Errorf("expected error, got no error")
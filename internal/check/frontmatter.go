@@ -6,6 +6,8 @@ package check
 import (
 	"bytes"
 	"fmt"
+	"regexp"
+	"sort"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/parser"
@@ -34,6 +36,16 @@ type FrontMatterOptions struct {
 	RequireDescription bool
 	RequireLayout      bool
 	RequirePageTitle   bool
+
+	// RequireFields are additional keys which must be present.
+	RequireFields []string
+
+	// NoFields are additional keys which must not be present.
+	NoFields []string
+
+	// FieldPatterns are regular expressions, by key, which the values of the
+	// keys must match if present.
+	FieldPatterns map[string]*regexp.Regexp
 }
 
 func NewFrontMatterCheck(opts *FrontMatterOptions) *FrontMatterCheck {
@@ -100,5 +112,50 @@ func (check *FrontMatterCheck) Run(src []byte) error {
 		return fmt.Errorf("YAML frontmatter missing required page_title")
 	}
 
+	if len(check.Options.RequireFields) == 0 && len(check.Options.NoFields) == 0 && len(check.Options.FieldPatterns) == 0 {
+		return nil
+	}
+
+	fields := make(map[string]interface{})
+
+	err = d.Decode(&fields)
+	if err != nil {
+		return fmt.Errorf("error parsing YAML frontmatter: %w", err)
+	}
+
+	for _, key := range check.Options.NoFields {
+		if _, ok := fields[key]; ok {
+			return fmt.Errorf("YAML frontmatter should not contain %s", key)
+		}
+	}
+
+	for _, key := range check.Options.RequireFields {
+		if _, ok := fields[key]; !ok {
+			return fmt.Errorf("YAML frontmatter missing required %s", key)
+		}
+	}
+
+	for _, key := range sortedKeys(check.Options.FieldPatterns) {
+		value, ok := fields[key]
+		if !ok {
+			continue
+		}
+
+		pattern := check.Options.FieldPatterns[key]
+		if !pattern.MatchString(fmt.Sprint(value)) {
+			return fmt.Errorf("YAML frontmatter %s value %q does not match pattern %q", key, fmt.Sprint(value), pattern)
+		}
+	}
+
 	return nil
 }
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
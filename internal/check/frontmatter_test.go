@@ -4,6 +4,7 @@
 package check
 
 import (
+	"regexp"
 	"testing"
 )
 
@@ -138,6 +139,70 @@ subcategory: Example Subcategory
 			},
 			ExpectError: true,
 		},
+		"require fields option": {
+			Source: `
+---
+page_title: Example Page Title
+subcategory: Example Subcategory
+---
+`,
+			Options: &FrontMatterOptions{
+				RequireFields: []string{"subcategory", "keywords"},
+			},
+			ExpectError: true,
+		},
+		"require fields option present": {
+			Source: `
+---
+keywords: [example]
+page_title: Example Page Title
+subcategory: Example Subcategory
+---
+`,
+			Options: &FrontMatterOptions{
+				RequireFields: []string{"subcategory", "keywords"},
+			},
+		},
+		"no fields option": {
+			Source: `
+---
+page_title: Example Page Title
+robots: noindex
+---
+`,
+			Options: &FrontMatterOptions{
+				NoFields: []string{"robots"},
+			},
+			ExpectError: true,
+		},
+		"field patterns option": {
+			Source: `
+---
+page_title: Example Page Title
+subcategory: Other
+---
+`,
+			Options: &FrontMatterOptions{
+				FieldPatterns: map[string]*regexp.Regexp{
+					"subcategory": regexp.MustCompile(`^(Compute|Storage)$`),
+				},
+			},
+			ExpectError: true,
+		},
+		"field patterns option match": {
+			Source: `
+---
+page_title: Example Page Title
+subcategory: Storage
+---
+`,
+			Options: &FrontMatterOptions{
+				FieldPatterns: map[string]*regexp.Regexp{
+					"description": regexp.MustCompile(`^Manages`),
+					"subcategory": regexp.MustCompile(`^(Compute|Storage)$`),
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,58 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+)
+
+// FileCountCheck verifies that the number of documentation files is below the
+// maximum, which defaults to the Terraform Registry storage limit if zero.
+func FileCountCheck(files []string, maxFiles int) error {
+	if maxFiles <= 0 {
+		maxFiles = RegistryMaximumNumberOfFiles
+	}
+
+	log.Printf("[DEBUG] Number of documentation files: %d (limit: %d)", len(files), maxFiles)
+	if len(files) <= maxFiles {
+		return nil
+	}
+
+	return &RuleError{
+		Rule: RuleFileCount,
+		Err:  fmt.Errorf("exceeded maximum (%d) number of documentation files for Terraform Registry: %d", maxFiles, len(files)),
+	}
+}
+
+// PathDepthCheck verifies that the number of path segments of the
+// documentation file at path, relative to the provider directory, below the
+// legacy or registry documentation directory is below the maximum, which
+// defaults to the Terraform Registry limit if zero.
+func PathDepthCheck(path string, maxDepth int) error {
+	if maxDepth <= 0 {
+		maxDepth = RegistryMaximumPathDepth
+	}
+
+	rel := filepath.ToSlash(path)
+	for _, dir := range []string{LegacyIndexDirectory, RegistryIndexDirectory} {
+		if strings.HasPrefix(rel, dir+"/") {
+			rel = strings.TrimPrefix(rel, dir+"/")
+			break
+		}
+	}
+
+	depth := len(strings.Split(rel, "/"))
+	if depth <= maxDepth {
+		return nil
+	}
+
+	return &RuleError{
+		Rule: RulePathDepth,
+		File: path,
+		Err:  fmt.Errorf("%s: exceeded maximum (%d) path depth of documentation file for Terraform Registry: %d", path, maxDepth, depth),
+	}
+}
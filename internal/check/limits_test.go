@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+)
+
+func TestFileCountCheck(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		Files       []string
+		MaxFiles    int
+		ExpectError bool
+	}{
+		"under limit": {
+			Files: []string{"docs/index.md"},
+		},
+		"on custom limit": {
+			Files:    []string{"docs/index.md", "docs/resources/thing.md"},
+			MaxFiles: 2,
+		},
+		"over custom limit": {
+			Files:       []string{"docs/index.md", "docs/resources/thing.md", "docs/data-sources/thing.md"},
+			MaxFiles:    2,
+			ExpectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := FileCountCheck(testCase.Files, testCase.MaxFiles)
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
+
+func TestPathDepthCheck(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		Path          string
+		MaxDepth      int
+		ExpectedError string
+	}{
+		"registry": {
+			Path: "docs/cdktf/typescript/resources/thing.md",
+		},
+		"legacy": {
+			Path: "website/docs/cdktf/typescript/r/thing.html.markdown",
+		},
+		"over limit": {
+			Path:          "docs/cdktf/typescript/resources/nested/thing.md",
+			ExpectedError: "docs/cdktf/typescript/resources/nested/thing.md: exceeded maximum (4) path depth of documentation file for Terraform Registry: 5",
+		},
+		"over custom limit": {
+			Path:          "website/docs/r/thing.html.markdown",
+			MaxDepth:      1,
+			ExpectedError: "website/docs/r/thing.html.markdown: exceeded maximum (1) path depth of documentation file for Terraform Registry: 2",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := PathDepthCheck(testCase.Path, testCase.MaxDepth)
+
+			if got == nil && testCase.ExpectedError != "" {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && got.Error() != testCase.ExpectedError {
+				t.Errorf("expected error: %s, got error: %s", testCase.ExpectedError, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,265 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+var (
+	// markdownLink matches the destinations of inline Markdown links and
+	// images, such as "[timeouts](#nestedblock--timeouts)".
+	markdownLink = regexp.MustCompile(`\]\(\s*<?([^)\s>]+)>?(?:\s+"[^"]*")?\s*\)`)
+
+	// htmlAnchor matches HTML anchors with an id or name, such as the
+	// anchors of nested schemas rendered by tfplugindocs:
+	// `<a id="nestedblock--timeouts"></a>`.
+	htmlAnchor = regexp.MustCompile(`<a\s+(?:[^>]*\s)?(?:id|name)="([^"]+)"`)
+
+	// codeSpan matches inline code, which is not checked for links.
+	codeSpan = regexp.MustCompile("`[^`]*`")
+)
+
+// linkFileExtensions are the extensions which are added to relative link
+// paths without an extension, as links between pages commonly omit it.
+var linkFileExtensions = []string{
+	".md",
+	".html.markdown",
+	".html.md",
+	".markdown",
+}
+
+type LinksCheck struct {
+	// Dir is the directory which the paths of checked files are relative to.
+	Dir string
+
+	// anchors are the anchors of linked files, by path, which are cached
+	// as many files link to the same pages.
+	anchors map[string]map[string]bool
+}
+
+// NewLinksCheck returns a check of the relative links and anchors of
+// documentation files with paths relative to dir.
+func NewLinksCheck(dir string) *LinksCheck {
+	return &LinksCheck{
+		Dir:     dir,
+		anchors: make(map[string]map[string]bool),
+	}
+}
+
+// Run returns an error for each relative link of the file at path, with
+// content src, to a file which does not exist or an anchor which does not
+// exist in the linked file. Anchors are the IDs of headings, as generated by
+// the Terraform Registry, and of HTML anchors. Links with a URL scheme or an
+// absolute path, and links in code, are not checked.
+func (check *LinksCheck) Run(path string, src []byte) error {
+	var result error
+
+	for _, link := range documentLinks(src) {
+		reason := check.brokenLinkReason(path, src, link.Destination)
+		if reason == "" {
+			continue
+		}
+
+		result = errors.Join(result, &RuleError{
+			Rule: RuleLinks,
+			File: path,
+			Line: link.Line,
+			Err:  fmt.Errorf("%s:%d: error checking links: broken link %q: %s", path, link.Line, link.Destination, reason),
+		})
+	}
+
+	return result
+}
+
+// brokenLinkReason returns why the link destination of the file at path, with
+// content src, is broken, or an empty string if the link is not broken.
+func (check *LinksCheck) brokenLinkReason(filePath string, src []byte, destination string) string {
+	if strings.Contains(destination, ":") || strings.HasPrefix(destination, "/") {
+		return ""
+	}
+
+	linkPath, anchor, _ := strings.Cut(destination, "#")
+	if i := strings.Index(linkPath, "?"); i >= 0 {
+		linkPath = linkPath[:i]
+	}
+
+	targetPath := filePath
+	targetSrc := src
+
+	if linkPath != "" {
+		targetPath = path.Join(path.Dir(filepath.ToSlash(filePath)), linkPath)
+
+		var found bool
+		targetPath, found = check.resolveFile(targetPath)
+		if !found {
+			return "file not found"
+		}
+
+		if anchor == "" {
+			return ""
+		}
+
+		content, err := os.ReadFile(filepath.Join(check.Dir, filepath.FromSlash(targetPath)))
+		if err != nil {
+			log.Printf("[DEBUG] Skipping anchor check of unreadable file %s: %s", targetPath, err)
+			return ""
+		}
+
+		targetSrc = content
+	}
+
+	if anchor == "" {
+		return ""
+	}
+
+	anchors, ok := check.anchors[targetPath]
+	if !ok {
+		anchors = DocumentAnchors(targetSrc)
+		check.anchors[targetPath] = anchors
+	}
+
+	if !anchors[anchor] && !anchors[strings.ToLower(anchor)] {
+		return fmt.Sprintf("anchor %q not found", anchor)
+	}
+
+	return ""
+}
+
+// resolveFile returns the slash-separated path of the linked file, adding a
+// documentation file extension if the file does not exist without one.
+func (check *LinksCheck) resolveFile(linkPath string) (string, bool) {
+	candidates := []string{linkPath}
+	for _, ext := range linkFileExtensions {
+		candidates = append(candidates, linkPath+ext)
+	}
+
+	for _, candidate := range candidates {
+		if _, err := os.Stat(filepath.Join(check.Dir, filepath.FromSlash(candidate))); err == nil {
+			return candidate, true
+		}
+	}
+
+	return linkPath, false
+}
+
+// documentLink is an inline link of a documentation file.
+type documentLink struct {
+	Destination string
+
+	// Line is the 1-based line number of the link.
+	Line int
+}
+
+// documentLinks returns the inline links of the content, outside of the YAML
+// frontmatter, code blocks, and inline code, in order of appearance.
+func documentLinks(src []byte) []documentLink {
+	var links []documentLink
+
+	scanDocumentLines(src, func(lineNum int, line string) {
+		line = codeSpan.ReplaceAllString(line, "")
+
+		for _, m := range markdownLink.FindAllStringSubmatch(line, -1) {
+			links = append(links, documentLink{Destination: m[1], Line: lineNum})
+		}
+	})
+
+	return links
+}
+
+// DocumentAnchors returns the anchors of the content, which are the IDs of
+// headings, as generated by the Terraform Registry, and of HTML anchors,
+// outside of the YAML frontmatter and code blocks.
+func DocumentAnchors(src []byte) map[string]bool {
+	anchors := make(map[string]bool)
+	headingIDs := make(map[string]int)
+
+	scanDocumentLines(src, func(_ int, line string) {
+		for _, m := range htmlAnchor.FindAllStringSubmatch(line, -1) {
+			anchors[m[1]] = true
+		}
+
+		trimmed := strings.TrimLeft(line, "#")
+		if trimmed == line || (trimmed != "" && trimmed[0] != ' ') {
+			return
+		}
+
+		id := headingID(strings.TrimRight(strings.TrimSpace(trimmed), "# "))
+
+		// Duplicate headings have a numbered suffix.
+		if n := headingIDs[id]; n > 0 {
+			headingIDs[id]++
+			id = fmt.Sprintf("%s-%d", id, n)
+		} else {
+			headingIDs[id] = 1
+		}
+
+		anchors[id] = true
+	})
+
+	return anchors
+}
+
+// headingID returns the anchor ID of a heading, which is the lowercase text
+// of the heading without punctuation and with spaces replaced by hyphens.
+func headingID(heading string) string {
+	var b strings.Builder
+
+	for _, r := range strings.ToLower(heading) {
+		switch {
+		case unicode.IsLetter(r), unicode.IsDigit(r), r == '-', r == '_':
+			b.WriteRune(r)
+		case r == ' ':
+			b.WriteRune('-')
+		}
+	}
+
+	return b.String()
+}
+
+// scanDocumentLines calls fn with each line of the content, and its 1-based
+// line number, outside of the YAML frontmatter and code blocks.
+func scanDocumentLines(src []byte, fn func(lineNum int, line string)) {
+	inFrontMatter := false
+	inCodeBlock := false
+
+	scanner := bufio.NewScanner(bytes.NewReader(src))
+	for lineNum := 0; scanner.Scan(); lineNum++ {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if lineNum == 0 && trimmed == "---" {
+			inFrontMatter = true
+			continue
+		}
+
+		if inFrontMatter {
+			if trimmed == "---" {
+				inFrontMatter = false
+			}
+			continue
+		}
+
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inCodeBlock = !inCodeBlock
+			continue
+		}
+
+		if inCodeBlock {
+			continue
+		}
+
+		fn(lineNum+1, line)
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLinksCheck(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"docs/index.md":             "# Example Provider\n",
+		"docs/guides/upgrading.md":  "# Upgrading\n\n## Version 2 Changes\n",
+		"docs/resources/example.md": "# Example\n",
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	testCases := map[string]struct {
+		Source        string
+		ExpectedError string
+	}{
+		"no links": {
+			Source: "# Example\n",
+		},
+		"external links": {
+			Source: "[Terraform](https://www.terraform.io) [Registry](/providers) [email](mailto:example@example.com)\n",
+		},
+		"relative links": {
+			Source: "[index](../index.md) [guide](../guides/upgrading) [changes](../guides/upgrading.md#version-2-changes)\n",
+		},
+		"intra-page anchors": {
+			Source: "# Example\n\n- `block` (Block) (see [below for nested schema](#nestedblock--block))\n\n<a id=\"nestedblock--block\"></a>\n### Nested Schema for `block`\n\n[Nested](#nested-schema-for-block)\n",
+		},
+		"links in code": {
+			Source: "`[missing](missing.md)`\n\n```markdown\n[missing](missing.md)\n```\n",
+		},
+		"missing file": {
+			Source:        "# Example\n\n[missing](../resources/missing.md)\n",
+			ExpectedError: `docs/resources/example.md:3: error checking links: broken link "../resources/missing.md": file not found`,
+		},
+		"missing anchor": {
+			Source:        "[missing](#missing)\n[guide](../guides/upgrading.md#version-3-changes)\n",
+			ExpectedError: "docs/resources/example.md:1: error checking links: broken link \"#missing\": anchor \"missing\" not found\ndocs/resources/example.md:2: error checking links: broken link \"../guides/upgrading.md#version-3-changes\": anchor \"version-3-changes\" not found",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewLinksCheck(dir).Run("docs/resources/example.md", []byte(testCase.Source))
+
+			if testCase.ExpectedError == "" {
+				if got != nil {
+					t.Errorf("expected no error, got error: %s", got)
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected error: %s, got no error", testCase.ExpectedError)
+			}
+
+			if diff := cmp.Diff(testCase.ExpectedError, got.Error()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestDocumentAnchors(t *testing.T) {
+	t.Parallel()
+
+	src := "---\npage_title: \"# Not a heading\"\n---\n\n# Example Resource\n\n## Example Usage\n\n```shell\n# Not a heading\n```\n\n## Example Usage\n\n<a id=\"nestedatt--config\"></a>\n### Nested Schema for `config`\n"
+
+	expected := map[string]bool{
+		"example-resource":         true,
+		"example-usage":            true,
+		"example-usage-1":          true,
+		"nestedatt--config":        true,
+		"nested-schema-for-config": true,
+	}
+
+	if diff := cmp.Diff(expected, DocumentAnchors([]byte(src))); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -14,6 +14,10 @@ type ProviderFileOptions struct {
 
 	FrontMatter     *FrontMatterOptions
 	ValidExtensions []string
+
+	// MaxFileSize is the maximum size of the file in bytes, which defaults to
+	// the Terraform Registry storage limit if zero.
+	MaxFileSize int64
 }
 
 type ProviderFileCheck struct {
@@ -46,11 +50,11 @@ func (check *ProviderFileCheck) Run(path string) error {
 	log.Printf("[DEBUG] Checking file: %s", fullpath)
 
 	if err := FileExtensionCheck(path, check.Options.ValidExtensions); err != nil {
-		return fmt.Errorf("%s: error checking file extension: %w", path, err)
+		return &RuleError{Rule: RuleFileExtension, File: path, Err: fmt.Errorf("%s: error checking file extension: %w", path, err)}
 	}
 
-	if err := FileSizeCheck(fullpath); err != nil {
-		return fmt.Errorf("%s: error checking file size: %w", path, err)
+	if err := FileSizeCheck(fullpath, check.Options.MaxFileSize); err != nil {
+		return &RuleError{Rule: RuleFileSize, File: path, Err: fmt.Errorf("%s: error checking file size: %w", path, err)}
 	}
 
 	content, err := os.ReadFile(fullpath)
@@ -60,7 +64,7 @@ func (check *ProviderFileCheck) Run(path string) error {
 	}
 
 	if err := NewFrontMatterCheck(check.Options.FrontMatter).Run(content); err != nil {
-		return fmt.Errorf("%s: error checking file frontmatter: %w", path, err)
+		return &RuleError{Rule: RuleFrontMatter, File: path, Err: fmt.Errorf("%s: error checking file frontmatter: %w", path, err)}
 	}
 
 	return nil
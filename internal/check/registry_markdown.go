@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// registryMaxHeadingLevel is the deepest heading level which the Terraform
+// Registry renders distinctly.
+const registryMaxHeadingLevel = 4
+
+var (
+	// rawHTMLBlock matches lines starting with block-level HTML elements,
+	// which the Terraform Registry strips. HTML comments and anchors, such as
+	// the anchors of nested schemas rendered by tfplugindocs, are supported.
+	rawHTMLBlock = regexp.MustCompile(`(?i)^\s*<(address|article|aside|blockquote|center|details|dialog|div|dl|fieldset|figure|footer|form|h[1-6]|header|hr|iframe|img|main|nav|ol|p|pre|script|section|style|summary|table|ul|video)\b`)
+
+	// unsupportedCallout matches callout syntax of other Markdown renderers,
+	// such as GitHub alerts ("> [!NOTE]") and admonitions (":::note"), which
+	// the Terraform Registry renders as plain text. The Terraform Registry
+	// supports "->", "~>", and "!>" callouts instead.
+	unsupportedCallout = regexp.MustCompile(`(?i)^\s*(>\s*\[!(note|tip|important|warning|caution)\]|:::\s*(note|tip|info|important|warning|caution|danger))`)
+
+	// localImage matches the destinations of Markdown and HTML images with an
+	// absolute local path, such as "![diagram](/Users/example/diagram.png)".
+	localImage = regexp.MustCompile(`(?i)(?:!\[[^\]]*\]\(\s*<?|<img\s+(?:[^>]*\s)?src=")((?:/|file:|[a-z]:\\)[^)\s">]*)`)
+)
+
+type RegistryMarkdownCheck struct{}
+
+// NewRegistryMarkdownCheck returns a check of Markdown constructs which the
+// Terraform Registry strips or does not render as intended.
+func NewRegistryMarkdownCheck() *RegistryMarkdownCheck {
+	return &RegistryMarkdownCheck{}
+}
+
+// Run returns an error for each line of the file at path, with content src,
+// which contains a raw HTML block, unsupported callout syntax, a heading
+// deeper than level 4, or an image with an absolute local path, outside of
+// the YAML frontmatter and code blocks.
+func (check *RegistryMarkdownCheck) Run(path string, src []byte) error {
+	var result error
+
+	scanDocumentLines(src, func(lineNum int, line string) {
+		reason := registryMarkdownReason(codeSpan.ReplaceAllString(line, ""))
+		if reason == "" {
+			return
+		}
+
+		result = errors.Join(result, &RuleError{
+			Rule: RuleRegistryMarkdown,
+			File: path,
+			Line: lineNum,
+			Err:  fmt.Errorf("%s:%d: error checking registry markdown: %s", path, lineNum, reason),
+		})
+	})
+
+	return result
+}
+
+// registryMarkdownReason returns why the line is not rendered as intended by
+// the Terraform Registry, or an empty string.
+func registryMarkdownReason(line string) string {
+	if m := rawHTMLBlock.FindStringSubmatch(line); m != nil {
+		return fmt.Sprintf("raw HTML <%s> block is not supported", strings.ToLower(m[1]))
+	}
+
+	if m := unsupportedCallout.FindStringSubmatch(line); m != nil {
+		return fmt.Sprintf("callout syntax %q is not supported, use ->, ~>, or !> instead", strings.TrimSpace(m[1]))
+	}
+
+	if level := len(line) - len(strings.TrimLeft(line, "#")); level > registryMaxHeadingLevel && strings.HasPrefix(line[level:], " ") {
+		return fmt.Sprintf("heading level %d is deeper than the supported level %d", level, registryMaxHeadingLevel)
+	}
+
+	if m := localImage.FindStringSubmatch(line); m != nil && !strings.HasPrefix(m[1], "//") {
+		return fmt.Sprintf("image %q has an absolute local path", m[1])
+	}
+
+	return ""
+}
@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRegistryMarkdownCheck(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		Source        string
+		ExpectedError string
+	}{
+		"supported markdown": {
+			Source: "# Example\n\n-> **Note:** Supported callout\n\n<!-- tfplugindocs:begin:notes -->\n<!-- tfplugindocs:end:notes -->\n\n<a id=\"nestedblock--timeouts\"></a>\n#### Nested Schema for `timeouts`\n\n![diagram](https://example.com/diagram.png)\n",
+		},
+		"code blocks and inline code": {
+			Source: "`<div>`\n\n```html\n<div>Example</div>\n##### Not a heading\n```\n",
+		},
+		"raw HTML block": {
+			Source:        "# Example\n\n<div class=\"note\">Note</div>\n",
+			ExpectedError: "docs/resources/example.md:3: error checking registry markdown: raw HTML <div> block is not supported",
+		},
+		"unsupported callouts": {
+			Source:        "> [!NOTE]\n> GitHub alert\n\n:::warning\nAdmonition\n:::\n",
+			ExpectedError: "docs/resources/example.md:1: error checking registry markdown: callout syntax \"> [!NOTE]\" is not supported, use ->, ~>, or !> instead\ndocs/resources/example.md:4: error checking registry markdown: callout syntax \":::warning\" is not supported, use ->, ~>, or !> instead",
+		},
+		"deep heading": {
+			Source:        "##### Example\n",
+			ExpectedError: "docs/resources/example.md:1: error checking registry markdown: heading level 5 is deeper than the supported level 4",
+		},
+		"local images": {
+			Source:        "![diagram](/Users/example/diagram.png)\n<img src=\"file:///tmp/diagram.png\">\n",
+			ExpectedError: "docs/resources/example.md:1: error checking registry markdown: image \"/Users/example/diagram.png\" has an absolute local path\ndocs/resources/example.md:2: error checking registry markdown: raw HTML <img> block is not supported",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewRegistryMarkdownCheck().Run("docs/resources/example.md", []byte(testCase.Source))
+
+			if testCase.ExpectedError == "" {
+				if got != nil {
+					t.Errorf("expected no error, got error: %s", got)
+				}
+				return
+			}
+
+			if got == nil {
+				t.Fatalf("expected error: %s, got no error", testCase.ExpectedError)
+			}
+
+			if diff := cmp.Diff(testCase.ExpectedError, got.Error()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+// Rule IDs of the checks, which identify check errors in machine-readable
+// validation output.
+const (
+	RuleDescriptionStyle   = "DescriptionStyleCheck"
+	RuleExampleFormat      = "ExampleFormatCheck"
+	RuleFileCount          = "FileCountCheck"
+	RuleFileExtension      = "FileExtensionCheck"
+	RuleFileMismatch       = "FileMismatchCheck"
+	RuleFileSize           = "FileSizeCheck"
+	RuleFrontMatter        = "FrontMatterCheck"
+	RuleInvalidDirectories = "InvalidDirectoriesCheck"
+	RuleLinks              = "LinksCheck"
+	RuleMixedDirectories   = "MixedDirectoriesCheck"
+	RulePathDepth          = "PathDepthCheck"
+	RuleRegistryMarkdown   = "RegistryMarkdownCheck"
+	RuleSchemaAttributes   = "SchemaAttributesCheck"
+)
+
+// Rules are the IDs of all check rules.
+var Rules = []string{
+	RuleDescriptionStyle,
+	RuleExampleFormat,
+	RuleFileCount,
+	RuleFileExtension,
+	RuleFileMismatch,
+	RuleFileSize,
+	RuleFrontMatter,
+	RuleInvalidDirectories,
+	RuleLinks,
+	RuleMixedDirectories,
+	RulePathDepth,
+	RuleRegistryMarkdown,
+	RuleSchemaAttributes,
+}
+
+// RuleError is an error found by a check, with the ID of the check rule and
+// the location of the error. The error message is unchanged.
+type RuleError struct {
+	// Rule is the ID of the check rule, such as FileExtensionCheck.
+	Rule string
+
+	// File is the path of the file or directory with the error, if known.
+	File string
+
+	// Line is the 1-based line number of the error in File, or 0 if the error
+	// applies to the whole file.
+	Line int
+
+	Err error
+}
+
+func (e *RuleError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *RuleError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+var (
+	// schemaSectionHeading matches level 2 headings of documentation sections
+	// which describe schema attributes, such as "## Schema" or
+	// "## Argument Reference".
+	schemaSectionHeading = regexp.MustCompile(`(?i)^##\s+.*\b(schema|arguments?|attributes?)\b`)
+
+	// renderedAttributeItem matches list items of attributes, as rendered by
+	// tfplugindocs, such as "- `name` (String) Description", optionally with
+	// an attribute anchor before the name.
+	renderedAttributeItem = regexp.MustCompile("^\\s*[-*+]\\s+(?:<a id=\"[^\"]*\"></a>)?`([a-z_][a-z0-9_]*)`\\s+\\(")
+
+	// renderedAttributeRow matches table rows of attributes, as rendered by
+	// tfplugindocs with the table schema style, such as
+	// "| `name` | String | Required | Description |".
+	renderedAttributeRow = regexp.MustCompile("^\\|\\s*(?:<a id=\"[^\"]*\"></a>)?`([a-z_][a-z0-9_]*)`\\s*\\|")
+
+	// handWrittenAttributeItem matches unindented list items of attributes,
+	// as commonly hand-written, such as "* `name` - (Required) Description".
+	handWrittenAttributeItem = regexp.MustCompile("^[-*+]\\s+`([a-z_][a-z0-9_]*)`\\s+[-:]")
+)
+
+type SchemaAttributesCheck struct {
+	Schema *tfjson.Schema
+
+	IdentitySchema *tfjson.IdentitySchema
+}
+
+// NewSchemaAttributesCheck returns a check of the attributes documented in
+// the schema sections of a resource, data source, ephemeral resource, or list
+// resource documentation file against its schema. The identity schema is
+// optional.
+func NewSchemaAttributesCheck(schema *tfjson.Schema, identitySchema *tfjson.IdentitySchema) *SchemaAttributesCheck {
+	return &SchemaAttributesCheck{
+		Schema:         schema,
+		IdentitySchema: identitySchema,
+	}
+}
+
+// DocumentedAttribute is an attribute list item of a documentation file.
+type DocumentedAttribute struct {
+	Name string
+
+	// Line is the 1-based line number of the list item.
+	Line int
+}
+
+// Run returns an error listing the documented attributes of the file at path,
+// with content src, which do not exist at any nesting level of the schema.
+// The error is located at the first of these attributes. Attributes are only
+// found in list items under level 2 headings mentioning a schema, arguments,
+// or attributes, outside of code blocks.
+func (check *SchemaAttributesCheck) Run(path string, src []byte) error {
+	if check.Schema == nil || check.Schema.Block == nil {
+		return nil
+	}
+
+	known := make(map[string]bool)
+	addBlockNames(known, check.Schema.Block)
+
+	if check.IdentitySchema != nil {
+		for name := range check.IdentitySchema.Attributes {
+			known[name] = true
+		}
+	}
+
+	line := 0
+	unknown := make(map[string]bool)
+
+	for _, attr := range DocumentedAttributes(src) {
+		if known[attr.Name] {
+			continue
+		}
+
+		if line == 0 {
+			line = attr.Line
+		}
+
+		unknown[attr.Name] = true
+	}
+
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(unknown))
+	for name := range unknown {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return &RuleError{
+		Rule: RuleSchemaAttributes,
+		File: path,
+		Line: line,
+		Err:  fmt.Errorf("documented attribute(s) not found in schema: %s", strings.Join(names, ", ")),
+	}
+}
+
+// DocumentedAttributes returns the attribute list items and table rows under
+// level 2 headings mentioning a schema, arguments, or attributes, outside of
+// the YAML frontmatter and code blocks, in order of appearance.
+func DocumentedAttributes(src []byte) []DocumentedAttribute {
+	var attrs []DocumentedAttribute
+
+	inSchemaSection := false
+
+	scanDocumentLines(src, func(lineNum int, line string) {
+		if strings.HasPrefix(line, "## ") {
+			inSchemaSection = schemaSectionHeading.MatchString(line)
+			return
+		}
+
+		if !inSchemaSection {
+			return
+		}
+
+		if m := renderedAttributeItem.FindStringSubmatch(line); m != nil {
+			attrs = append(attrs, DocumentedAttribute{Name: m[1], Line: lineNum})
+			return
+		}
+
+		if m := renderedAttributeRow.FindStringSubmatch(line); m != nil {
+			attrs = append(attrs, DocumentedAttribute{Name: m[1], Line: lineNum})
+			return
+		}
+
+		if m := handWrittenAttributeItem.FindStringSubmatch(line); m != nil {
+			attrs = append(attrs, DocumentedAttribute{Name: m[1], Line: lineNum})
+		}
+	})
+
+	return attrs
+}
+
+func addBlockNames(names map[string]bool, block *tfjson.SchemaBlock) {
+	if block == nil {
+		return
+	}
+
+	for name, attr := range block.Attributes {
+		names[name] = true
+		addNestedAttributeNames(names, attr.AttributeNestedType)
+	}
+
+	for name, nestedBlock := range block.NestedBlocks {
+		names[name] = true
+		addBlockNames(names, nestedBlock.Block)
+	}
+}
+
+func addNestedAttributeNames(names map[string]bool, nestedType *tfjson.SchemaNestedAttributeType) {
+	if nestedType == nil {
+		return
+	}
+
+	for name, attr := range nestedType.Attributes {
+		names[name] = true
+		addNestedAttributeNames(names, attr.AttributeNestedType)
+	}
+}
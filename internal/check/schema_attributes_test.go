@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package check
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestSchemaAttributesCheck(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"id":   {AttributeType: cty.String, Computed: true},
+				"name": {AttributeType: cty.String, Required: true},
+				"settings": {
+					AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+						NestingMode: tfjson.SchemaNestingModeSingle,
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"value": {AttributeType: cty.String, Optional: true},
+						},
+					},
+					Optional: true,
+				},
+			},
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"rule": {
+					NestingMode: tfjson.SchemaNestingModeList,
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"priority": {AttributeType: cty.Number, Optional: true},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	identitySchema := &tfjson.IdentitySchema{
+		Attributes: map[string]*tfjson.IdentityAttribute{
+			"region": {IdentityType: cty.String},
+		},
+	}
+
+	testCases := map[string]struct {
+		Source      string
+		ExpectError bool
+	}{
+		"rendered schema": {
+			Source: `---
+page_title: "example"
+---
+
+# example
+
+- ` + "`removed`" + ` (String) Not in a schema section
+
+## Schema
+
+### Required
+
+- ` + "`name`" + ` (String) Name
+
+### Optional
+
+- ` + "`rule`" + ` (Block List) (see [below for nested schema](#nestedblock--rule))
+- ` + "`settings`" + ` (Attributes) Settings
+  - ` + "`value`" + ` (String) Value
+
+### Read-Only
+
+- ` + "`id`" + ` (String) Identifier
+
+<a id="nestedblock--rule"></a>
+### Nested Schema for ` + "`rule`" + `
+
+Optional:
+
+- ` + "`priority`" + ` (Number) Priority
+
+## Import
+
+- ` + "`removed`" + ` - Not in a schema section
+`,
+		},
+		"hand-written schema": {
+			Source: `# example
+
+## Argument Reference
+
+* ` + "`name`" + ` - (Required) Name
+* ` + "`rule`" + ` - (Optional) Rule, one of:
+  * ` + "`removed`" + ` - Enumeration value
+
+## Attributes Reference
+
+* ` + "`id`" + ` - Identifier
+* ` + "`region`" + ` - Identity region
+
+` + "```" + `
+- ` + "`removed`" + ` (String) In a code block
+` + "```" + `
+`,
+		},
+		"removed attribute": {
+			Source: `## Schema
+
+- ` + "`name`" + ` (String) Name
+- ` + "`removed`" + ` (String) Removed
+`,
+			ExpectError: true,
+		},
+		"removed attribute with anchor": {
+			Source: `## Schema
+
+- <a id="attr-name"></a>` + "`name`" + ` (String) Name
+- <a id="attr-removed"></a>` + "`removed`" + ` (String) Removed
+`,
+			ExpectError: true,
+		},
+		"removed table attribute": {
+			Source: `## Schema
+
+| Name | Type | Required | Description |
+|------|------|----------|-------------|
+| ` + "`name`" + ` | String | Required | Name |
+| ` + "`removed`" + ` | String | Optional | Removed |
+`,
+			ExpectError: true,
+		},
+		"removed hand-written attribute": {
+			Source: `## Argument Reference
+
+* ` + "`removed`" + ` - (Optional) Removed
+`,
+			ExpectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := NewSchemaAttributesCheck(schema, identitySchema).Run("example.md", []byte(testCase.Source))
+
+			if got == nil && testCase.ExpectError {
+				t.Errorf("expected error, got no error")
+			}
+
+			if got != nil && !testCase.ExpectError {
+				t.Errorf("expected no error, got error: %s", got)
+			}
+		})
+	}
+}
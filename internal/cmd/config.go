@@ -0,0 +1,309 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultConfigFile is the name of the configuration file which is loaded from
+// the provider directory if the --config flag is not set.
+const defaultConfigFile = ".tfplugindocs.yml"
+
+// configFlagUsage is the usage of the --config flag of every command.
+const configFlagUsage = "path to a YAML configuration file which sets default values of the other flags; defaults to " + defaultConfigFile + " in the provider directory, if it exists"
+
+// providerDirPathOptions are the options with paths relative to the provider
+// directory, and workingDirPathOptions are the options with paths relative to
+// the working directory. Relative paths of these options in a configuration
+// file are relative to the directory of the file instead, and are converted
+// when setting the flags. The paths of pathListOptions are comma separated
+// lists, which are converted individually.
+var (
+	providerDirPathOptions = []string{
+		"attribute-defaults-file",
+		"attribute-types-file",
+		"attribute-validators-file",
+		"cache-file",
+		"deprecations-file",
+		"emit-json-model",
+		"emit-nav",
+		"emit-single-page",
+		"examples-dir",
+		"html-dir",
+		"item-metadata-file",
+		"rendered-website-dir",
+		"requires-replace-file",
+		"templates-dir",
+		"website-source-dir",
+	}
+
+	workingDirPathOptions = []string{
+		"plugin-dir",
+		"providers-schema",
+		"tf-install-dir",
+		"website-temp-dir",
+		"workspace",
+	}
+
+	pathListOptions = []string{
+		"plugin-dir",
+		"providers-schema",
+	}
+)
+
+// configCommands are the commands which support a configuration file section.
+var configCommands = map[string]func() *flag.FlagSet{
+	"coverage":         func() *flag.FlagSet { return (&coverageCmd{}).Flags() },
+	"extract-examples": func() *flag.FlagSet { return (&extractExamplesCmd{}).Flags() },
+	"generate":         func() *flag.FlagSet { return (&generateCmd{}).Flags() },
+	"migrate":          func() *flag.FlagSet { return (&migrateCmd{}).Flags() },
+	"serve":            func() *flag.FlagSet { return (&serveCmd{}).Flags() },
+	"validate":         func() *flag.FlagSet { return (&validateCmd{}).Flags() },
+}
+
+// applyConfigFile sets the flags of the named command which were not set on
+// the command line from the configuration file. Top-level configuration file
+// options apply to every command with a flag of the same name, while options
+// in a section named after the command only apply to that command and take
+// precedence over top-level options, for example:
+//
+//	provider-name: scaffolding
+//	generate:
+//	  schema-style: legacy
+//
+// If configPath is empty, the default configuration file in the provider
+// directory is used if it exists. Relative paths in the configuration file are
+// relative to its directory. The provider directory cannot be set in the
+// configuration file, as the default configuration file is located in it.
+func applyConfigFile(fs *flag.FlagSet, command, configPath, providerDir string) error {
+	if configPath == "" {
+		configPath = filepath.Join(providerDir, defaultConfigFile)
+
+		if _, err := os.Stat(configPath); errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return fmt.Errorf("unable to read config file %q: %w", configPath, err)
+	}
+
+	var config map[string]interface{}
+	err = yaml.Unmarshal(data, &config)
+	if err != nil {
+		return fmt.Errorf("unable to parse config file %q: %w", configPath, err)
+	}
+
+	options, err := configOptions(config, command)
+	if err != nil {
+		return fmt.Errorf("invalid config file %q: %w", configPath, err)
+	}
+
+	if _, ok := options["provider-dir"]; ok {
+		return fmt.Errorf("invalid config file %q: option \"provider-dir\" is not supported, as the config file is located by the provider directory", configPath)
+	}
+
+	err = setUnsetFlags(fs, options, filepath.Dir(configPath), providerDir)
+	if err != nil {
+		return fmt.Errorf("invalid config file %q: %w", configPath, err)
+	}
+
+	return nil
+}
+
+// setUnsetFlags sets the flags which were not set on the command line, or
+// otherwise already set, from the options, by flag name. Options without a
+// flag in the flag set are ignored. Relative paths of the options are relative
+// to configDir.
+func setUnsetFlags(fs *flag.FlagSet, options map[string]string, configDir, providerDir string) error {
+	setFlags := make(map[string]bool)
+	fs.Visit(func(f *flag.Flag) {
+		setFlags[f.Name] = true
+	})
+
+	for _, name := range sortedNames(options) {
+		if setFlags[name] || fs.Lookup(name) == nil {
+			continue
+		}
+
+		value, err := configPathValue(name, options[name], configDir, providerDir)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for option %q: %w", options[name], name, err)
+		}
+
+		err = fs.Set(name, value)
+		if err != nil {
+			return fmt.Errorf("invalid value %q for option %q: %w", options[name], name, err)
+		}
+	}
+
+	return nil
+}
+
+// configOptions returns the configuration file options, by flag name, for the
+// named command. Top-level options are overridden by options in the command
+// section.
+func configOptions(config map[string]interface{}, command string) (map[string]string, error) {
+	options := make(map[string]string)
+
+	for _, name := range sortedNames(config) {
+		value := config[name]
+
+		if newFlagSet, ok := configCommands[name]; ok {
+			section, ok := value.(map[string]interface{})
+			if !ok && value != nil {
+				return nil, fmt.Errorf("expected %q to be a mapping of options", name)
+			}
+
+			fs := newFlagSet()
+			for _, optionName := range sortedNames(section) {
+				if optionName == "config" || fs.Lookup(optionName) == nil {
+					return nil, fmt.Errorf("unknown option %q in %q section", optionName, name)
+				}
+			}
+
+			continue
+		}
+
+		if !isCommandFlag(name) {
+			return nil, fmt.Errorf("unknown option %q", name)
+		}
+
+		option, err := configValue(name, value)
+		if err != nil {
+			return nil, err
+		}
+
+		options[name] = option
+	}
+
+	section, _ := config[command].(map[string]interface{})
+	for _, name := range sortedNames(section) {
+		option, err := configValue(name, section[name])
+		if err != nil {
+			return nil, err
+		}
+
+		options[name] = option
+	}
+
+	return options, nil
+}
+
+// configPathValue returns the flag value of a path option, which is relative
+// to the configuration file directory, as a path relative to the provider
+// directory or working directory, the same as the flag. Other options,
+// absolute paths, and the stdin ("-") and URL values of the providers schema
+// list are returned unmodified.
+func configPathValue(name, value, configDir, providerDir string) (string, error) {
+	if slices.Contains(pathListOptions, name) {
+		paths := splitList(value)
+		for i, path := range paths {
+			if path == "-" || filepath.IsAbs(path) || strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://") {
+				continue
+			}
+
+			paths[i] = filepath.Join(configDir, path)
+		}
+
+		return strings.Join(paths, ","), nil
+	}
+
+	if value == "" || filepath.IsAbs(value) {
+		return value, nil
+	}
+
+	path := filepath.Join(configDir, value)
+
+	switch {
+	case slices.Contains(workingDirPathOptions, name):
+		return path, nil
+	case slices.Contains(providerDirPathOptions, name):
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			return "", err
+		}
+
+		absProviderDir, err := filepath.Abs(providerDir)
+		if err != nil {
+			return "", err
+		}
+
+		return filepath.Rel(absProviderDir, absPath)
+	}
+
+	return value, nil
+}
+
+// configValue returns the flag value of a configuration file option.
+func configValue(name string, value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool, int, float64:
+		return fmt.Sprint(v), nil
+	case nil:
+		return "", nil
+	case []interface{}:
+		// Lists are set as comma separated flag values.
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			switch item.(type) {
+			case string, bool, int, float64:
+				values = append(values, fmt.Sprint(item))
+			default:
+				return "", fmt.Errorf("unsupported list item for option %q, expected a string, number, or boolean", name)
+			}
+		}
+		return strings.Join(values, ","), nil
+	case map[string]interface{}:
+		// Mappings are set as comma separated <key>=<value> flag values,
+		// such as the rule severities of the validate command.
+		values := make([]string, 0, len(v))
+		for _, key := range sortedNames(v) {
+			switch item := v[key].(type) {
+			case string, bool, int, float64:
+				values = append(values, key+"="+fmt.Sprint(item))
+			default:
+				return "", fmt.Errorf("unsupported mapping value for option %q, expected a string, number, or boolean", name)
+			}
+		}
+		return strings.Join(values, ","), nil
+	default:
+		return "", fmt.Errorf("unsupported value for option %q, expected a string, number, boolean, list, or mapping", name)
+	}
+}
+
+// isCommandFlag returns true if any of the configuration file commands has a
+// flag with the given name.
+func isCommandFlag(name string) bool {
+	for _, newFlagSet := range configCommands {
+		if name != "config" && newFlagSet().Lookup(name) != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func sortedNames[V any](m map[string]V) []string {
+	names := make([]string, 0, len(m))
+	for name := range m {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/provider"
+)
+
+type coverageCmd struct {
+	commonCmd
+
+	flagConfig string
+
+	flagProviderName    string
+	flagProviderDir     string
+	flagProvidersSchema string
+	flagIgnore          string
+	flagFormat          string
+	flagMinCoverage     float64
+	tfVersion           string
+	tfBinary            string
+	tfInstallDir        string
+	flagUseOpenTofu     bool
+	flagOffline         bool
+}
+
+func (cmd *coverageCmd) Synopsis() string {
+	return "reports the percentage of resources, data sources, functions, and attributes with descriptions in the provider schema"
+}
+
+func (cmd *coverageCmd) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugindocs coverage [<args>] [<providers schema JSON file>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *coverageCmd) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("coverage", flag.ExitOnError)
+	fs.StringVar(&cmd.flagConfig, "config", "", configFlagUsage)
+	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "provider name, as used in Terraform configurations; defaults to the --provider-dir short name (after removing `terraform-provider-` prefix), or with --providers-schema, to the only provider of the schema file")
+	fs.StringVar(&cmd.flagIgnore, "ignore", "", "comma separated glob patterns of resource, data source, ephemeral resource, list resource, action, and function names to exclude from the coverage, in addition to the patterns in the .tfplugindocsignore file (ex. aws_internal_*,data-sources/aws_legacy_*)")
+	fs.StringVar(&cmd.flagProviderDir, "provider-dir", "", "relative or absolute path to the root provider code directory; this will default to the current working directory if not set")
+	fs.StringVar(&cmd.flagProvidersSchema, "providers-schema", "", "path to the providers schema JSON file, which contains the output of the terraform providers schema -json command, \"-\" to read it from stdin, or an HTTP(S) URL to fetch it from; comma separated paths are merged, such as the separately exported schemas of a muxed provider. Setting this flag will skip building the provider and calling Terraform CLI; the file can also be passed as an argument")
+	fs.StringVar(&cmd.flagFormat, "format", provider.CoverageFormatMarkdown, "output format of the report, either markdown or json")
+	fs.Float64Var(&cmd.flagMinCoverage, "min-coverage", 0, "minimum percentage of described items, attributes, and function parameters; the command fails if the coverage is lower")
+	fs.StringVar(&cmd.tfVersion, "tf-version", "", "exact terraform binary version to use, which is found in the local environment or --tf-install-dir, or downloaded. If not provided, will look for any terraform binary in the local environment or --tf-install-dir. If not found, will download the latest version of Terraform")
+	fs.StringVar(&cmd.tfBinary, "tf-binary", "", "path, or name in PATH, of a Terraform or OpenTofu CLI binary to export the provider schema with, instead of finding or downloading Terraform (ex. /usr/local/bin/tofu)")
+	fs.StringVar(&cmd.tfInstallDir, "tf-install-dir", "", "directory in which downloaded terraform binaries are kept, in a subdirectory named after the --tf-version or latest, and reused by later runs instead of downloading them again (ex. a CI cache directory)")
+	fs.BoolVar(&cmd.flagOffline, "offline", false, "fail instead of accessing the network to export the provider schema: terraform is not downloaded, the provider is built with GOPROXY=off, and --providers-schema URLs are not fetched")
+	fs.BoolVar(&cmd.flagUseOpenTofu, "use-opentofu", false, "export the provider schema with the OpenTofu CLI binary (tofu) in PATH, or --tf-binary if set, instead of Terraform")
+	cmd.logFlags(fs)
+	return fs
+}
+
+func (cmd *coverageCmd) Run(args []string) int {
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to parse flags: %s", err))
+		return 1
+	}
+
+	switch {
+	case fs.NArg() > 1:
+		cmd.ui.Error(fmt.Sprintf("expected at most 1 argument, the providers schema JSON file, got %d", fs.NArg()))
+		cmd.ui.Error(cmd.Help())
+		return 1
+	case fs.NArg() == 1 && cmd.flagProvidersSchema != "":
+		cmd.ui.Error("the providers schema JSON file argument cannot be used with --providers-schema")
+		return 1
+	case fs.NArg() == 1:
+		// The argument is set as the flag, so the configuration file does not
+		// override it.
+		err = fs.Set("providers-schema", fs.Arg(0))
+		if err != nil {
+			cmd.ui.Error(fmt.Sprintf("unable to set providers schema: %s", err))
+			return 1
+		}
+	}
+
+	err = applyConfigFile(fs, "coverage", cmd.flagConfig, cmd.flagProviderDir)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to load config file: %s", err))
+		return 1
+	}
+
+	return cmd.run(cmd.runInternal)
+}
+
+func (cmd *coverageCmd) runInternal() error {
+	err := provider.Coverage(cmd.ui, provider.CoverageOptions{
+		ProviderDir:         cmd.flagProviderDir,
+		ProviderName:        cmd.flagProviderName,
+		ProvidersSchemaPath: cmd.flagProvidersSchema,
+		TFVersion:           cmd.tfVersion,
+		TFBinary:            cmd.tfBinary,
+		TFInstallDir:        cmd.tfInstallDir,
+		UseOpenTofu:         cmd.flagUseOpenTofu,
+		Offline:             cmd.flagOffline,
+		Ignore:              splitList(cmd.flagIgnore),
+		Format:              cmd.flagFormat,
+		MinCoverage:         cmd.flagMinCoverage,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to check description coverage: %w", err)
+	}
+
+	return nil
+}
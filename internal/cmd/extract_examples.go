@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/provider"
+)
+
+type extractExamplesCmd struct {
+	commonCmd
+
+	flagConfig string
+
+	flagProviderDir string
+	flagExamplesDir string
+	flagCheck       bool
+}
+
+func (cmd *extractExamplesCmd) Synopsis() string {
+	return "extracts the Terraform configurations of acceptance tests marked with a tfplugindocs:example comment into the examples directory"
+}
+
+func (cmd *extractExamplesCmd) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugindocs extract-examples [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *extractExamplesCmd) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("extract-examples", flag.ExitOnError)
+	fs.StringVar(&cmd.flagConfig, "config", "", configFlagUsage)
+
+	fs.StringVar(&cmd.flagProviderDir, "provider-dir", "", "relative or absolute path to the root provider code directory, which is searched for test files; this will default to the current working directory if not set")
+	fs.StringVar(&cmd.flagExamplesDir, "examples-dir", "examples", "examples directory based on provider-dir; extracted examples are written to the path of their comment directive relative to this directory")
+	fs.BoolVar(&cmd.flagCheck, "check", false, "extract examples without writing files and exit with an error if the examples directory is out of date with the acceptance tests")
+	cmd.logFlags(fs)
+
+	return fs
+}
+
+func (cmd *extractExamplesCmd) Run(args []string) int {
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to parse flags: %s", err))
+		return 1
+	}
+
+	err = applyConfigFile(fs, "extract-examples", cmd.flagConfig, cmd.flagProviderDir)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to load config file: %s", err))
+		return 1
+	}
+
+	return cmd.run(cmd.runInternal)
+}
+
+func (cmd *extractExamplesCmd) runInternal() error {
+	err := provider.ExtractExamples(
+		cmd.ui,
+		cmd.flagProviderDir,
+		cmd.flagExamplesDir,
+		cmd.flagCheck,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to extract examples: %w", err)
+	}
+
+	return nil
+}
@@ -14,10 +14,80 @@ import (
 type generateCmd struct {
 	commonCmd
 
-	flagIgnoreDeprecated bool
+	flagConfig    string
+	flagWorkspace string
 
-	flagProviderName         string
-	flagRenderedProviderName string
+	flagIgnoreDeprecated    bool
+	flagFailOnEmptyDesc     bool
+	flagValidateExamples    bool
+	flagCheck               bool
+	flagDryRun              bool
+	flagReproducible        bool
+	flagStripExampleHeaders bool
+	flagFormatExamples      bool
+	flagGenerateExamples    bool
+	flagDebugTemplates      bool
+	flagStrictMetadata      bool
+	flagFailFast            bool
+	flagProgress            bool
+	flagStats               bool
+	flagAttributeAnchors    bool
+	flagCollapsibleNested   bool
+	flagWriteOnlySection    bool
+	flagTimeoutsSection     bool
+	flagSensitiveSection    bool
+	flagLinkItemMentions    bool
+	flagDeprecationsGuide   bool
+	flagUseOpenTofu         bool
+	flagOffline             bool
+	flagParallel            int
+	flagInlineNestedDepth   int
+	flagMaxNestedDepth      int
+	flagInlineObjectMax     int
+	flagDescMaxLength       int
+	flagDescFirstSentence   bool
+	flagDescOmitLinkURLs    bool
+	flagSplitPageSize       int
+
+	flagProviderName          string
+	flagIgnore                string
+	flagOnly                  string
+	flagRenderedProviderName  string
+	flagProviderVersion       string
+	flagProviderSource        string
+	flagRegistryProvider      string
+	flagRegistryVersion       string
+	flagSchemaStyle           string
+	flagSchemaGroupOrder      string
+	flagAttributeSort         string
+	flagTypeSyntax            string
+	flagAttributeOrder        string
+	flagHeadings              string
+	flagLocales               string
+	flagOutputExtension       string
+	flagFrontmatterDialect    string
+	flagOutputFormat          string
+	flagHTMLDir               string
+	flagEmitJSONModel         string
+	flagEmitNav               string
+	flagNavFormat             string
+	flagEmitSinglePage        string
+	flagCacheFile             string
+	flagAttributeDefaults     string
+	flagAttributeValidators   string
+	flagRequiresReplace       string
+	flagDeprecations          string
+	flagAttributeTypes        string
+	flagItemMetadata          string
+	flagDeprecatedSubcategory string
+	flagTemplateEnv           string
+	flagTemplateFuncsPlugin   string
+	flagPostProcessCmd        string
+	flagPreGenerateCmd        string
+	flagPostGenerateCmd       string
+	flagExampleSyntax         string
+	flagExampleValues         string
+	flagBuildTimestamp        string
 
 	flagProviderDir        string
 	flagProvidersSchema    string
@@ -26,6 +96,9 @@ type generateCmd struct {
 	flagWebsiteTmpDir      string
 	flagWebsiteSourceDir   string
 	tfVersion              string
+	tfBinary               string
+	tfInstallDir           string
+	flagPluginDir          string
 }
 
 func (cmd *generateCmd) Synopsis() string {
@@ -71,17 +144,93 @@ func (cmd *generateCmd) Help() string {
 }
 
 func (cmd *generateCmd) Flags() *flag.FlagSet {
+	defaults := provider.DefaultGenerateOptions()
+
 	fs := flag.NewFlagSet("generate", flag.ExitOnError)
+	fs.StringVar(&cmd.flagConfig, "config", "", configFlagUsage)
+	fs.StringVar(&cmd.flagWorkspace, "workspace", "", "path to a YAML workspace file listing the provider directories, relative to the file, and per-provider flag values of a repository with multiple providers, to generate documentation for each provider in a single run with a combined summary; cannot be used with --provider-dir")
 	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "provider name, as used in Terraform configurations; defaults to the --provider-dir short name (after removing `terraform-provider-` prefix)")
+	fs.StringVar(&cmd.flagIgnore, "ignore", "", "comma separated glob patterns of resource, data source, ephemeral resource, list resource, action, and function names to exclude from documentation, in addition to the patterns in the .tfplugindocsignore file (ex. aws_internal_*,data-sources/aws_legacy_*)")
+	fs.StringVar(&cmd.flagOnly, "only", "", "comma separated glob patterns of resource, data source, ephemeral resource, list resource, action, and function names to generate, without generating any other files or cleaning the rendered website directory (ex. resources/aws_s3_*)")
 	fs.StringVar(&cmd.flagProviderDir, "provider-dir", "", "relative or absolute path to the root provider code directory when running the command outside the root provider code directory")
-	fs.StringVar(&cmd.flagProvidersSchema, "providers-schema", "", "path to the providers schema JSON file, which contains the output of the terraform providers schema -json command. Setting this flag will skip building the provider and calling Terraform CLI")
+	fs.StringVar(&cmd.flagProvidersSchema, "providers-schema", "", "path to the providers schema JSON file, which contains the output of the terraform providers schema -json command, \"-\" to read it from stdin, or an HTTP(S) URL to fetch it from; comma separated paths are merged, such as the separately exported schemas of a muxed provider. Setting this flag will skip building the provider and calling Terraform CLI")
 	fs.StringVar(&cmd.flagRenderedProviderName, "rendered-provider-name", "", "provider name, as generated in documentation (ex. page titles, ...)")
-	fs.StringVar(&cmd.flagRenderedWebsiteDir, "rendered-website-dir", "docs", "output directory based on provider-dir")
-	fs.StringVar(&cmd.flagExamplesDir, "examples-dir", "examples", "examples directory based on provider-dir")
+	fs.StringVar(&cmd.flagProviderVersion, "provider-version", "", "version of the provider, available to templates as .ProviderVersion (ex. 1.2.0 or v1.2.0)")
+	fs.StringVar(&cmd.flagProviderSource, "provider-source", "", "source address of the provider, as used in required_providers blocks (ex. hashicorp/random); defaults to hashicorp/<provider short name>")
+	fs.StringVar(&cmd.flagRegistryProvider, "registry-provider", "", "source address of a published provider (ex. hashicorp/aws) to install with Terraform and generate documentation from, without the provider code directory; defaults --provider-name, --provider-source, and --provider-version")
+	fs.StringVar(&cmd.flagRegistryVersion, "registry-version", "", "exact version of the --registry-provider to install (ex. 5.60.0); defaults to the latest version")
+	fs.StringVar(&cmd.flagRenderedWebsiteDir, "rendered-website-dir", defaults.RenderedWebsiteDir, "output directory based on provider-dir")
+	fs.StringVar(&cmd.flagExamplesDir, "examples-dir", defaults.ExamplesDir, "examples directory based on provider-dir")
 	fs.StringVar(&cmd.flagWebsiteTmpDir, "website-temp-dir", "", "temporary directory (used during generation)")
-	fs.StringVar(&cmd.flagWebsiteSourceDir, "website-source-dir", "templates", "templates directory based on provider-dir")
-	fs.StringVar(&cmd.tfVersion, "tf-version", "", "terraform binary version to download. If not provided, will look for a terraform binary in the local environment. If not found in the environment, will download the latest version of Terraform")
+	fs.StringVar(&cmd.flagWebsiteSourceDir, "website-source-dir", defaults.TemplatesDir, "templates directory based on provider-dir")
+	fs.StringVar(&cmd.tfVersion, "tf-version", "", "exact terraform binary version to use, which is found in the local environment or --tf-install-dir, or downloaded. If not provided, will look for any terraform binary in the local environment or --tf-install-dir. If not found, will download the latest version of Terraform")
+	fs.StringVar(&cmd.tfBinary, "tf-binary", "", "path, or name in PATH, of a Terraform or OpenTofu CLI binary to export the provider schema with, instead of finding or downloading Terraform (ex. /usr/local/bin/tofu)")
+	fs.StringVar(&cmd.tfInstallDir, "tf-install-dir", "", "directory in which downloaded terraform binaries are kept, in a subdirectory named after the --tf-version or latest, and reused by later runs instead of downloading them again (ex. a CI cache directory)")
+	fs.BoolVar(&cmd.flagOffline, "offline", false, "fail instead of accessing the network to export the provider schema: terraform is not downloaded, the provider is built with GOPROXY=off, --providers-schema URLs are not fetched, and the --registry-provider is only installed from --plugin-dir or TF_PLUGIN_CACHE_DIR")
+	fs.StringVar(&cmd.flagPluginDir, "plugin-dir", "", "comma separated provider plugin directories, in the layout of a terraform filesystem mirror or plugin cache, to install the --registry-provider from instead of the registry, as with terraform init -plugin-dir")
+	fs.BoolVar(&cmd.flagUseOpenTofu, "use-opentofu", false, "export the provider schema with the OpenTofu CLI binary (tofu) in PATH, or --tf-binary if set, instead of Terraform")
 	fs.BoolVar(&cmd.flagIgnoreDeprecated, "ignore-deprecated", false, "don't generate documentation for deprecated resources and data-sources")
+	fs.BoolVar(&cmd.flagIgnoreDeprecated, "skip-deprecated", false, "alias of --ignore-deprecated")
+	fs.StringVar(&cmd.flagDeprecatedSubcategory, "deprecated-subcategory", "", "subcategory of deprecated resources, data sources, and other items, which overrides the subcategory file, to group them in the Terraform Registry navigation (ex. Deprecated); cannot be used with --ignore-deprecated")
+	fs.BoolVar(&cmd.flagFailOnEmptyDesc, "fail-on-empty-description", false, "exit with an error listing the resources, data sources, functions, attributes, and function parameters without a description")
+	fs.BoolVar(&cmd.flagValidateExamples, "validate-examples", false, "exit with an error listing the arguments and blocks of the provider, resources, data sources, ephemeral resources, and actions in the Terraform files of the examples directory which are not in the provider schema, or are read-only, such as removed attributes")
+	fs.IntVar(&cmd.flagParallel, "parallel", defaults.Parallel, "number of resource, data source, and function pages to render concurrently")
+	fs.StringVar(&cmd.flagSchemaStyle, "schema-style", defaults.SchemaStyle, "layout of rendered schemas: default (Required, Optional, and Read-Only sections), legacy (Argument Reference and Attributes Reference sections), or table (Markdown tables of name, type, required, and description)")
+	fs.StringVar(&cmd.flagSchemaGroupOrder, "schema-group-order", "", "comma separated order of the required, optional, and read-only groups of rendered schemas with the default schema style (ex. required,read-only,optional)")
+	fs.StringVar(&cmd.flagAttributeSort, "attribute-sort", defaults.AttributeSort, "sort order of the attributes and blocks of rendered schemas: alphabetical (by name within each group) or required-first (a single list ordered by group, then name, with the group of each attribute in its summary)")
+	fs.StringVar(&cmd.flagTypeSyntax, "type-syntax", defaults.TypeSyntax, "syntax of the types of attributes of rendered schemas: default (ex. Map of String) or terraform (Terraform type constraints, ex. map(string))")
+	fs.StringVar(&cmd.flagAttributeOrder, "attribute-order", "", "comma separated names, or dot separated paths, of attributes and blocks of rendered schemas to order before the others within their group, with entries after * ordered after the others (ex. name,*,id)")
+	fs.StringVar(&cmd.flagLocales, "locales", "", "comma separated locales to additionally generate translated documentation for, into the rendered website directory suffixed with the locale (ex. docs-ja), from the templates and examples subdirectories of each locale (ex. templates/ja) over the default ones, with headings from the templates/<locale>/messages.yml message catalog (ex. ja,pt-BR)")
+	fs.StringVar(&cmd.flagHeadings, "headings", "", "comma separated text of headings of default templates and rendered schemas, as <name>=<text> where the name is example-usage, import, schema, nested-schema, required, optional, read-only, write-only, timeouts, sensitive-attributes, related-resources, argument-reference, or attributes-reference (ex. schema=Arguments,read-only=Exported Attributes)")
+	fs.IntVar(&cmd.flagInlineNestedDepth, "inline-nested-depth", 0, "number of nesting levels of nested attributes and blocks to render inline under their parent, instead of in separate nested schema sections")
+	fs.IntVar(&cmd.flagInlineObjectMax, "inline-object-max-attributes", 0, "number of attributes of object attribute types up to which their attributes are listed after the description of the attribute, instead of in a nested schema section; objects with attributes of object types are always rendered in sections")
+	fs.IntVar(&cmd.flagMaxNestedDepth, "max-nested-depth", 0, "number of nesting levels of nested attributes and blocks to render, beyond which nested schemas are replaced by a note with a summary of their type, for deeply nested or recursive types; 0 renders all levels")
+	fs.BoolVar(&cmd.flagStripExampleHeaders, "strip-example-headers", false, "remove leading copyright and license header comments, and directive comments (ex. # noqa), from example files included by the codefile and tffile template functions")
+	fs.BoolVar(&cmd.flagFormatExamples, "format-examples", false, "format the Terraform example files included by the codefile (with the terraform or hcl format) and tffile template functions in the canonical style of terraform fmt, without changing the files")
+	fs.StringVar(&cmd.flagExampleValues, "example-values", "", "comma separated values of the @@<name>@@ placeholder tokens of the example files included by the codefile and tffile template functions, as <name>=<value>, which replace the tokens when rendering; tokens without a value are kept (ex. VERSION=1.2.0,REGION=us-east-1)")
+	fs.BoolVar(&cmd.flagGenerateExamples, "generate-examples", false, "synthesize a skeleton example with the required attributes and blocks of resources, data sources, ephemeral resources, list resources, and actions without an example file, with placeholder values derived from their types, which the default templates render as the example usage")
+	fs.StringVar(&cmd.flagExampleSyntax, "example-syntax", defaults.ExampleSyntax, "severity of the syntax errors of the Terraform example files included by the codefile (with the terraform or hcl format) and tffile template functions, which are reported with their file and line: error fails generation, warn outputs them as warnings, and off does not check the syntax of examples")
+	fs.StringVar(&cmd.flagOutputExtension, "output-extension", defaults.OutputExtension, "file extension, and dialect, of rendered template files: .md, .markdown, .html.markdown (legacy website frontmatter layout), or .mdx (MDX escaping)")
+	fs.StringVar(&cmd.flagOutputFormat, "output-format", defaults.OutputFormat, "output format: markdown (rendered website directory) or html (rendered website directory and a static HTML site in html-dir)")
+	fs.StringVar(&cmd.flagHTMLDir, "html-dir", defaults.HTMLDir, "static HTML site directory based on provider-dir, which is replaced when using the html output format")
+	fs.IntVar(&cmd.flagDescMaxLength, "frontmatter-description-max-length", 0, "maximum number of characters of the plain text description frontmatter of the default templates, and of the plainmarkdown template function, beyond which it is truncated at a word boundary; 0 does not limit it")
+	fs.BoolVar(&cmd.flagDescFirstSentence, "frontmatter-description-first-sentence", false, "keep only the first sentence of the plain text description frontmatter of the default templates, and of the plainmarkdown template function")
+	fs.BoolVar(&cmd.flagDescOmitLinkURLs, "frontmatter-description-omit-link-urls", false, "write only the text of links, without their URL, in the plain text description frontmatter of the default templates, and of the plainmarkdown template function")
+	fs.IntVar(&cmd.flagSplitPageSize, "split-page-size", 0, "size in bytes above which the nested schema sections of rendered resource, data source, and other item pages are moved into sibling pages, largest first; 0 does not split pages")
+	fs.StringVar(&cmd.flagFrontmatterDialect, "frontmatter-dialect", defaults.FrontmatterDialect, "dialect of the frontmatter of rendered template files: registry (unmodified YAML), hugo (TOML), or docusaurus (YAML with sidebar_label and slug keys)")
+	fs.StringVar(&cmd.flagEmitJSONModel, "emit-json-model", "", "path, relative to provider-dir, of a JSON file to write the documentation model (provider, resources, attributes, types, descriptions, examples, and functions) to, for use by external site generators")
+	fs.StringVar(&cmd.flagEmitNav, "emit-nav", "", "path, relative to provider-dir, of a file to write the navigation of the rendered website (provider index, guides, and generated items grouped by subcategory) to, for use by external site generators")
+	fs.StringVar(&cmd.flagNavFormat, "nav-format", defaults.NavFormat, "format of the navigation file: json (generic tree), mkdocs (MkDocs nav YAML), or docusaurus (Docusaurus sidebars.js)")
+	fs.StringVar(&cmd.flagEmitSinglePage, "emit-single-page", "", "path, relative to provider-dir, of a file to write all rendered pages to as a single document with a table of contents, for offline review; written as HTML if the path ends with .html, otherwise as Markdown")
+	fs.StringVar(&cmd.flagCacheFile, "cache-file", "", "path, relative to provider-dir, of a cache file with content hashes of the schema, template, and examples of each resource, data source, and function page, to skip rendering unchanged pages on subsequent runs")
+	fs.StringVar(&cmd.flagAttributeDefaults, "attribute-defaults-file", "", "path, relative to provider-dir, of a JSON file with default values of attributes by item and attribute path, rendered as \"Defaults to `X`.\" after attribute descriptions, for providers schema JSONs which do not include default values")
+	fs.StringVar(&cmd.flagAttributeValidators, "attribute-validators-file", "", "path, relative to provider-dir, of a JSON file with validators of attributes by item and attribute path, such as allowed values and ranges, rendered as sentences (ex. \"Allowed values: `a`, `b`.\") after attribute descriptions, for providers schema JSONs which do not include validators")
+	fs.StringVar(&cmd.flagRequiresReplace, "requires-replace-file", "", "path, relative to provider-dir, of a JSON file which marks attributes by item and attribute path which force replacement of their resource, rendered with a \"Changing this forces a new resource to be created.\" note after attribute descriptions, for providers schema JSONs which do not mark them")
+	fs.StringVar(&cmd.flagAttributeTypes, "attribute-types-file", "", "path, relative to provider-dir, of a JSON file with names rendered instead of the types of attributes by item and attribute path, such as the names of custom types of a provider framework, which providers schema JSONs only contain the underlying type of")
+	fs.StringVar(&cmd.flagItemMetadata, "item-metadata-file", "", "path, relative to provider-dir, of a JSON, or YAML if it has a .yaml or .yml extension, file with metadata of resources, data sources, and other items by name, such as the related resources, page title, subcategory, and additional frontmatter of the default templates")
+	fs.StringVar(&cmd.flagDeprecations, "deprecations-file", "", "path, relative to provider-dir, of a JSON file with replacements of deprecated resources, data sources, and attributes by item and attribute path, rendered as \"Use `X` instead.\" after the deprecation notice of items and after attribute descriptions")
+	fs.BoolVar(&cmd.flagAttributeAnchors, "attribute-anchors", false, "write an HTML anchor before every attribute and block of rendered schemas, with the lowercase path joined by hyphens (ex. attr-versioning-enabled), for deep links to attributes")
+	fs.BoolVar(&cmd.flagCollapsibleNested, "collapsible-nested-schemas", false, "wrap nested schema sections of rendered schemas in HTML <details> elements, which are collapsed by default, for output targets which render HTML (not supported by the Terraform Registry)")
+	fs.BoolVar(&cmd.flagWriteOnlySection, "write-only-section", false, "render write-only attributes of rendered schemas in a \"Write-Only Arguments\" section of their own, which explains that they are not persisted to the plan or state, instead of in the Required or Optional group")
+	fs.BoolVar(&cmd.flagTimeoutsSection, "timeouts-section", false, "render the timeouts block, or attribute, of rendered schemas in a \"Timeouts\" section of its own, which lists the create, read, update, and delete timeouts with their default values, instead of in a nested schema section")
+	fs.BoolVar(&cmd.flagLinkItemMentions, "link-item-mentions", false, "convert code spans of rendered pages which only contain the name of a generated resource or data source (ex. aws_iam_role or data.aws_iam_role) into relative links to its page, except in frontmatter, headings, code blocks, and existing links")
+	fs.BoolVar(&cmd.flagSensitiveSection, "sensitive-attributes-section", false, "list the sensitive attributes of resources, data sources, and other items in a \"Sensitive Attributes\" section of the default templates, with a warning that their values are stored in plain text in the state")
+	fs.BoolVar(&cmd.flagDeprecationsGuide, "deprecations-guide", false, "generate a guides/deprecations.md page which lists the deprecated resources, data sources, and attributes of the provider with links to their pages, unless the page has a template or static file")
+	fs.StringVar(&cmd.flagTemplateEnv, "template-env", "", "comma separated names of environment variables which templates can read with the env template function, which fails for other variables (ex. GITHUB_SHA,GITHUB_REF_NAME)")
+	fs.StringVar(&cmd.flagTemplateFuncsPlugin, "template-funcs-plugin", "", "path, or name in PATH, of an external binary which provides additional template functions: it is run with the functions argument to list their names as a JSON array, and with the call and function name arguments for each call, with the JSON array of arguments on stdin and the JSON result on stdout")
+	fs.StringVar(&cmd.flagPostProcessCmd, "post-process-cmd", "", "command, a program and its arguments separated by spaces, which rewrites each rendered page before it is written: it is run with the path of the page relative to the rendered website directory as an additional argument, the content of the page on stdin, and the rewritten content on stdout")
+	fs.StringVar(&cmd.flagPreGenerateCmd, "pre-generate-cmd", "", "command, a program and its arguments separated by spaces, which is run in the provider directory before the provider schema is read (ex. to regenerate examples), with TFPLUGINDOCS_PROVIDER_DIR, TFPLUGINDOCS_PROVIDER_NAME, TFPLUGINDOCS_RENDERED_WEBSITE_DIR, TFPLUGINDOCS_EXAMPLES_DIR, and TFPLUGINDOCS_TEMPLATES_DIR environment variables")
+	fs.StringVar(&cmd.flagPostGenerateCmd, "post-generate-cmd", "", "command, a program and its arguments separated by spaces, which is run in the provider directory after the website is written (ex. to run formatters), with the environment variables of --pre-generate-cmd and TFPLUGINDOCS_PAGES_RENDERED and TFPLUGINDOCS_PAGES_UNCHANGED, except with --check or --dry-run")
+	fs.StringVar(&cmd.flagBuildTimestamp, "build-timestamp", "", "RFC 3339 timestamp returned by the buildinfo template function instead of the time of the generation, for reproducible output (ex. 2024-01-02T15:04:05Z)")
+	fs.BoolVar(&cmd.flagReproducible, "reproducible", false, "render byte-identical files for the same inputs: without --build-timestamp, the timestamp of the buildinfo template function is read from the SOURCE_DATE_EPOCH environment variable, or is the Unix epoch")
+	fs.BoolVar(&cmd.flagDebugTemplates, "debug-templates", false, "output the template, or static file, which each generated page is rendered from, and whether it was chosen by exact name, as the type default template (ex. templates/data-sources.md.tmpl), or as the built-in default template")
+	fs.BoolVar(&cmd.flagStrictMetadata, "strict-metadata", false, "exit with an error listing the items and attribute paths of the attribute defaults, validators, requires replace, deprecations, attribute types, and item metadata files which do not exist in the provider schema, instead of ignoring them")
+	fs.BoolVar(&cmd.flagFailFast, "fail-fast", false, "stop at the first template which fails to render, instead of rendering the other pages and reporting the errors of all failed templates at the end")
+	fs.BoolVar(&cmd.flagProgress, "progress", false, "output the duration of each phase of the generation (schema, templates, render, and write) when it finishes, and the number of files rendered after each tenth of the files, for large providers")
+	fs.BoolVar(&cmd.flagStats, "stats", false, "output a summary of the number of pages rendered, unchanged, and split, static files copied, and the duration of each phase at the end of the generation")
+	fs.BoolVar(&cmd.flagCheck, "check", false, "render documentation without writing files and exit with an error if the rendered website directory is out of date")
+	fs.BoolVar(&cmd.flagDryRun, "dry-run", false, "render documentation without writing files and report which files in the rendered website directory would be created, updated (with added and removed line counts), or deleted")
+	cmd.logFlags(fs)
 	return fs
 }
 
@@ -93,26 +242,116 @@ func (cmd *generateCmd) Run(args []string) int {
 		return 1
 	}
 
+	if cmd.flagWorkspace != "" {
+		if cmd.flagProviderDir != "" {
+			cmd.ui.Error("--provider-dir cannot be used with --workspace, as the workspace file lists the provider directories")
+			return 1
+		}
+
+		return cmd.run(func() error { return cmd.runWorkspace(args) })
+	}
+
+	err = applyConfigFile(fs, "generate", cmd.flagConfig, cmd.flagProviderDir)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to load config file: %s", err))
+		return 1
+	}
+
 	return cmd.run(cmd.runInternal)
 }
 
 func (cmd *generateCmd) runInternal() error {
-	err := provider.Generate(
-		cmd.ui,
-		cmd.flagProviderDir,
-		cmd.flagProviderName,
-		cmd.flagProvidersSchema,
-		cmd.flagRenderedProviderName,
-		cmd.flagRenderedWebsiteDir,
-		cmd.flagExamplesDir,
-		cmd.flagWebsiteTmpDir,
-		cmd.flagWebsiteSourceDir,
-		cmd.tfVersion,
-		cmd.flagIgnoreDeprecated,
-	)
+	err := provider.Generate(cmd.ui, cmd.generateOptions())
 	if err != nil {
 		return fmt.Errorf("unable to generate website: %w", err)
 	}
 
 	return nil
 }
+
+// generateOptions returns the options of the generation from the flags.
+func (cmd *generateCmd) generateOptions() provider.GenerateOptions {
+	return provider.GenerateOptions{
+		ProviderDir:          cmd.flagProviderDir,
+		ProviderName:         cmd.flagProviderName,
+		ProvidersSchemaPath:  cmd.flagProvidersSchema,
+		RenderedProviderName: cmd.flagRenderedProviderName,
+		ProviderVersion:      cmd.flagProviderVersion,
+		ProviderSource:       cmd.flagProviderSource,
+		RegistryProvider:     cmd.flagRegistryProvider,
+		RegistryVersion:      cmd.flagRegistryVersion,
+		RenderedWebsiteDir:   cmd.flagRenderedWebsiteDir,
+		ExamplesDir:          cmd.flagExamplesDir,
+		WebsiteTmpDir:        cmd.flagWebsiteTmpDir,
+		TemplatesDir:         cmd.flagWebsiteSourceDir,
+		TFVersion:            cmd.tfVersion,
+		TFBinary:             cmd.tfBinary,
+		TFInstallDir:         cmd.tfInstallDir,
+		Offline:              cmd.flagOffline,
+		PluginDirs:           splitList(cmd.flagPluginDir),
+		UseOpenTofu:          cmd.flagUseOpenTofu,
+		SchemaStyle:          cmd.flagSchemaStyle,
+		SchemaGroupOrder:     splitList(cmd.flagSchemaGroupOrder),
+		AttributeSort:        cmd.flagAttributeSort,
+		TypeSyntax:           cmd.flagTypeSyntax,
+		AttributeOrder:       splitList(cmd.flagAttributeOrder),
+		Headings:             splitList(cmd.flagHeadings),
+		Locales:              splitList(cmd.flagLocales),
+		OutputExtension:      cmd.flagOutputExtension,
+		FrontmatterDialect:   cmd.flagFrontmatterDialect,
+
+		FrontmatterDescriptionMaxLength:     cmd.flagDescMaxLength,
+		FrontmatterDescriptionFirstSentence: cmd.flagDescFirstSentence,
+		FrontmatterDescriptionOmitLinkURLs:  cmd.flagDescOmitLinkURLs,
+		SplitPageSize:                       cmd.flagSplitPageSize,
+		OutputFormat:                        cmd.flagOutputFormat,
+		HTMLDir:                             cmd.flagHTMLDir,
+		EmitJSONModel:                       cmd.flagEmitJSONModel,
+		EmitNav:                             cmd.flagEmitNav,
+		NavFormat:                           cmd.flagNavFormat,
+		EmitSinglePage:                      cmd.flagEmitSinglePage,
+		CacheFile:                           cmd.flagCacheFile,
+		AttributeDefaultsFile:               cmd.flagAttributeDefaults,
+		AttributeValidatorsFile:             cmd.flagAttributeValidators,
+		RequiresReplaceFile:                 cmd.flagRequiresReplace,
+		DeprecationsFile:                    cmd.flagDeprecations,
+		AttributeTypesFile:                  cmd.flagAttributeTypes,
+		ItemMetadataFile:                    cmd.flagItemMetadata,
+		DeprecationsGuide:                   cmd.flagDeprecationsGuide,
+		DeprecatedSubcategory:               cmd.flagDeprecatedSubcategory,
+		TemplateEnv:                         splitList(cmd.flagTemplateEnv),
+		TemplateFuncsPlugin:                 cmd.flagTemplateFuncsPlugin,
+		PostProcessCmd:                      cmd.flagPostProcessCmd,
+		PreGenerateCmd:                      cmd.flagPreGenerateCmd,
+		PostGenerateCmd:                     cmd.flagPostGenerateCmd,
+		BuildTimestamp:                      cmd.flagBuildTimestamp,
+		Reproducible:                        cmd.flagReproducible,
+		Ignore:                              splitList(cmd.flagIgnore),
+		Only:                                splitList(cmd.flagOnly),
+		IgnoreDeprecated:                    cmd.flagIgnoreDeprecated,
+		FailOnEmptyDescription:              cmd.flagFailOnEmptyDesc,
+		ValidateExamples:                    cmd.flagValidateExamples,
+		Check:                               cmd.flagCheck,
+		DryRun:                              cmd.flagDryRun,
+		StripExampleHeaders:                 cmd.flagStripExampleHeaders,
+		FormatExamples:                      cmd.flagFormatExamples,
+		ExampleSyntax:                       cmd.flagExampleSyntax,
+		GenerateExamples:                    cmd.flagGenerateExamples,
+		ExampleValues:                       splitList(cmd.flagExampleValues),
+		DebugTemplates:                      cmd.flagDebugTemplates,
+		StrictMetadata:                      cmd.flagStrictMetadata,
+		FailFast:                            cmd.flagFailFast,
+		Progress:                            cmd.flagProgress,
+		Stats:                               cmd.flagStats,
+		AttributeAnchors:                    cmd.flagAttributeAnchors,
+		CollapsibleNestedSchemas:            cmd.flagCollapsibleNested,
+		WriteOnlySection:                    cmd.flagWriteOnlySection,
+		TimeoutsSection:                     cmd.flagTimeoutsSection,
+		SensitiveAttributesSection:          cmd.flagSensitiveSection,
+		LinkItemMentions:                    cmd.flagLinkItemMentions,
+		Parallel:                            cmd.flagParallel,
+		InlineNestedDepth:                   cmd.flagInlineNestedDepth,
+		MaxNestedDepth:                      cmd.flagMaxNestedDepth,
+		InlineObjectMaxAttributes:           cmd.flagInlineObjectMax,
+	}
+}
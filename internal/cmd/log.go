@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/cli"
+)
+
+const (
+	logFormatText = "text"
+	logFormatJSON = "json"
+)
+
+// logLevels are the values of the --log-level flag, from the most to the
+// least verbose.
+var logLevels = []string{"debug", "info", "warn", "error"}
+
+// logLevelIndex returns the index of the level in logLevels, or -1 if it is
+// not a log level.
+func logLevelIndex(level string) int {
+	for i, name := range logLevels {
+		if strings.EqualFold(level, name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// logFlags adds the --log-level and --log-format flags, which are common to
+// all commands, to the flag set.
+func (cmd *commonCmd) logFlags(fs *flag.FlagSet) {
+	fs.StringVar(&cmd.flagLogLevel, "log-level", "info", "minimum level of log messages to output: debug, info, warn, or error")
+	fs.StringVar(&cmd.flagLogFormat, "log-format", logFormatText, "format of log messages: text, or json (one JSON object per line on stderr, with level, message, and page fields)")
+}
+
+// logUi is a cli.Ui which filters the messages by level, and outputs them as
+// text or as JSON objects. Output is not filtered, as it is the result of
+// commands, such as reports, rather than log messages.
+type logUi struct {
+	cli.Ui
+
+	// errorWriter is where JSON log messages, and text debug messages, are
+	// written to.
+	errorWriter io.Writer
+
+	level  int
+	format string
+
+	mu sync.Mutex
+}
+
+// logEvent is a log message in the JSON log format.
+type logEvent struct {
+	Level   string `json:"level"`
+	Message string `json:"message"`
+
+	// Page is the path of the page which the message is about, relative to
+	// the rendered website directory.
+	Page string `json:"page,omitempty"`
+}
+
+func newLogUi(ui cli.Ui, errorWriter io.Writer) *logUi {
+	return &logUi{
+		Ui:          ui,
+		errorWriter: errorWriter,
+		level:       logLevelIndex("info"),
+		format:      logFormatText,
+	}
+}
+
+// configure sets the level and format of log messages, and redirects the
+// messages of the standard logger, such as [DEBUG] messages, into the ui.
+func (l *logUi) configure(level, format string) error {
+	if level == "" {
+		level = "info"
+	}
+
+	index := logLevelIndex(level)
+	if index < 0 {
+		return fmt.Errorf("unsupported log level %q, expected one of: %s", level, strings.Join(logLevels, ", "))
+	}
+
+	switch format {
+	case "":
+		format = logFormatText
+	case logFormatText, logFormatJSON:
+	default:
+		return fmt.Errorf("unsupported log format %q, expected one of: %s, %s", format, logFormatText, logFormatJSON)
+	}
+
+	l.level = index
+	l.format = format
+
+	log.SetFlags(0)
+	log.SetOutput(&logWriter{ui: l})
+
+	return nil
+}
+
+func (l *logUi) Info(message string) {
+	l.log("info", "", message)
+}
+
+func (l *logUi) Warn(message string) {
+	l.log("warn", "", message)
+}
+
+func (l *logUi) Error(message string) {
+	l.log("error", "", message)
+}
+
+// PageInfo outputs an info message about the page.
+func (l *logUi) PageInfo(page, message string) {
+	l.log("info", page, message)
+}
+
+// PageWarn outputs a warning about the page.
+func (l *logUi) PageWarn(page, message string) {
+	l.log("warn", page, message)
+}
+
+func (l *logUi) log(level, page, message string) {
+	if logLevelIndex(level) < l.level {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.format == logFormatJSON {
+		// each event is written on its own line, so trailing newlines of
+		// messages, such as of command errors, are dropped
+		event, err := json.Marshal(logEvent{
+			Level:   level,
+			Message: strings.TrimRight(message, "\n"),
+			Page:    page,
+		})
+		if err != nil {
+			return
+		}
+
+		fmt.Fprintln(l.errorWriter, string(event))
+		return
+	}
+
+	switch level {
+	case "debug":
+		fmt.Fprintln(l.errorWriter, "[DEBUG] "+message)
+	case "info":
+		l.Ui.Info(message)
+	case "warn":
+		l.Ui.Warn(message)
+	case "error":
+		l.Ui.Error(message)
+	}
+}
+
+// logWriter is the output of the standard logger, which converts its
+// messages into messages of the ui, at the level of their [LEVEL] prefix, or
+// at the info level without a prefix.
+type logWriter struct {
+	ui *logUi
+}
+
+func (w *logWriter) Write(p []byte) (int, error) {
+	message := strings.TrimRight(string(p), "\n")
+	level := "info"
+
+	for _, name := range logLevels {
+		prefix := "[" + strings.ToUpper(name) + "] "
+		if strings.HasPrefix(message, prefix) {
+			level = name
+			message = strings.TrimPrefix(message, prefix)
+			break
+		}
+	}
+
+	w.ui.log(level, "", message)
+
+	return len(p), nil
+}
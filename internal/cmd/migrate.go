@@ -14,6 +14,8 @@ import (
 type migrateCmd struct {
 	commonCmd
 
+	flagConfig string
+
 	flagProviderDir  string
 	flagTemplatesDir string
 	flagExamplesDir  string
@@ -64,11 +66,13 @@ func (cmd *migrateCmd) Help() string {
 
 func (cmd *migrateCmd) Flags() *flag.FlagSet {
 	fs := flag.NewFlagSet("migrate", flag.ExitOnError)
+	fs.StringVar(&cmd.flagConfig, "config", "", configFlagUsage)
 
 	fs.StringVar(&cmd.flagProviderDir, "provider-dir", "", "relative or absolute path to the root provider code directory; this will default to the current working directory if not set")
 	fs.StringVar(&cmd.flagTemplatesDir, "templates-dir", "templates", "new website templates directory based on provider-dir; files will be migrated to this directory")
 	fs.StringVar(&cmd.flagExamplesDir, "examples-dir", "examples", "examples directory based on provider-dir; extracted code examples will be migrated to this directory")
 	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "provider name, as used in Terraform configurations; defaults to the --provider-dir short name (after removing `terraform-provider-` prefix)")
+	cmd.logFlags(fs)
 
 	return fs
 }
@@ -81,6 +85,12 @@ func (cmd *migrateCmd) Run(args []string) int {
 		return 1
 	}
 
+	err = applyConfigFile(fs, "migrate", cmd.flagConfig, cmd.flagProviderDir)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to load config file: %s", err))
+		return 1
+	}
+
 	return cmd.run(cmd.runInternal)
 }
 
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/hashicorp/cli"
 	"github.com/mattn/go-colorable"
@@ -15,11 +16,17 @@ import (
 )
 
 type commonCmd struct {
-	ui cli.Ui
+	ui *logUi
+
+	flagLogLevel  string
+	flagLogFormat string
 }
 
 func (cmd *commonCmd) run(r func() error) int {
-	err := r()
+	err := cmd.ui.configure(cmd.flagLogLevel, cmd.flagLogFormat)
+	if err == nil {
+		err = r()
+	}
 	if err != nil {
 		// TODO: unwraps? check for special exit code error?
 		cmd.ui.Error(fmt.Sprintf("Error executing command: %s\n", err))
@@ -28,7 +35,21 @@ func (cmd *commonCmd) run(r func() error) int {
 	return 0
 }
 
-func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
+// splitList returns the trimmed, non-empty values of a comma separated flag.
+func splitList(value string) []string {
+	var result []string
+
+	for _, v := range strings.Split(value, ",") {
+		v = strings.TrimSpace(v)
+		if v != "" {
+			result = append(result, v)
+		}
+	}
+
+	return result
+}
+
+func initCommands(ui *logUi) map[string]cli.CommandFactory {
 
 	generateFactory := func() (cli.Command, error) {
 		return &generateCmd{
@@ -57,6 +78,14 @@ func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
 		}, nil
 	}
 
+	extractExamplesFactory := func() (cli.Command, error) {
+		return &extractExamplesCmd{
+			commonCmd: commonCmd{
+				ui: ui,
+			},
+		}, nil
+	}
+
 	migrateFactory := func() (cli.Command, error) {
 		return &migrateCmd{
 			commonCmd: commonCmd{
@@ -65,12 +94,41 @@ func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
 		}, nil
 	}
 
+	serveFactory := func() (cli.Command, error) {
+		return &serveCmd{
+			generateCmd: generateCmd{
+				commonCmd: commonCmd{
+					ui: ui,
+				},
+			},
+		}, nil
+	}
+
+	schemaDiffFactory := func() (cli.Command, error) {
+		return &schemaDiffCmd{
+			commonCmd: commonCmd{
+				ui: ui,
+			},
+		}, nil
+	}
+
+	coverageFactory := func() (cli.Command, error) {
+		return &coverageCmd{
+			commonCmd: commonCmd{
+				ui: ui,
+			},
+		}, nil
+	}
+
 	return map[string]cli.CommandFactory{
-		"":         defaultFactory,
-		"generate": generateFactory,
-		"validate": validateFactory,
-		"migrate":  migrateFactory,
-		//"serve": serveFactory,
+		"":                 defaultFactory,
+		"generate":         generateFactory,
+		"validate":         validateFactory,
+		"migrate":          migrateFactory,
+		"extract-examples": extractExamplesFactory,
+		"serve":            serveFactory,
+		"schema-diff":      schemaDiffFactory,
+		"coverage":         coverageFactory,
 	}
 }
 
@@ -84,7 +142,7 @@ func (cmd *defaultCmd) Synopsis() string {
 }
 
 func Run(name, version string, args []string, stdin io.Reader, stdout, stderr io.Writer) int {
-	var ui cli.Ui = &cli.ColoredUi{
+	ui := newLogUi(&cli.ColoredUi{
 		ErrorColor: cli.UiColorRed,
 		WarnColor:  cli.UiColorYellow,
 
@@ -93,7 +151,7 @@ func Run(name, version string, args []string, stdin io.Reader, stdout, stderr io
 			Writer:      stdout,
 			ErrorWriter: stderr,
 		},
-	}
+	}, stderr)
 
 	commands := initCommands(ui)
 
@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/provider"
+)
+
+type schemaDiffCmd struct {
+	commonCmd
+
+	flagProviderName string
+	flagFormat       string
+
+	oldSchemaPath string
+	newSchemaPath string
+}
+
+func (cmd *schemaDiffCmd) Synopsis() string {
+	return "compares two providers schema JSON files and reports added, removed, and changed resources, data sources, functions, and attributes"
+}
+
+func (cmd *schemaDiffCmd) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugindocs schema-diff [<args>] <old providers schema JSON file> <new providers schema JSON file>\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *schemaDiffCmd) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("schema-diff", flag.ExitOnError)
+	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "provider name, as used in Terraform configurations; required if the schema files contain more than one provider")
+	fs.StringVar(&cmd.flagFormat, "format", provider.SchemaDiffFormatMarkdown, "output format of the report, either markdown or json")
+	cmd.logFlags(fs)
+	return fs
+}
+
+func (cmd *schemaDiffCmd) Run(args []string) int {
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to parse flags: %s", err))
+		return 1
+	}
+
+	if fs.NArg() != 2 {
+		cmd.ui.Error(fmt.Sprintf("expected 2 arguments, the old and new providers schema JSON files, got %d", fs.NArg()))
+		cmd.ui.Error(cmd.Help())
+		return 1
+	}
+
+	cmd.oldSchemaPath = fs.Arg(0)
+	cmd.newSchemaPath = fs.Arg(1)
+
+	return cmd.run(cmd.runInternal)
+}
+
+func (cmd *schemaDiffCmd) runInternal() error {
+	err := provider.SchemaDiff(cmd.ui,
+		cmd.oldSchemaPath,
+		cmd.newSchemaPath,
+		cmd.flagProviderName,
+		cmd.flagFormat,
+	)
+	if err != nil {
+		return fmt.Errorf("unable to diff provider schemas: %w", err)
+	}
+
+	return nil
+}
@@ -3,4 +3,124 @@
 
 package cmd
 
-//TODO: this command can run an emulated server to simulate the registry
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/provider"
+)
+
+// serveExcludedFlags are the flags of the generate command which the serve
+// command does not have, as they write or check the rendered website
+// directory, or report on the generation, instead of changing the rendered
+// pages.
+var serveExcludedFlags = []string{
+	"cache-file",
+	"check",
+	"dry-run",
+	"emit-json-model",
+	"emit-nav",
+	"emit-single-page",
+	"fail-on-empty-description",
+	"html-dir",
+	"locales",
+	"nav-format",
+	"only",
+	"output-format",
+	"post-generate-cmd",
+	"pre-generate-cmd",
+	"progress",
+	"rendered-website-dir",
+	"stats",
+	"validate-examples",
+	"website-temp-dir",
+	"workspace",
+}
+
+type serveCmd struct {
+	generateCmd
+
+	flagAddress string
+}
+
+func (cmd *serveCmd) Synopsis() string {
+	return "renders a plugin website and serves a live reloading preview over HTTP"
+}
+
+func (cmd *serveCmd) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugindocs serve [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *serveCmd) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("serve", flag.ExitOnError)
+	cmd.generateCmd.Flags().VisitAll(func(f *flag.Flag) {
+		if !slices.Contains(serveExcludedFlags, f.Name) {
+			fs.Var(f.Value, f.Name, f.Usage)
+		}
+	})
+	fs.StringVar(&cmd.flagAddress, "address", "localhost:8080", "address for the preview HTTP server to listen on")
+	return fs
+}
+
+func (cmd *serveCmd) Run(args []string) int {
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to parse flags: %s", err))
+		return 1
+	}
+
+	err = applyConfigFile(fs, "serve", cmd.flagConfig, cmd.flagProviderDir)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to load config file: %s", err))
+		return 1
+	}
+
+	return cmd.run(cmd.runInternal)
+}
+
+func (cmd *serveCmd) runInternal() error {
+	err := provider.Serve(cmd.ui, cmd.generateOptions(), cmd.flagAddress)
+	if err != nil {
+		return fmt.Errorf("unable to serve website: %w", err)
+	}
+
+	return nil
+}
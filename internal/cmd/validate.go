@@ -9,16 +9,35 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-docs/internal/check"
 	"github.com/hashicorp/terraform-plugin-docs/internal/provider"
 )
 
 type validateCmd struct {
 	commonCmd
 
-	flagProviderName    string
-	flagProviderDir     string
-	flagProvidersSchema string
-	tfVersion           string
+	flagConfig string
+
+	flagProviderName         string
+	flagIgnore               string
+	flagRules                string
+	flagFrontMatterRequired  string
+	flagFrontMatterForbidden string
+	flagFrontMatterPatterns  string
+	flagDescriptionStyle     string
+	flagExamplesDir          string
+	flagExampleFormat        bool
+	flagMaxFileSize          int64
+	flagMaxFiles             int
+	flagMaxPathDepth         int
+	flagFormat               string
+	flagProviderDir          string
+	flagProvidersSchema      string
+	tfVersion                string
+	tfBinary                 string
+	tfInstallDir             string
+	flagUseOpenTofu          bool
+	flagOffline              bool
 }
 
 func (cmd *validateCmd) Synopsis() string {
@@ -65,10 +84,28 @@ func (cmd *validateCmd) Help() string {
 
 func (cmd *validateCmd) Flags() *flag.FlagSet {
 	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	fs.StringVar(&cmd.flagConfig, "config", "", configFlagUsage)
 	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "provider name, as used in Terraform configurations; defaults to the --provider-dir short name (after removing `terraform-provider-` prefix)")
+	fs.StringVar(&cmd.flagIgnore, "ignore", "", "comma separated glob patterns of resource, data source, ephemeral resource, list resource, action, and function names to exclude from documentation, in addition to the patterns in the .tfplugindocsignore file (ex. aws_internal_*,data-sources/aws_legacy_*)")
 	fs.StringVar(&cmd.flagProviderDir, "provider-dir", "", "relative or absolute path to the root provider code directory; this will default to the current working directory if not set")
-	fs.StringVar(&cmd.flagProvidersSchema, "providers-schema", "", "path to the providers schema JSON file, which contains the output of the terraform providers schema -json command. Setting this flag will skip building the provider and calling Terraform CLI")
-	fs.StringVar(&cmd.tfVersion, "tf-version", "", "terraform binary version to download. If not provided, will look for a terraform binary in the local environment. If not found in the environment, will download the latest version of Terraform")
+	fs.StringVar(&cmd.flagProvidersSchema, "providers-schema", "", "path to the providers schema JSON file, which contains the output of the terraform providers schema -json command, \"-\" to read it from stdin, or an HTTP(S) URL to fetch it from; comma separated paths are merged, such as the separately exported schemas of a muxed provider. Setting this flag will skip building the provider and calling Terraform CLI")
+	fs.StringVar(&cmd.flagRules, "rules", "", "comma separated severities of validation check rules, as <rule>=<severity> where the severity is error, warn, or off, in addition to disabling rules in a file with a tfplugindocs:disable comment (ex. SchemaAttributesCheck=warn,FileSizeCheck=off)")
+	fs.StringVar(&cmd.flagFrontMatterRequired, "frontmatter-required", "", "comma separated YAML frontmatter keys which are required in every documentation file, in addition to the keys required by the Terraform Registry (ex. subcategory)")
+	fs.StringVar(&cmd.flagFrontMatterForbidden, "frontmatter-forbidden", "", "comma separated YAML frontmatter keys which are not allowed in any documentation file, in addition to the keys not allowed by the Terraform Registry (ex. sidebar_current)")
+	fs.StringVar(&cmd.flagFrontMatterPatterns, "frontmatter-patterns", "", "comma separated regular expressions which YAML frontmatter values must match, as <key>=<pattern> (ex. subcategory=^(Compute|Storage)$)")
+	fs.StringVar(&cmd.flagDescriptionStyle, "description-style", "", "comma separated style rules of provider schema descriptions: uppercase, period, max-length=<n>, and no-todo; descriptions are only checked if set (ex. uppercase,period,max-length=300)")
+	fs.StringVar(&cmd.flagExamplesDir, "examples-dir", "examples", "examples directory based on provider-dir, whose Terraform files are checked with --example-format")
+	fs.BoolVar(&cmd.flagExampleFormat, "example-format", false, "check that the Terraform files of the --examples-dir directory are formatted in the canonical style of terraform fmt")
+	fs.Int64Var(&cmd.flagMaxFileSize, "max-file-size", check.RegistryMaximumSizeOfFile, "maximum size in bytes of a documentation file, which defaults to the Terraform Registry storage limit")
+	fs.IntVar(&cmd.flagMaxFiles, "max-files", check.RegistryMaximumNumberOfFiles, "maximum number of documentation files, which defaults to the Terraform Registry storage limit")
+	fs.IntVar(&cmd.flagMaxPathDepth, "max-path-depth", check.RegistryMaximumPathDepth, "maximum number of path segments of a documentation file below the documentation directory (ex. cdktf/typescript/resources/example.md is 4)")
+	fs.StringVar(&cmd.flagFormat, "format", "text", "output format of validation findings: text, json, or sarif")
+	fs.StringVar(&cmd.tfVersion, "tf-version", "", "exact terraform binary version to use, which is found in the local environment or --tf-install-dir, or downloaded. If not provided, will look for any terraform binary in the local environment or --tf-install-dir. If not found, will download the latest version of Terraform")
+	fs.StringVar(&cmd.tfBinary, "tf-binary", "", "path, or name in PATH, of a Terraform or OpenTofu CLI binary to export the provider schema with, instead of finding or downloading Terraform (ex. /usr/local/bin/tofu)")
+	fs.StringVar(&cmd.tfInstallDir, "tf-install-dir", "", "directory in which downloaded terraform binaries are kept, in a subdirectory named after the --tf-version or latest, and reused by later runs instead of downloading them again (ex. a CI cache directory)")
+	fs.BoolVar(&cmd.flagOffline, "offline", false, "fail instead of accessing the network to export the provider schema: terraform is not downloaded, the provider is built with GOPROXY=off, and --providers-schema URLs are not fetched")
+	fs.BoolVar(&cmd.flagUseOpenTofu, "use-opentofu", false, "export the provider schema with the OpenTofu CLI binary (tofu) in PATH, or --tf-binary if set, instead of Terraform")
+	cmd.logFlags(fs)
 	return fs
 }
 
@@ -80,16 +117,38 @@ func (cmd *validateCmd) Run(args []string) int {
 		return 1
 	}
 
+	err = applyConfigFile(fs, "validate", cmd.flagConfig, cmd.flagProviderDir)
+	if err != nil {
+		cmd.ui.Error(fmt.Sprintf("unable to load config file: %s", err))
+		return 1
+	}
+
 	return cmd.run(cmd.runInternal)
 }
 
 func (cmd *validateCmd) runInternal() error {
-	err := provider.Validate(cmd.ui,
-		cmd.flagProviderDir,
-		cmd.flagProviderName,
-		cmd.flagProvidersSchema,
-		cmd.tfVersion,
-	)
+	err := provider.Validate(cmd.ui, provider.ValidateOptions{
+		ProviderDir:          cmd.flagProviderDir,
+		ProviderName:         cmd.flagProviderName,
+		ProvidersSchemaPath:  cmd.flagProvidersSchema,
+		TFVersion:            cmd.tfVersion,
+		TFBinary:             cmd.tfBinary,
+		TFInstallDir:         cmd.tfInstallDir,
+		UseOpenTofu:          cmd.flagUseOpenTofu,
+		Offline:              cmd.flagOffline,
+		Ignore:               splitList(cmd.flagIgnore),
+		Rules:                splitList(cmd.flagRules),
+		FrontMatterRequired:  splitList(cmd.flagFrontMatterRequired),
+		FrontMatterForbidden: splitList(cmd.flagFrontMatterForbidden),
+		FrontMatterPatterns:  cmd.flagFrontMatterPatterns,
+		DescriptionStyle:     splitList(cmd.flagDescriptionStyle),
+		ExamplesDir:          cmd.flagExamplesDir,
+		ExampleFormat:        cmd.flagExampleFormat,
+		MaxFileSize:          cmd.flagMaxFileSize,
+		MaxFiles:             cmd.flagMaxFiles,
+		MaxPathDepth:         cmd.flagMaxPathDepth,
+		Format:               cmd.flagFormat,
+	})
 	if err != nil {
 		return errors.Join(errors.New("validation errors found: "), err)
 	}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// workspaceFile is a workspace file, which lists the provider directories of
+// a repository with multiple providers to generate documentation for, for
+// example:
+//
+//	providers:
+//	  - dir: providers/alpha
+//	  - dir: providers/beta
+//	    options:
+//	      provider-name: beta
+//	      rendered-website-dir: website/docs
+type workspaceFile struct {
+	Providers []workspaceProvider `yaml:"providers"`
+}
+
+// workspaceProvider is a provider of a workspace file.
+type workspaceProvider struct {
+	// Dir is the provider directory, relative to the workspace file
+	// directory.
+	Dir string `yaml:"dir"`
+
+	// Options are the generate flag values of the provider, which take
+	// precedence over the configuration file of the provider and are
+	// overridden by flags set on the command line.
+	Options map[string]interface{} `yaml:"options"`
+}
+
+// workspaceResult is the result of generating the documentation of a
+// workspace provider.
+type workspaceResult struct {
+	providerDir        string
+	renderedWebsiteDir string
+	err                error
+}
+
+// workspaceProviderUnsupportedOptions are the generate flags which cannot be
+// set in the options of a workspace provider.
+var workspaceProviderUnsupportedOptions = []string{
+	"config",
+	"provider-dir",
+	"workspace",
+	"log-level",
+	"log-format",
+}
+
+// loadWorkspaceFile reads and validates the workspace file.
+func loadWorkspaceFile(path string) (*workspaceFile, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read workspace file %q: %w", path, err)
+	}
+
+	var workspace workspaceFile
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err = decoder.Decode(&workspace)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unable to parse workspace file %q: %w", path, err)
+	}
+
+	if len(workspace.Providers) == 0 {
+		return nil, fmt.Errorf("invalid workspace file %q: no providers", path)
+	}
+
+	dirs := make(map[string]bool, len(workspace.Providers))
+	for i, p := range workspace.Providers {
+		if p.Dir == "" {
+			return nil, fmt.Errorf("invalid workspace file %q: provider %d has no dir", path, i+1)
+		}
+
+		dir := filepath.Clean(p.Dir)
+		if dirs[dir] {
+			return nil, fmt.Errorf("invalid workspace file %q: duplicate provider dir %q", path, p.Dir)
+		}
+		dirs[dir] = true
+	}
+
+	return &workspace, nil
+}
+
+// runWorkspace generates the documentation of every provider of the workspace
+// file, continuing with the remaining providers if one fails, and outputs a
+// summary of the results. Each provider is generated with the flags set on
+// the command line, its workspace options, and its configuration file, in
+// order of precedence.
+func (cmd *generateCmd) runWorkspace(args []string) error {
+	workspace, err := loadWorkspaceFile(cmd.flagWorkspace)
+	if err != nil {
+		return err
+	}
+
+	workspaceDir := filepath.Dir(cmd.flagWorkspace)
+	results := make([]workspaceResult, 0, len(workspace.Providers))
+	failed := 0
+
+	for _, p := range workspace.Providers {
+		providerDir := p.Dir
+		if !filepath.IsAbs(providerDir) {
+			providerDir = filepath.Join(workspaceDir, providerDir)
+		}
+
+		cmd.ui.Info(fmt.Sprintf("generating documentation for workspace provider %q", providerDir))
+
+		providerCmd := &generateCmd{commonCmd: cmd.commonCmd}
+
+		err := providerCmd.applyWorkspaceProvider(args, p, workspaceDir, providerDir)
+		if err == nil {
+			err = providerCmd.runInternal()
+		}
+
+		if err != nil {
+			failed++
+		}
+
+		results = append(results, workspaceResult{
+			providerDir:        providerDir,
+			renderedWebsiteDir: filepath.Join(providerDir, providerCmd.flagRenderedWebsiteDir),
+			err:                err,
+		})
+	}
+
+	cmd.ui.Output(fmt.Sprintf("workspace summary: %d provider(s), %d succeeded, %d failed", len(results), len(results)-failed, failed))
+
+	for _, result := range results {
+		if result.err != nil {
+			cmd.ui.Output(fmt.Sprintf("  failed: %s: %s", result.providerDir, result.err))
+			continue
+		}
+
+		cmd.ui.Output(fmt.Sprintf("  ok: %s: %s", result.providerDir, result.renderedWebsiteDir))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("unable to generate documentation for %d of %d workspace provider(s)", failed, len(results))
+	}
+
+	return nil
+}
+
+// applyWorkspaceProvider sets the flags of the command for a workspace
+// provider from the command line arguments, the workspace provider options,
+// and the configuration file of the provider.
+func (cmd *generateCmd) applyWorkspaceProvider(args []string, p workspaceProvider, workspaceDir, providerDir string) error {
+	fs := cmd.Flags()
+
+	// The arguments were already parsed successfully by the workspace command.
+	_ = fs.Parse(args)
+
+	options := make(map[string]string, len(p.Options))
+	for _, name := range sortedNames(p.Options) {
+		if slices.Contains(workspaceProviderUnsupportedOptions, name) {
+			return fmt.Errorf("invalid workspace file %q: option %q is not supported in provider options", cmd.flagWorkspace, name)
+		}
+
+		if fs.Lookup(name) == nil {
+			return fmt.Errorf("invalid workspace file %q: unknown option %q", cmd.flagWorkspace, name)
+		}
+
+		option, err := configValue(name, p.Options[name])
+		if err != nil {
+			return fmt.Errorf("invalid workspace file %q: %w", cmd.flagWorkspace, err)
+		}
+
+		options[name] = option
+	}
+
+	err := fs.Set("provider-dir", providerDir)
+	if err != nil {
+		return err
+	}
+
+	err = setUnsetFlags(fs, options, workspaceDir, providerDir)
+	if err != nil {
+		return fmt.Errorf("invalid workspace file %q: %w", cmd.flagWorkspace, err)
+	}
+
+	err = applyConfigFile(fs, "generate", cmd.flagConfig, providerDir)
+	if err != nil {
+		return fmt.Errorf("unable to load config file: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package coverage
+
+import (
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// Report contains the description coverage of a provider schema.
+type Report struct {
+	// Provider contains the coverage of the provider configuration schema.
+	Provider *Item `json:"provider,omitempty"`
+
+	Resources          []Item `json:"resources,omitempty"`
+	DataSources        []Item `json:"data_sources,omitempty"`
+	EphemeralResources []Item `json:"ephemeral_resources,omitempty"`
+	ListResources      []Item `json:"list_resources,omitempty"`
+	Actions            []Item `json:"actions,omitempty"`
+	Functions          []Item `json:"functions,omitempty"`
+}
+
+// Item contains the description coverage of the provider configuration, a
+// resource, data source, ephemeral resource, list resource, action, or
+// function.
+type Item struct {
+	Name string `json:"name"`
+
+	// Described is true if the item itself has a non-empty description. For
+	// functions, either the description or the summary must be set.
+	Described bool `json:"described"`
+
+	// Attributes is the number of attributes and blocks, including nested
+	// attributes and blocks, or the number of function parameters.
+	Attributes int `json:"attributes"`
+
+	// DescribedAttributes is the number of Attributes with a non-empty
+	// description.
+	DescribedAttributes int `json:"described_attributes"`
+
+	// Undescribed contains the dot separated paths of attributes, blocks, and
+	// function parameters without a description, e.g. "timeouts.create".
+	Undescribed []string `json:"undescribed,omitempty"`
+}
+
+// Percentage returns the percentage of the item and its attributes with a
+// non-empty description.
+func (i Item) Percentage() float64 {
+	described := i.DescribedAttributes
+	if i.Described {
+		described++
+	}
+
+	return percentage(described, i.Attributes+1)
+}
+
+// Summary is the number of described elements out of a total.
+type Summary struct {
+	Described  int     `json:"described"`
+	Total      int     `json:"total"`
+	Percentage float64 `json:"percentage"`
+}
+
+func newSummary(described, total int) Summary {
+	return Summary{
+		Described:  described,
+		Total:      total,
+		Percentage: percentage(described, total),
+	}
+}
+
+// Items returns the number of described items, i.e. the provider
+// configuration, resources, data sources, ephemeral resources, list
+// resources, actions, and functions.
+func (r *Report) Items() Summary {
+	var described, total int
+
+	for _, item := range r.all() {
+		total++
+		if item.Described {
+			described++
+		}
+	}
+
+	return newSummary(described, total)
+}
+
+// Attributes returns the number of described attributes, blocks, and
+// function parameters of all items.
+func (r *Report) Attributes() Summary {
+	var described, total int
+
+	for _, item := range r.all() {
+		total += item.Attributes
+		described += item.DescribedAttributes
+	}
+
+	return newSummary(described, total)
+}
+
+// Total returns the number of described items, attributes, blocks, and
+// function parameters.
+func (r *Report) Total() Summary {
+	items := r.Items()
+	attributes := r.Attributes()
+
+	return newSummary(items.Described+attributes.Described, items.Total+attributes.Total)
+}
+
+func (r *Report) all() []Item {
+	var items []Item
+
+	if r.Provider != nil {
+		items = append(items, *r.Provider)
+	}
+
+	items = append(items, r.Resources...)
+	items = append(items, r.DataSources...)
+	items = append(items, r.EphemeralResources...)
+	items = append(items, r.ListResources...)
+	items = append(items, r.Actions...)
+	items = append(items, r.Functions...)
+
+	return items
+}
+
+// Compute returns the description coverage of the provider schema. Items in
+// the report are sorted by name. Actions are not part of the provider schema,
+// and can be set separately with SchemaItems.
+func Compute(schema *tfjson.ProviderSchema) *Report {
+	report := &Report{}
+
+	if schema == nil {
+		return report
+	}
+
+	if schema.ConfigSchema != nil {
+		provider := schemaItem("provider", schema.ConfigSchema)
+		report.Provider = &provider
+	}
+
+	report.Resources = SchemaItems(schema.ResourceSchemas)
+	report.DataSources = SchemaItems(schema.DataSourceSchemas)
+	report.EphemeralResources = SchemaItems(schema.EphemeralResourceSchemas)
+	report.ListResources = SchemaItems(schema.ListResourceSchemas)
+
+	for _, name := range sortedKeys(schema.Functions) {
+		report.Functions = append(report.Functions, functionItem(name, schema.Functions[name]))
+	}
+
+	return report
+}
+
+// SchemaItems returns the description coverage of each schema, sorted by
+// name.
+func SchemaItems(schemas map[string]*tfjson.Schema) []Item {
+	var items []Item
+
+	for _, name := range sortedKeys(schemas) {
+		items = append(items, schemaItem(name, schemas[name]))
+	}
+
+	return items
+}
+
+func schemaItem(name string, schema *tfjson.Schema) Item {
+	item := Item{Name: name}
+
+	if schema == nil || schema.Block == nil {
+		return item
+	}
+
+	item.Described = described(schema.Block.Description)
+	item.addBlock("", schema.Block)
+
+	return item
+}
+
+func functionItem(name string, signature *tfjson.FunctionSignature) Item {
+	item := Item{Name: name}
+
+	if signature == nil {
+		return item
+	}
+
+	item.Described = described(signature.Description) || described(signature.Summary)
+
+	parameters := signature.Parameters
+	if signature.VariadicParameter != nil {
+		parameters = append(parameters[:len(parameters):len(parameters)], signature.VariadicParameter)
+	}
+
+	for _, parameter := range parameters {
+		if parameter == nil {
+			continue
+		}
+
+		item.add(parameter.Name, parameter.Description)
+	}
+
+	return item
+}
+
+func (i *Item) add(path, description string) {
+	i.Attributes++
+
+	if described(description) {
+		i.DescribedAttributes++
+		return
+	}
+
+	i.Undescribed = append(i.Undescribed, path)
+}
+
+func (i *Item) addBlock(path string, block *tfjson.SchemaBlock) {
+	for _, name := range sortedKeys(block.Attributes) {
+		i.addAttribute(joinPath(path, name), block.Attributes[name])
+	}
+
+	for _, name := range sortedKeys(block.NestedBlocks) {
+		nested := block.NestedBlocks[name]
+		childPath := joinPath(path, name)
+
+		if nested == nil || nested.Block == nil {
+			i.add(childPath, "")
+			continue
+		}
+
+		i.add(childPath, nested.Block.Description)
+		i.addBlock(childPath, nested.Block)
+	}
+}
+
+func (i *Item) addAttribute(path string, attribute *tfjson.SchemaAttribute) {
+	if attribute == nil {
+		i.add(path, "")
+		return
+	}
+
+	i.add(path, attribute.Description)
+
+	if attribute.AttributeNestedType == nil {
+		return
+	}
+
+	for _, name := range sortedKeys(attribute.AttributeNestedType.Attributes) {
+		i.addAttribute(joinPath(path, name), attribute.AttributeNestedType.Attributes[name])
+	}
+}
+
+func described(description string) bool {
+	return strings.TrimSpace(description) != ""
+}
+
+func percentage(described, total int) float64 {
+	if total == 0 {
+		return 100
+	}
+
+	return float64(described) / float64(total) * 100
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
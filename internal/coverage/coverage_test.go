@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package coverage_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/coverage"
+)
+
+func TestCompute(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		schema        *tfjson.ProviderSchema
+		expected      *coverage.Report
+		expectedTotal coverage.Summary
+	}{
+		"empty": {
+			schema:        &tfjson.ProviderSchema{},
+			expected:      &coverage.Report{},
+			expectedTotal: coverage.Summary{Percentage: 100},
+		},
+		"nested attributes and blocks": {
+			schema: &tfjson.ProviderSchema{
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"scaffolding_example": {
+						Block: &tfjson.SchemaBlock{
+							Description: "Example resource",
+							Attributes: map[string]*tfjson.SchemaAttribute{
+								"id": {AttributeType: cty.String, Computed: true},
+								"settings": {
+									Description: "Settings",
+									AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+										NestingMode: tfjson.SchemaNestingModeSingle,
+										Attributes: map[string]*tfjson.SchemaAttribute{
+											"enabled": {AttributeType: cty.Bool, Description: " "},
+										},
+									},
+								},
+							},
+							NestedBlocks: map[string]*tfjson.SchemaBlockType{
+								"timeouts": {
+									NestingMode: tfjson.SchemaNestingModeSingle,
+									Block: &tfjson.SchemaBlock{
+										Description: "Timeouts",
+										Attributes: map[string]*tfjson.SchemaAttribute{
+											"create": {AttributeType: cty.String, Description: "Create timeout"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: &coverage.Report{
+				Resources: []coverage.Item{
+					{
+						Name:                "scaffolding_example",
+						Described:           true,
+						Attributes:          5,
+						DescribedAttributes: 3,
+						Undescribed:         []string{"id", "settings.enabled"},
+					},
+				},
+			},
+			expectedTotal: coverage.Summary{Described: 4, Total: 6, Percentage: float64(4) / float64(6) * 100},
+		},
+		"functions": {
+			schema: &tfjson.ProviderSchema{
+				Functions: map[string]*tfjson.FunctionSignature{
+					"example": {
+						Summary: "Example function",
+						Parameters: []*tfjson.FunctionParameter{
+							{Name: "input", Description: "Value to echo."},
+						},
+						VariadicParameter: &tfjson.FunctionParameter{Name: "suffixes"},
+					},
+					"undescribed": {},
+				},
+			},
+			expected: &coverage.Report{
+				Functions: []coverage.Item{
+					{
+						Name:                "example",
+						Described:           true,
+						Attributes:          2,
+						DescribedAttributes: 1,
+						Undescribed:         []string{"suffixes"},
+					},
+					{
+						Name: "undescribed",
+					},
+				},
+			},
+			expectedTotal: coverage.Summary{Described: 2, Total: 4, Percentage: 50},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := coverage.Compute(c.schema)
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(c.expectedTotal, actual.Total()); diff != "" {
+				t.Errorf("unexpected total difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package coverage
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type section struct {
+	title string
+	kind  string
+	items []Item
+}
+
+func (r *Report) sections() []section {
+	var provider []Item
+	if r.Provider != nil {
+		provider = []Item{*r.Provider}
+	}
+
+	return []section{
+		{"Provider", "Provider", provider},
+		{"Resources", "Resource", r.Resources},
+		{"Data Sources", "Data Source", r.DataSources},
+		{"Ephemeral Resources", "Ephemeral Resource", r.EphemeralResources},
+		{"List Resources", "List Resource", r.ListResources},
+		{"Actions", "Action", r.Actions},
+		{"Functions", "Function", r.Functions},
+	}
+}
+
+// RenderMarkdown writes the report as Markdown, with a summary table followed
+// by a table for each item type and a list of missing descriptions.
+func RenderMarkdown(w io.Writer, report *Report) error {
+	b := &strings.Builder{}
+
+	b.WriteString("## Summary\n\n")
+	b.WriteString("| | Described | Total | Coverage |\n")
+	b.WriteString("|---|---|---|---|\n")
+	writeSummaryRow(b, "Items", report.Items())
+	writeSummaryRow(b, "Attributes", report.Attributes())
+	writeSummaryRow(b, "Total", report.Total())
+
+	sections := report.sections()
+
+	for _, section := range sections {
+		if len(section.items) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(b, "\n## %s\n\n", section.title)
+		b.WriteString("| Name | Description | Attributes | Coverage |\n")
+		b.WriteString("|---|---|---|---|\n")
+
+		for _, item := range section.items {
+			description := "no"
+			if item.Described {
+				description = "yes"
+			}
+
+			fmt.Fprintf(b, "| `%s` | %s | %d/%d | %s |\n", item.Name, description, item.DescribedAttributes, item.Attributes, formatPercentage(item.Percentage()))
+		}
+	}
+
+	wroteHeading := false
+
+	for _, section := range sections {
+		for _, item := range section.items {
+			if item.Described && len(item.Undescribed) == 0 {
+				continue
+			}
+
+			if !wroteHeading {
+				b.WriteString("\n## Missing Descriptions\n")
+				wroteHeading = true
+			}
+
+			if section.kind == "Provider" {
+				b.WriteString("\n### Provider\n\n")
+			} else {
+				fmt.Fprintf(b, "\n### %s `%s`\n\n", section.kind, item.Name)
+			}
+
+			if !item.Described {
+				b.WriteString("- Description\n")
+			}
+
+			for _, path := range item.Undescribed {
+				fmt.Fprintf(b, "- `%s`\n", path)
+			}
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+type jsonSummary struct {
+	Items      Summary `json:"items"`
+	Attributes Summary `json:"attributes"`
+	Total      Summary `json:"total"`
+}
+
+type jsonReport struct {
+	Summary jsonSummary `json:"summary"`
+
+	*Report
+}
+
+// RenderJSON writes the report as indented JSON, including a summary of the
+// item, attribute, and total coverage.
+func RenderJSON(w io.Writer, report *Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(jsonReport{
+		Summary: jsonSummary{
+			Items:      report.Items(),
+			Attributes: report.Attributes(),
+			Total:      report.Total(),
+		},
+		Report: report,
+	})
+}
+
+func writeSummaryRow(b *strings.Builder, name string, summary Summary) {
+	fmt.Fprintf(b, "| %s | %d | %d | %s |\n", name, summary.Described, summary.Total, formatPercentage(summary.Percentage))
+}
+
+func formatPercentage(p float64) string {
+	return fmt.Sprintf("%.1f%%", p)
+}
@@ -0,0 +1,445 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docsmodel
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+// Kind describes whether a schema item is an attribute or a block.
+type Kind string
+
+const (
+	KindAttribute Kind = "attribute"
+	KindBlock     Kind = "block"
+)
+
+// Model is the machine-readable documentation model of a provider, suitable
+// for consumption by external site generators.
+type Model struct {
+	Provider Provider `json:"provider"`
+
+	Resources          []Resource `json:"resources,omitempty"`
+	DataSources        []Resource `json:"data_sources,omitempty"`
+	EphemeralResources []Resource `json:"ephemeral_resources,omitempty"`
+	ListResources      []Resource `json:"list_resources,omitempty"`
+	Actions            []Resource `json:"actions,omitempty"`
+	Functions          []Function `json:"functions,omitempty"`
+}
+
+// Provider describes the provider and its configuration schema.
+type Provider struct {
+	// Name is the provider name, as used in Terraform configurations.
+	Name string `json:"name"`
+
+	// RenderedName is the provider name, as generated in documentation.
+	RenderedName string `json:"rendered_name,omitempty"`
+
+	Description string      `json:"description,omitempty"`
+	Attributes  []Attribute `json:"attributes,omitempty"`
+
+	// Example is the content of the provider example file, if any.
+	Example string `json:"example,omitempty"`
+}
+
+// Resource describes a resource, data source, ephemeral resource, list
+// resource, or action.
+type Resource struct {
+	Name        string      `json:"name"`
+	Description string      `json:"description,omitempty"`
+	Deprecated  bool        `json:"deprecated,omitempty"`
+	Attributes  []Attribute `json:"attributes,omitempty"`
+
+	// Example is the content of the example file, if any.
+	Example string `json:"example,omitempty"`
+
+	// Import is the content of the import example file, if any. Only set
+	// for resources.
+	Import string `json:"import,omitempty"`
+}
+
+// Attribute describes an attribute or block of a schema.
+type Attribute struct {
+	Name string `json:"name"`
+	Kind Kind   `json:"kind"`
+
+	// Type is the human readable type of the attribute or block, as rendered
+	// in the Markdown documentation, e.g. "List of String" or "Block Set".
+	Type string `json:"type"`
+
+	Description string `json:"description,omitempty"`
+	Required    bool   `json:"required,omitempty"`
+	Optional    bool   `json:"optional,omitempty"`
+	Computed    bool   `json:"computed,omitempty"`
+	Sensitive   bool   `json:"sensitive,omitempty"`
+	WriteOnly   bool   `json:"write_only,omitempty"`
+	Deprecated  bool   `json:"deprecated,omitempty"`
+
+	// MinItems and MaxItems are the limits of the number of block items.
+	// Only set for blocks.
+	MinItems uint64 `json:"min_items,omitempty"`
+	MaxItems uint64 `json:"max_items,omitempty"`
+
+	// Attributes contains the nested attributes and blocks of nested
+	// attributes and blocks.
+	Attributes []Attribute `json:"attributes,omitempty"`
+}
+
+// Function describes a provider function.
+type Function struct {
+	Name               string `json:"name"`
+	Summary            string `json:"summary,omitempty"`
+	Description        string `json:"description,omitempty"`
+	DeprecationMessage string `json:"deprecation_message,omitempty"`
+
+	// Signature is the function signature, e.g.
+	// "example(input string) string".
+	Signature string `json:"signature"`
+
+	Parameters        []Parameter `json:"parameters,omitempty"`
+	VariadicParameter *Parameter  `json:"variadic_parameter,omitempty"`
+	ReturnType        string      `json:"return_type"`
+
+	// Example is the content of the example file, if any.
+	Example string `json:"example,omitempty"`
+}
+
+// Parameter describes a function parameter.
+type Parameter struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Nullable    bool   `json:"nullable,omitempty"`
+}
+
+// Build returns the documentation model of a provider schema. Items are sorted
+// by name. If ignoreDeprecated is true, deprecated resources, data sources,
+// ephemeral resources, list resources, actions, and functions are omitted.
+func Build(providerName string, schema *tfjson.ProviderSchema, actionSchemas map[string]*tfjson.Schema, ignoreDeprecated bool) (*Model, error) {
+	if schema == nil {
+		schema = &tfjson.ProviderSchema{}
+	}
+
+	model := &Model{
+		Provider: Provider{
+			Name: providerName,
+		},
+	}
+
+	if schema.ConfigSchema != nil && schema.ConfigSchema.Block != nil {
+		model.Provider.Description = strings.TrimSpace(schema.ConfigSchema.Block.Description)
+
+		attributes, err := blockAttributes(schema.ConfigSchema.Block)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build provider model: %w", err)
+		}
+		model.Provider.Attributes = attributes
+	}
+
+	var err error
+
+	model.Resources, err = resources(schema.ResourceSchemas, ignoreDeprecated)
+	if err != nil {
+		return nil, err
+	}
+
+	model.DataSources, err = resources(schema.DataSourceSchemas, ignoreDeprecated)
+	if err != nil {
+		return nil, err
+	}
+
+	model.EphemeralResources, err = resources(schema.EphemeralResourceSchemas, ignoreDeprecated)
+	if err != nil {
+		return nil, err
+	}
+
+	model.ListResources, err = resources(schema.ListResourceSchemas, ignoreDeprecated)
+	if err != nil {
+		return nil, err
+	}
+
+	model.Actions, err = resources(actionSchemas, ignoreDeprecated)
+	if err != nil {
+		return nil, err
+	}
+
+	model.Functions, err = functions(schema.Functions, ignoreDeprecated)
+	if err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+func resources(schemas map[string]*tfjson.Schema, ignoreDeprecated bool) ([]Resource, error) {
+	var result []Resource
+
+	for _, name := range sortedKeys(schemas) {
+		schema := schemas[name]
+		if schema == nil || schema.Block == nil {
+			continue
+		}
+
+		if ignoreDeprecated && schema.Block.Deprecated {
+			continue
+		}
+
+		attributes, err := blockAttributes(schema.Block)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build model of %q: %w", name, err)
+		}
+
+		result = append(result, Resource{
+			Name:        name,
+			Description: strings.TrimSpace(schema.Block.Description),
+			Deprecated:  schema.Block.Deprecated,
+			Attributes:  attributes,
+		})
+	}
+
+	return result, nil
+}
+
+func functions(signatures map[string]*tfjson.FunctionSignature, ignoreDeprecated bool) ([]Function, error) {
+	var result []Function
+
+	for _, name := range sortedKeys(signatures) {
+		signature := signatures[name]
+		if signature == nil {
+			continue
+		}
+
+		if ignoreDeprecated && signature.DeprecationMessage != "" {
+			continue
+		}
+
+		function := Function{
+			Name:               name,
+			Summary:            strings.TrimSpace(signature.Summary),
+			Description:        strings.TrimSpace(signature.Description),
+			DeprecationMessage: signature.DeprecationMessage,
+			Signature:          functionSignature(name, signature),
+		}
+
+		var err error
+
+		function.ReturnType, err = typeString(signature.ReturnType)
+		if err != nil {
+			return nil, fmt.Errorf("unable to build model of function %q: %w", name, err)
+		}
+
+		for _, p := range signature.Parameters {
+			param, err := parameter(p)
+			if err != nil {
+				return nil, fmt.Errorf("unable to build model of function %q: %w", name, err)
+			}
+			function.Parameters = append(function.Parameters, param)
+		}
+
+		if signature.VariadicParameter != nil {
+			param, err := parameter(signature.VariadicParameter)
+			if err != nil {
+				return nil, fmt.Errorf("unable to build model of function %q: %w", name, err)
+			}
+			function.VariadicParameter = &param
+		}
+
+		result = append(result, function)
+	}
+
+	return result, nil
+}
+
+func parameter(p *tfjson.FunctionParameter) (Parameter, error) {
+	ty, err := typeString(p.Type)
+	if err != nil {
+		return Parameter{}, fmt.Errorf("unable to build model of parameter %q: %w", p.Name, err)
+	}
+
+	return Parameter{
+		Name:        p.Name,
+		Type:        ty,
+		Description: strings.TrimSpace(p.Description),
+		Nullable:    p.IsNullable,
+	}, nil
+}
+
+// functionSignature returns the plain text signature of a function, matching
+// the signature in the Markdown documentation.
+func functionSignature(name string, signature *tfjson.FunctionSignature) string {
+	var params []string
+	for _, p := range signature.Parameters {
+		params = append(params, fmt.Sprintf("%s %s", p.Name, p.Type.FriendlyName()))
+	}
+
+	if signature.VariadicParameter != nil {
+		params = append(params, fmt.Sprintf("%s %s...", signature.VariadicParameter.Name, signature.VariadicParameter.Type.FriendlyName()))
+	}
+
+	return fmt.Sprintf("%s(%s) %s", name, strings.Join(params, ", "), signature.ReturnType.FriendlyName())
+}
+
+func blockAttributes(block *tfjson.SchemaBlock) ([]Attribute, error) {
+	var result []Attribute
+
+	for name, att := range block.Attributes {
+		attribute, err := schemaAttribute(name, att)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, attribute)
+	}
+
+	for name, nested := range block.NestedBlocks {
+		attribute, err := blockType(name, nested)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, attribute)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+
+	return result, nil
+}
+
+func schemaAttribute(name string, att *tfjson.SchemaAttribute) (Attribute, error) {
+	attribute := Attribute{
+		Name:        name,
+		Kind:        KindAttribute,
+		Description: strings.TrimSpace(att.Description),
+		Required:    att.Required,
+		Optional:    att.Optional,
+		Computed:    att.Computed,
+		Sensitive:   att.Sensitive,
+		WriteOnly:   att.WriteOnly,
+		Deprecated:  att.Deprecated,
+	}
+
+	if att.AttributeNestedType != nil {
+		attribute.Type = strings.TrimSpace("Attributes " + nestingModeName(att.AttributeNestedType.NestingMode))
+
+		for childName, child := range att.AttributeNestedType.Attributes {
+			childAttribute, err := schemaAttribute(childName, child)
+			if err != nil {
+				return Attribute{}, err
+			}
+			attribute.Attributes = append(attribute.Attributes, childAttribute)
+		}
+
+		sort.Slice(attribute.Attributes, func(i, j int) bool {
+			return attribute.Attributes[i].Name < attribute.Attributes[j].Name
+		})
+
+		return attribute, nil
+	}
+
+	var err error
+	attribute.Type, err = typeString(att.AttributeType)
+	if err != nil {
+		return Attribute{}, fmt.Errorf("unable to build model of attribute %q: %w", name, err)
+	}
+
+	return attribute, nil
+}
+
+func blockType(name string, block *tfjson.SchemaBlockType) (Attribute, error) {
+	attribute := Attribute{
+		Name:     name,
+		Kind:     KindBlock,
+		Type:     strings.TrimSpace("Block " + nestingModeName(block.NestingMode)),
+		MinItems: block.MinItems,
+		MaxItems: block.MaxItems,
+	}
+
+	if block.Block == nil {
+		return attribute, nil
+	}
+
+	attribute.Description = strings.TrimSpace(block.Block.Description)
+	attribute.Deprecated = block.Block.Deprecated
+
+	// Blocks are required or optional based on the minimum number of items,
+	// or computed if all of their attributes and blocks are computed.
+	switch {
+	case block.MinItems > 0:
+		attribute.Required = true
+	case blockIsComputed(block.Block):
+		attribute.Computed = true
+	default:
+		attribute.Optional = true
+	}
+
+	var err error
+	attribute.Attributes, err = blockAttributes(block.Block)
+	if err != nil {
+		return Attribute{}, fmt.Errorf("unable to build model of block %q: %w", name, err)
+	}
+
+	return attribute, nil
+}
+
+func blockIsComputed(block *tfjson.SchemaBlock) bool {
+	if len(block.Attributes) == 0 && len(block.NestedBlocks) == 0 {
+		return false
+	}
+
+	for _, att := range block.Attributes {
+		if !att.Computed || att.Optional {
+			return false
+		}
+	}
+
+	for _, nested := range block.NestedBlocks {
+		if nested.Block == nil || !blockIsComputed(nested.Block) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func nestingModeName(mode tfjson.SchemaNestingMode) string {
+	switch mode {
+	case tfjson.SchemaNestingModeList:
+		return "List"
+	case tfjson.SchemaNestingModeSet:
+		return "Set"
+	case tfjson.SchemaNestingModeMap:
+		return "Map"
+	}
+
+	return ""
+}
+
+func typeString(ty cty.Type) (string, error) {
+	b := &bytes.Buffer{}
+
+	err := schemamd.WriteType(b, ty)
+	if err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docsmodel_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/docsmodel"
+)
+
+func TestBuild(t *testing.T) {
+	t.Parallel()
+
+	exampleSchema := &tfjson.ProviderSchema{
+		ConfigSchema: &tfjson.Schema{
+			Block: &tfjson.SchemaBlock{
+				Description: "Example provider",
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"token": {AttributeType: cty.String, Optional: true, Sensitive: true},
+				},
+			},
+		},
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_widget": {
+				Block: &tfjson.SchemaBlock{
+					Description: "Example widget",
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"name": {AttributeType: cty.String, Required: true, Description: " Widget name "},
+						"settings": {
+							AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+								NestingMode: tfjson.SchemaNestingModeSingle,
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"value": {AttributeType: cty.List(cty.String), Computed: true},
+								},
+							},
+							Optional: true,
+						},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							NestingMode: tfjson.SchemaNestingModeSet,
+							MinItems:    1,
+							MaxItems:    2,
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"priority": {AttributeType: cty.Number, Optional: true},
+								},
+							},
+						},
+						"status": {
+							NestingMode: tfjson.SchemaNestingModeList,
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"state": {AttributeType: cty.String, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			"scaffolding_example": {
+				Block: &tfjson.SchemaBlock{
+					Deprecated: true,
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"id": {AttributeType: cty.String, Computed: true, WriteOnly: true},
+					},
+				},
+			},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"join": {
+				Summary:    "Join strings",
+				ReturnType: cty.String,
+				Parameters: []*tfjson.FunctionParameter{
+					{Name: "separator", Type: cty.String},
+				},
+				VariadicParameter: &tfjson.FunctionParameter{
+					Name:       "values",
+					Type:       cty.String,
+					IsNullable: true,
+				},
+			},
+			"legacy": {
+				DeprecationMessage: "Use join instead",
+				ReturnType:         cty.Bool,
+			},
+		},
+	}
+
+	exampleAction := map[string]*tfjson.Schema{
+		"scaffolding_action": {
+			Block: &tfjson.SchemaBlock{
+				Description: "Example action",
+			},
+		},
+	}
+
+	expectedProvider := docsmodel.Provider{
+		Name:        "scaffolding",
+		Description: "Example provider",
+		Attributes: []docsmodel.Attribute{
+			{Name: "token", Kind: docsmodel.KindAttribute, Type: "String", Optional: true, Sensitive: true},
+		},
+	}
+
+	expectedWidget := docsmodel.Resource{
+		Name:        "scaffolding_widget",
+		Description: "Example widget",
+		Attributes: []docsmodel.Attribute{
+			{Name: "name", Kind: docsmodel.KindAttribute, Type: "String", Description: "Widget name", Required: true},
+			{
+				Name:     "rule",
+				Kind:     docsmodel.KindBlock,
+				Type:     "Block Set",
+				Required: true,
+				MinItems: 1,
+				MaxItems: 2,
+				Attributes: []docsmodel.Attribute{
+					{Name: "priority", Kind: docsmodel.KindAttribute, Type: "Number", Optional: true},
+				},
+			},
+			{
+				Name:     "settings",
+				Kind:     docsmodel.KindAttribute,
+				Type:     "Attributes",
+				Optional: true,
+				Attributes: []docsmodel.Attribute{
+					{Name: "value", Kind: docsmodel.KindAttribute, Type: "List of String", Computed: true},
+				},
+			},
+			{
+				Name:     "status",
+				Kind:     docsmodel.KindBlock,
+				Type:     "Block List",
+				Computed: true,
+				Attributes: []docsmodel.Attribute{
+					{Name: "state", Kind: docsmodel.KindAttribute, Type: "String", Computed: true},
+				},
+			},
+		},
+	}
+
+	expectedJoin := docsmodel.Function{
+		Name:      "join",
+		Summary:   "Join strings",
+		Signature: "join(separator string, values string...) string",
+		Parameters: []docsmodel.Parameter{
+			{Name: "separator", Type: "String"},
+		},
+		VariadicParameter: &docsmodel.Parameter{Name: "values", Type: "String", Nullable: true},
+		ReturnType:        "String",
+	}
+
+	cases := map[string]struct {
+		schema           *tfjson.ProviderSchema
+		actionSchemas    map[string]*tfjson.Schema
+		ignoreDeprecated bool
+		expected         *docsmodel.Model
+	}{
+		"nil schema": {
+			expected: &docsmodel.Model{
+				Provider: docsmodel.Provider{Name: "scaffolding"},
+			},
+		},
+		"all items": {
+			schema:        exampleSchema,
+			actionSchemas: exampleAction,
+			expected: &docsmodel.Model{
+				Provider: expectedProvider,
+				Resources: []docsmodel.Resource{
+					{
+						Name:       "scaffolding_example",
+						Deprecated: true,
+						Attributes: []docsmodel.Attribute{
+							{Name: "id", Kind: docsmodel.KindAttribute, Type: "String", Computed: true, WriteOnly: true},
+						},
+					},
+					expectedWidget,
+				},
+				Actions: []docsmodel.Resource{
+					{Name: "scaffolding_action", Description: "Example action"},
+				},
+				Functions: []docsmodel.Function{
+					expectedJoin,
+					{
+						Name:               "legacy",
+						DeprecationMessage: "Use join instead",
+						Signature:          "legacy() bool",
+						ReturnType:         "Boolean",
+					},
+				},
+			},
+		},
+		"ignore deprecated": {
+			schema:           exampleSchema,
+			ignoreDeprecated: true,
+			expected: &docsmodel.Model{
+				Provider:  expectedProvider,
+				Resources: []docsmodel.Resource{expectedWidget},
+				Functions: []docsmodel.Function{expectedJoin},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := docsmodel.Build("scaffolding", c.schema, c.actionSchemas, c.ignoreDeprecated)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderJSON(t *testing.T) {
+	t.Parallel()
+
+	model := &docsmodel.Model{
+		Provider: docsmodel.Provider{Name: "scaffolding"},
+		DataSources: []docsmodel.Resource{
+			{
+				Name:    "scaffolding_example",
+				Example: `data "scaffolding_example" "example" {}`,
+				Attributes: []docsmodel.Attribute{
+					{Name: "id", Kind: docsmodel.KindAttribute, Type: "String", Computed: true},
+				},
+			},
+		},
+	}
+
+	expected := `{
+  "provider": {
+    "name": "scaffolding"
+  },
+  "data_sources": [
+    {
+      "name": "scaffolding_example",
+      "attributes": [
+        {
+          "name": "id",
+          "kind": "attribute",
+          "type": "String",
+          "computed": true
+        }
+      ],
+      "example": "data \"scaffolding_example\" \"example\" {}"
+    }
+  ]
+}
+`
+
+	b := &strings.Builder{}
+
+	err := docsmodel.RenderJSON(b, model)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(expected, b.String()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docsmodel
+
+import (
+	"encoding/json"
+	"io"
+)
+
+// RenderJSON writes the model as indented JSON.
+func RenderJSON(w io.Writer, model *Model) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(model)
+}
@@ -5,23 +5,108 @@ package mdplain
 
 import (
 	"bytes"
+	"strings"
+	"unicode"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/extension"
 )
 
+// truncationSuffix is appended to text which is truncated to a maximum
+// length.
+const truncationSuffix = "..."
+
+// Options configures how Markdown is rendered as plain text.
+type Options struct {
+	// OmitLinkURLs writes only the text of links, instead of their text
+	// followed by their URL.
+	OmitLinkURLs bool
+
+	// FirstSentence keeps only the first sentence of the text.
+	FirstSentence bool
+
+	// MaxLength is the maximum number of characters of the text, which is
+	// truncated at a word boundary, with a "..." suffix, if longer. The
+	// default of 0 does not limit the length.
+	MaxLength int
+}
+
 // Clean runs a VERY naive cleanup of markdown text to make it more palatable as plain text.
 func PlainMarkdown(markdown string) (string, error) {
+	return PlainMarkdownWithOptions(markdown, Options{})
+}
+
+// PlainMarkdownWithOptions renders markdown as plain text, the same as
+// PlainMarkdown, with the options applied.
+func PlainMarkdownWithOptions(markdown string, opts Options) (string, error) {
 	var buf bytes.Buffer
 	extensions := []goldmark.Extender{
 		extension.Linkify,
+		extension.Table,
 	}
 	md := goldmark.New(
 		goldmark.WithExtensions(extensions...),
-		goldmark.WithRenderer(NewTextRenderer()),
+		goldmark.WithRenderer(&TextRender{omitLinkURLs: opts.OmitLinkURLs}),
 	)
 	if err := md.Convert([]byte(markdown), &buf); err != nil {
 		return "", err
 	}
-	return buf.String(), nil
+
+	text := buf.String()
+
+	if opts.FirstSentence {
+		text = FirstSentence(text)
+	}
+
+	if opts.MaxLength > 0 {
+		text = Truncate(text, opts.MaxLength)
+	}
+
+	return text, nil
+}
+
+// FirstSentence returns the first sentence of the plain text, which ends at
+// the first ".", "!", or "?" followed by whitespace, with its line breaks
+// replaced by spaces. Text without such an end is returned as one line.
+func FirstSentence(text string) string {
+	text = strings.TrimSpace(text)
+	runes := []rune(text)
+
+	for i, r := range runes {
+		if r != '.' && r != '!' && r != '?' {
+			continue
+		}
+
+		if i+1 == len(runes) || unicode.IsSpace(runes[i+1]) {
+			text = string(runes[:i+1])
+			break
+		}
+	}
+
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// Truncate returns the plain text limited to maxLength characters. Longer
+// text is cut at the last word boundary which fits with a "..." suffix.
+func Truncate(text string, maxLength int) string {
+	runes := []rune(text)
+	if len(runes) <= maxLength {
+		return text
+	}
+
+	if maxLength <= len(truncationSuffix) {
+		return string(runes[:maxLength])
+	}
+
+	cut := runes[:maxLength-len(truncationSuffix)]
+	for i := len(cut); i > 0; i-- {
+		if unicode.IsSpace(runes[i]) {
+			cut = runes[:i]
+			break
+		}
+	}
+
+	return strings.TrimRightFunc(string(cut), func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	}) + truncationSuffix
 }
@@ -36,3 +36,77 @@ func TestPlainMarkdown(t *testing.T) {
 	}
 
 }
+
+func TestPlainMarkdownWithOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		markdown string
+		opts     Options
+		expected string
+	}{
+		"defaults": {
+			markdown: "Manages a [widget](https://example.com/widgets). See `name`.",
+			expected: "Manages a widget https://example.com/widgets. See name.",
+		},
+		"omit link URLs": {
+			markdown: "Manages a [widget](https://example.com/widgets). See `name`.",
+			opts:     Options{OmitLinkURLs: true},
+			expected: "Manages a widget. See name.",
+		},
+		"nested lists": {
+			markdown: "Modes:\n\n- `fast`\n  1. first\n  2. second\n- `slow`",
+			expected: "Modes:\n- fast\n  1. first\n  2. second\n- slow",
+		},
+		"first sentence": {
+			markdown: "Manages a widget of\nthe example service. Widgets are billed hourly!\n\nMore details.",
+			opts:     Options{FirstSentence: true},
+			expected: "Manages a widget of the example service.",
+		},
+		"first sentence without end": {
+			markdown: "# Widget\n\nManages a widget",
+			opts:     Options{FirstSentence: true},
+			expected: "Widget Manages a widget",
+		},
+		"first sentence with version": {
+			markdown: "Requires version 1.2 or later. Other text.",
+			opts:     Options{FirstSentence: true},
+			expected: "Requires version 1.2 or later.",
+		},
+		"max length": {
+			markdown: "Manages a widget, which is billed hourly.",
+			opts:     Options{MaxLength: 20},
+			expected: "Manages a widget...",
+		},
+		"max length fits": {
+			markdown: "Manages a widget.",
+			opts:     Options{MaxLength: 17},
+			expected: "Manages a widget.",
+		},
+		"max length without spaces": {
+			markdown: "Supercalifragilisticexpialidocious",
+			opts:     Options{MaxLength: 10},
+			expected: "Superca...",
+		},
+		"first sentence and max length": {
+			markdown: "Manages a [widget](https://example.com/widgets) of the example service. Other text.",
+			opts:     Options{OmitLinkURLs: true, FirstSentence: true, MaxLength: 32},
+			expected: "Manages a widget of the...",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := PlainMarkdownWithOptions(testCase.markdown, testCase.opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -6,13 +6,17 @@ package mdplain
 import (
 	"bytes"
 	"io"
+	"strconv"
 
 	"github.com/yuin/goldmark/ast"
 	extAST "github.com/yuin/goldmark/extension/ast"
 	"github.com/yuin/goldmark/renderer"
 )
 
-type TextRender struct{}
+type TextRender struct {
+	// omitLinkURLs writes only the text of links, without their URL.
+	omitLinkURLs bool
+}
 
 func NewTextRenderer() *TextRender {
 	return &TextRender{}
@@ -48,11 +52,24 @@ func (r *TextRender) Render(w io.Writer, source []byte, n ast.Node) error {
 				_, _ = out.Write(line.Value(source))
 			}
 			return ast.WalkSkipChildren, nil
-		case *ast.List:
+		case *ast.ListItem:
 			doubleSpace(out)
+			out.WriteString(listItemPrefix(node))
 			return ast.WalkContinue, nil
-		case *ast.Paragraph:
+		case *extAST.TableHeader, *extAST.TableRow:
 			doubleSpace(out)
+			return ast.WalkContinue, nil
+		case *extAST.TableCell:
+			// Cells are separated by commas, as plain text has no columns.
+			if node.PreviousSibling() != nil {
+				out.WriteString(", ")
+			}
+			return ast.WalkContinue, nil
+		case *ast.Paragraph:
+			// The first paragraph of a list item follows its prefix.
+			if _, ok := node.Parent().(*ast.ListItem); !ok || node.PreviousSibling() != nil {
+				doubleSpace(out)
+			}
 			if node.Text(source)[0] == '|' { // Write tables as-is.
 				for i := 0; i < node.Lines().Len(); i++ {
 					line := node.Lines().At(i)
@@ -72,7 +89,7 @@ func (r *TextRender) Render(w io.Writer, source []byte, n ast.Node) error {
 			return ast.WalkSkipChildren, nil
 		case *ast.Link:
 			_, err := out.Write(node.Text(source))
-			if !isRelativeLink(node.Destination) {
+			if !r.omitLinkURLs && !isRelativeLink(node.Destination) {
 				out.WriteString(" ")
 				out.Write(node.Destination)
 			}
@@ -101,6 +118,29 @@ func (r *TextRender) Render(w io.Writer, source []byte, n ast.Node) error {
 
 func (r *TextRender) AddOptions(...renderer.Option) {}
 
+// listItemPrefix returns the indented prefix of the list item, which is its
+// number in ordered lists, or a hyphen otherwise.
+func listItemPrefix(item *ast.ListItem) string {
+	indent := ""
+	for parent := item.Parent(); parent != nil; parent = parent.Parent() {
+		if _, ok := parent.(*ast.ListItem); ok {
+			indent += "  "
+		}
+	}
+
+	list, ok := item.Parent().(*ast.List)
+	if !ok || !list.IsOrdered() {
+		return indent + "- "
+	}
+
+	number := list.Start
+	for sibling := item.PreviousSibling(); sibling != nil; sibling = sibling.PreviousSibling() {
+		number++
+	}
+
+	return indent + strconv.Itoa(number) + ". "
+}
+
 func doubleSpace(out *bytes.Buffer) {
 	if out.Len() > 0 {
 		out.WriteByte('\n')
@@ -0,0 +1,289 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+// attributeMetadata are values of attributes which are not part of the
+// schema, such as default values, by rendered website subdirectory, such as
+// "resources", then item name, then dot separated attribute path. The
+// provider schema has an empty subdirectory and item name.
+type attributeMetadata[V any] map[string]map[string]map[string]V
+
+// set sets the value of the attribute at path of the named item in the
+// rendered website subdirectory dir.
+func (m attributeMetadata[V]) set(dir, name, path string, value V) {
+	if m[dir] == nil {
+		m[dir] = make(map[string]map[string]V)
+	}
+
+	if m[dir][name] == nil {
+		m[dir][name] = make(map[string]V)
+	}
+
+	m[dir][name][path] = value
+}
+
+// itemSchemaOptions returns the schema render options with the default
+// values, validators, replacements, deprecations, and type names of the
+// attributes of the named item in the rendered website subdirectory dir, or
+// the options unchanged if the item has none of them.
+func (g *generator) itemSchemaOptions(opts *schemamd.RenderOptions, dir, name string) *schemamd.RenderOptions {
+	defaults := g.attributeDefaults[dir][name]
+	validators := g.attributeValidators[dir][name]
+	replacements := g.attributeReplacements[dir][name]
+	deprecations := g.deprecations[dir][name]
+	typeNames := g.attributeTypeNames[dir][name]
+
+	if len(defaults) == 0 && len(validators) == 0 && len(replacements) == 0 && len(deprecations) == 0 && len(typeNames) == 0 {
+		return opts
+	}
+
+	itemOpts := schemamd.RenderOptions{}
+	if opts != nil {
+		itemOpts = *opts
+	}
+	itemOpts.Defaults = defaults
+	itemOpts.Validators = validators
+	itemOpts.RequiresReplace = replacements
+	itemOpts.Deprecations = deprecations
+	itemOpts.TypeNames = typeNames
+
+	return &itemOpts
+}
+
+// schemaExtensionsProvider is the part of a provider in a providers schema
+// JSON which contains attribute metadata. Terraform does not export attribute
+// metadata, but providers schema JSONs exported by other tools, such as the
+// schema export of a provider framework, may include a "default" value,
+// "validators", and a "requires_replace" flag for each attribute, which
+// terraform-json does not decode.
+type schemaExtensionsProvider struct {
+	Provider                 *schemaExtensionsSchema            `json:"provider,omitempty"`
+	ResourceSchemas          map[string]*schemaExtensionsSchema `json:"resource_schemas,omitempty"`
+	DataSourceSchemas        map[string]*schemaExtensionsSchema `json:"data_source_schemas,omitempty"`
+	EphemeralResourceSchemas map[string]*schemaExtensionsSchema `json:"ephemeral_resource_schemas,omitempty"`
+	ListResourceSchemas      map[string]*schemaExtensionsSchema `json:"list_resource_schemas,omitempty"`
+	ActionSchemas            map[string]*schemaExtensionsSchema `json:"action_schemas,omitempty"`
+}
+
+type schemaExtensionsSchema struct {
+	Block *schemaExtensionsBlock `json:"block,omitempty"`
+}
+
+type schemaExtensionsBlock struct {
+	Attributes map[string]*schemaExtensionsAttribute `json:"attributes,omitempty"`
+	BlockTypes map[string]*schemaExtensionsSchema    `json:"block_types,omitempty"`
+}
+
+type schemaExtensionsAttribute struct {
+	Default         json.RawMessage `json:"default,omitempty"`
+	Validators      []validatorJSON `json:"validators,omitempty"`
+	RequiresReplace bool            `json:"requires_replace,omitempty"`
+	NestedType      *struct {
+		Attributes map[string]*schemaExtensionsAttribute `json:"attributes,omitempty"`
+	} `json:"nested_type,omitempty"`
+}
+
+// walkSchemaExtensions calls fn for every attribute, including nested
+// attributes and the attributes of nested blocks, of the first of the given
+// provider addresses in the providers schema JSON.
+func walkSchemaExtensions(schemajson []byte, fn func(dir, name string, path []string, att *schemaExtensionsAttribute) error, addresses ...string) error {
+	schemas := &struct {
+		Schemas map[string]*schemaExtensionsProvider `json:"provider_schemas,omitempty"`
+	}{}
+
+	err := json.Unmarshal(schemajson, schemas)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		ps, ok := schemas.Schemas[address]
+		if !ok || ps == nil {
+			continue
+		}
+
+		if ps.Provider != nil {
+			err = walkBlockExtensions("", "", nil, ps.Provider.Block, fn)
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, items := range []struct {
+			dir     string
+			schemas map[string]*schemaExtensionsSchema
+		}{
+			{"resources", ps.ResourceSchemas},
+			{"data-sources", ps.DataSourceSchemas},
+			{"ephemeral-resources", ps.EphemeralResourceSchemas},
+			{"list-resources", ps.ListResourceSchemas},
+			{"actions", ps.ActionSchemas},
+		} {
+			for _, name := range sortedKeys(items.schemas) {
+				schema := items.schemas[name]
+				if schema == nil {
+					continue
+				}
+
+				err = walkBlockExtensions(items.dir, name, nil, schema.Block, fn)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	}
+
+	return nil
+}
+
+// walkBlockExtensions calls fn for the attributes of the block at parents,
+// and of its nested attributes and blocks.
+func walkBlockExtensions(dir, name string, parents []string, block *schemaExtensionsBlock, fn func(dir, name string, path []string, att *schemaExtensionsAttribute) error) error {
+	if block == nil {
+		return nil
+	}
+
+	err := walkAttributesExtensions(dir, name, parents, block.Attributes, fn)
+	if err != nil {
+		return err
+	}
+
+	for _, blockName := range sortedKeys(block.BlockTypes) {
+		blockType := block.BlockTypes[blockName]
+		if blockType == nil {
+			continue
+		}
+
+		err = walkBlockExtensions(dir, name, append(parents[:len(parents):len(parents)], blockName), blockType.Block, fn)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// walkAttributesExtensions calls fn for the attributes at parents, and for
+// their nested attributes.
+func walkAttributesExtensions(dir, name string, parents []string, attributes map[string]*schemaExtensionsAttribute, fn func(dir, name string, path []string, att *schemaExtensionsAttribute) error) error {
+	for _, attName := range sortedKeys(attributes) {
+		att := attributes[attName]
+		if att == nil {
+			continue
+		}
+
+		path := append(parents[:len(parents):len(parents)], attName)
+
+		err := fn(dir, name, path, att)
+		if err != nil {
+			return err
+		}
+
+		if att.NestedType != nil {
+			err = walkAttributesExtensions(dir, name, path, att.NestedType.Attributes, fn)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// attributeMetadataFile is the format of attribute metadata files, which
+// contain values of the attributes of the provider, and of the items of each
+// rendered website subdirectory by name, by dot separated attribute path, for
+// example:
+//
+//	{
+//	  "provider": {"region": <value>},
+//	  "resources": {
+//	    "scaffolding_example": {"configurable_attribute": <value>, "settings.enabled": <value>}
+//	  }
+//	}
+type attributeMetadataFile[V any] struct {
+	Provider           map[string]V            `json:"provider,omitempty"`
+	Resources          map[string]map[string]V `json:"resources,omitempty"`
+	DataSources        map[string]map[string]V `json:"data-sources,omitempty"`
+	EphemeralResources map[string]map[string]V `json:"ephemeral-resources,omitempty"`
+	ListResources      map[string]map[string]V `json:"list-resources,omitempty"`
+	Actions            map[string]map[string]V `json:"actions,omitempty"`
+}
+
+// readAttributeMetadataFile returns the values of the attribute metadata file
+// at path, by rendered website subdirectory, item name, and attribute path.
+// The description of the file is used in errors.
+func readAttributeMetadataFile[V any](path, description string) (attributeMetadata[V], error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read %s file %q: %w", description, path, err)
+	}
+
+	var file attributeMetadataFile[V]
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	err = decoder.Decode(&file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse %s file %q: %w", description, path, jsonErrorPosition(data, err))
+	}
+
+	metadata := attributeMetadata[V]{
+		"resources":           file.Resources,
+		"data-sources":        file.DataSources,
+		"ephemeral-resources": file.EphemeralResources,
+		"list-resources":      file.ListResources,
+		"actions":             file.Actions,
+	}
+	if file.Provider != nil {
+		metadata[""] = map[string]map[string]V{"": file.Provider}
+	}
+
+	return metadata, nil
+}
+
+// metadataFilePath returns the absolute path of an attribute metadata file,
+// which is relative to the provider directory.
+func (g *generator) metadataFilePath(file string) string {
+	if filepath.IsAbs(file) {
+		return file
+	}
+
+	return filepath.Join(g.providerDir, file)
+}
+
+// renderJSONValue returns the text of a JSON value, which is the string for
+// strings and the compact JSON otherwise, or false if the value is null.
+func renderJSONValue(raw json.RawMessage) (string, bool, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return "", false, nil
+	}
+
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s, true, nil
+	}
+
+	b := &bytes.Buffer{}
+	err := json.Compact(b, raw)
+	if err != nil {
+		return "", false, err
+	}
+
+	return b.String(), true, nil
+}
@@ -0,0 +1,486 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs/build"
+)
+
+// renderCacheVersion is the version of the render cache file format. Cache
+// files of other versions are discarded.
+const renderCacheVersion = 2
+
+// renderCache contains the content hashes of rendered resource, data source,
+// ephemeral resource, list resource, action, and function pages, by path
+// relative to the rendered website directory. A page is not rendered again if
+// the hash of its inputs (schema, template, and examples) is unchanged since
+// the previous run, and neither are the files read by its template functions.
+type renderCache struct {
+	// path is the absolute path of the cache file.
+	path string
+
+	// baseDir is the directory which the paths of files read by template
+	// functions are relative to, if they are inside of it.
+	baseDir string
+
+	// settings is the hash of the inputs which affect every page, such as the
+	// tfplugindocs version, generation options, and partial templates.
+	settings string
+
+	// previous contains the entries loaded from the cache file.
+	previous map[string]renderCacheEntry
+
+	mu      sync.Mutex
+	entries map[string]renderCacheEntry
+}
+
+type renderCacheFile struct {
+	Version int                         `json:"version"`
+	Entries map[string]renderCacheEntry `json:"entries"`
+}
+
+// renderCacheEntry is the cache entry of a rendered page.
+type renderCacheEntry struct {
+	// Sum is the hash of the inputs of the page.
+	Sum string `json:"sum"`
+
+	// Files contains the content hashes of the files read by template
+	// functions while rendering the page, such as with codefile and tffile,
+	// by slash-separated path relative to the base directory of the cache.
+	Files map[string]string `json:"files,omitempty"`
+
+	// Pages contains the slash-separated paths, relative to the rendered
+	// website directory, of the pages split from the page.
+	Pages []string `json:"pages,omitempty"`
+}
+
+// loadRenderCache returns the render cache of the given file. An empty cache
+// is returned if the file does not exist or has a different format version.
+func loadRenderCache(path, baseDir string) (*renderCache, error) {
+	c := &renderCache{
+		path:     path,
+		baseDir:  baseDir,
+		previous: make(map[string]renderCacheEntry),
+		entries:  make(map[string]renderCacheEntry),
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return c, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read cache file %q: %w", path, err)
+	}
+
+	// The version is decoded first, as the entries of other versions may not
+	// be decodable.
+	var version struct {
+		Version int `json:"version"`
+	}
+	err = json.Unmarshal(data, &version)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse cache file %q: %w", path, jsonErrorPosition(data, err))
+	}
+
+	if version.Version != renderCacheVersion {
+		return c, nil
+	}
+
+	var f renderCacheFile
+	err = json.Unmarshal(data, &f)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse cache file %q: %w", path, jsonErrorPosition(data, err))
+	}
+
+	if f.Entries != nil {
+		c.previous = f.Entries
+	}
+
+	return c, nil
+}
+
+// Unchanged returns true if the hash of the page at the given path is the same
+// as in the previous run, and the files read while rendering the page in the
+// previous run are unchanged.
+func (c *renderCache) Unchanged(rel, sum string) bool {
+	previous, ok := c.previous[rel]
+	if !ok || previous.Sum != sum {
+		return false
+	}
+
+	for file, fileSum := range previous.Files {
+		current, err := hashFile(c.absPath(file))
+		if err != nil || current != fileSum {
+			return false
+		}
+	}
+
+	return true
+}
+
+// PreviousPages returns the paths of the pages split from the page at the
+// given path in the previous run.
+func (c *renderCache) PreviousPages(rel string) []string {
+	return c.previous[rel].Pages
+}
+
+// Keep records the entry of the page at the given path in the previous run,
+// for pages which are unchanged.
+func (c *renderCache) Keep(rel string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[rel] = c.previous[rel]
+}
+
+// Set records the hash of the page at the given path, the content hashes of
+// the files read while rendering it, and the paths of the pages split from it.
+func (c *renderCache) Set(rel, sum string, files, pages []string) error {
+	entry := renderCacheEntry{
+		Sum:   sum,
+		Pages: pages,
+	}
+
+	for _, file := range files {
+		fileSum, err := hashFile(file)
+		if err != nil {
+			return err
+		}
+
+		if entry.Files == nil {
+			entry.Files = make(map[string]string)
+		}
+
+		entry.Files[c.relPath(file)] = fileSum
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[rel] = entry
+
+	return nil
+}
+
+// relPath returns the slash-separated path of the file relative to the base
+// directory, or the absolute path if the file is outside of it.
+func (c *renderCache) relPath(file string) string {
+	rel, err := filepath.Rel(c.baseDir, file)
+	if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return filepath.ToSlash(file)
+	}
+
+	return filepath.ToSlash(rel)
+}
+
+// absPath returns the path of a file recorded by relPath.
+func (c *renderCache) absPath(file string) string {
+	file = filepath.FromSlash(file)
+	if filepath.IsAbs(file) {
+		return file
+	}
+
+	return filepath.Join(c.baseDir, file)
+}
+
+// PreviousFiles returns the paths of the pages in the previous run,
+// including the pages split from them.
+func (c *renderCache) PreviousFiles() map[string]bool {
+	return cacheEntryFiles(c.previous)
+}
+
+// StaleFiles returns the sorted paths of the pages in the previous run which
+// were not rendered or skipped in this run, including the pages split from
+// them.
+func (c *renderCache) StaleFiles() []string {
+	c.mu.Lock()
+	current := cacheEntryFiles(c.entries)
+	c.mu.Unlock()
+
+	var stale []string
+	for rel := range cacheEntryFiles(c.previous) {
+		if !current[rel] {
+			stale = append(stale, rel)
+		}
+	}
+	sort.Strings(stale)
+
+	return stale
+}
+
+// cacheEntryFiles returns the paths of the pages of entries, and of the pages
+// split from them.
+func cacheEntryFiles(entries map[string]renderCacheEntry) map[string]bool {
+	files := make(map[string]bool, len(entries))
+	for rel, entry := range entries {
+		files[rel] = true
+
+		for _, page := range entry.Pages {
+			files[page] = true
+		}
+	}
+
+	return files
+}
+
+// Save writes the cache file. If keepPrevious is true, the entries of pages in
+// the previous run which were not rendered in this run are also written.
+func (c *renderCache) Save(keepPrevious bool) error {
+	entries := make(map[string]renderCacheEntry, len(c.entries))
+	if keepPrevious {
+		for rel, entry := range c.previous {
+			entries[rel] = entry
+		}
+	}
+	for rel, entry := range c.entries {
+		entries[rel] = entry
+	}
+
+	data, err := json.MarshalIndent(renderCacheFile{
+		Version: renderCacheVersion,
+		Entries: entries,
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("unable to marshal cache file: %w", err)
+	}
+
+	return writeFile(c.path, string(data)+"\n")
+}
+
+// CacheFilePath returns the absolute path of the render cache file.
+func (g *generator) CacheFilePath() string {
+	if filepath.IsAbs(g.cacheFile) {
+		return g.cacheFile
+	}
+
+	return filepath.Join(g.providerDir, g.cacheFile)
+}
+
+// cacheSettings returns the hash of the inputs which affect every rendered
+// page.
+func (g *generator) cacheSettings(tmplOpts templateOptions) string {
+	h := sha256.New()
+
+	writeHashPart(h, []byte(build.GetVersion()))
+	writeHashPart(h, []byte(g.providerName))
+	writeHashPart(h, []byte(g.renderedProviderName))
+	writeHashPart(h, []byte(g.providerVersion))
+	writeHashPart(h, []byte(g.providerSource))
+	writeHashPart(h, []byte(g.schemaStyle))
+	writeHashPart(h, []byte(strconv.Itoa(g.inlineNestedDepth)))
+	writeHashPart(h, []byte(strconv.Itoa(g.maxNestedDepth)))
+	writeHashPart(h, []byte(strconv.Itoa(g.inlineObjectMaxAttributes)))
+	writeHashPart(h, []byte(strconv.FormatBool(g.stripExampleHeaders)))
+	writeHashPart(h, []byte(strconv.FormatBool(g.formatExamples)))
+	writeHashPart(h, []byte(g.exampleSyntax))
+	writeHashPart(h, []byte(strconv.FormatBool(g.generateExamples)))
+	writeHashPart(h, []byte(g.outputExtension))
+	writeHashPart(h, []byte(g.frontmatterDialect))
+	writeHashPart(h, []byte(strconv.Itoa(g.frontmatterDescriptionMaxLength)))
+	writeHashPart(h, []byte(strconv.FormatBool(g.frontmatterDescriptionFirstSentence)))
+	writeHashPart(h, []byte(strconv.FormatBool(g.frontmatterDescriptionOmitLinkURLs)))
+	writeHashPart(h, []byte(strconv.Itoa(g.splitPageSize)))
+	writeHashPart(h, []byte(strings.Join(g.schemaGroupOrder, ",")))
+	writeHashPart(h, []byte(strconv.FormatBool(g.attributeAnchors)))
+	writeHashPart(h, []byte(strconv.FormatBool(g.collapsibleNestedSchemas)))
+	writeHashPart(h, []byte(strconv.FormatBool(g.writeOnlySection)))
+	writeHashPart(h, []byte(strconv.FormatBool(g.timeoutsSection)))
+	writeHashPart(h, []byte(strconv.FormatBool(g.sensitiveAttributesSection)))
+	writeHashPart(h, []byte(strconv.FormatBool(g.linkItemMentions)))
+	if g.mentionTargets != nil {
+		// pages are linked to resources and data sources which are added
+		// or removed without changing the pages themselves
+		writeHashPart(h, []byte(strings.Join(g.mentionTargets.names(), ",")))
+	}
+	writeHashPart(h, []byte(g.attributeSort))
+	// only the names of the additional template functions are known, so
+	// pages are not rendered again for changes to the functions themselves
+	writeHashPart(h, []byte(strings.Join(sortedKeys(g.templateFuncs), ",")))
+	writeHashPart(h, []byte(g.postProcessCmd))
+	if g.templateFuncsPlugin != nil {
+		// pages are rendered again when the plugin binary is rebuilt
+		content, err := os.ReadFile(g.templateFuncsPlugin.path)
+		if err == nil {
+			writeHashPart(h, content)
+		}
+	}
+	for _, name := range g.templateEnv {
+		writeHashPart(h, []byte(name))
+		writeHashPart(h, []byte(os.Getenv(name)))
+	}
+	if g.buildTimestampFixed {
+		// the generation timestamp changes on every run, so pages are not
+		// rendered again for it unless it is fixed
+		writeHashPart(h, []byte(g.buildTimestamp.Format(time.RFC3339)))
+	}
+	writeHashPart(h, []byte(g.typeSyntax))
+	writeHashPart(h, []byte(strings.Join(g.attributeOrder, ",")))
+
+	for _, name := range sortedKeys(g.headings) {
+		writeHashPart(h, []byte(name))
+		writeHashPart(h, []byte(g.headings[name]))
+	}
+
+	for _, name := range sortedKeys(g.exampleValues) {
+		writeHashPart(h, []byte(name))
+		writeHashPart(h, []byte(g.exampleValues[name]))
+	}
+
+	names := make([]string, 0, len(tmplOpts.partials))
+	for name := range tmplOpts.partials {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		writeHashPart(h, []byte(name))
+		writeHashPart(h, []byte(tmplOpts.partials[name]))
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// itemHash returns the hash of the inputs of the rendered page of the named
+// item in the given rendered website subdirectory: the cache settings, the
+// subcategory, the template, the schema or function signature, and all files
+// in the examples directory of the item.
+func (g *generator) itemHash(dir, name string, tmplData []byte, providerSchema *tfjson.ProviderSchema) (string, error) {
+	var schema interface{}
+
+	switch dir {
+	case "resources":
+		schema = []interface{}{providerSchema.ResourceSchemas[name], providerSchema.ResourceIdentitySchemas[name]}
+	case "data-sources":
+		schema = providerSchema.DataSourceSchemas[name]
+	case "ephemeral-resources":
+		schema = providerSchema.EphemeralResourceSchemas[name]
+	case "list-resources":
+		schema = providerSchema.ListResourceSchemas[name]
+	case "actions":
+		schema = g.actionSchemas[name]
+	case "functions":
+		schema = providerSchema.Functions[name]
+	}
+
+	schemaData, err := json.Marshal(schema)
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal schema of %q: %w", name, err)
+	}
+
+	defaultsData, err := json.Marshal(g.attributeDefaults[dir][name])
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal attribute defaults of %q: %w", name, err)
+	}
+
+	validatorsData, err := json.Marshal(g.attributeValidators[dir][name])
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal attribute validators of %q: %w", name, err)
+	}
+
+	replacementsData, err := json.Marshal(g.attributeReplacements[dir][name])
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal attribute replacements of %q: %w", name, err)
+	}
+
+	deprecationsData, err := json.Marshal(g.deprecations[dir][name])
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal deprecations of %q: %w", name, err)
+	}
+
+	typeNamesData, err := json.Marshal(g.attributeTypeNames[dir][name])
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal attribute type names of %q: %w", name, err)
+	}
+
+	relatedData, err := json.Marshal(g.relatedResources(dir, name))
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal related resources of %q: %w", name, err)
+	}
+
+	metadataData, err := json.Marshal(g.itemMetadata[dir][name])
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal item metadata of %q: %w", name, err)
+	}
+
+	h := sha256.New()
+	writeHashPart(h, []byte(g.cache.settings))
+	writeHashPart(h, []byte(g.itemSubcategory(dir, name)))
+	writeHashPart(h, tmplData)
+	writeHashPart(h, schemaData)
+	writeHashPart(h, defaultsData)
+	writeHashPart(h, validatorsData)
+	writeHashPart(h, replacementsData)
+	writeHashPart(h, deprecationsData)
+	writeHashPart(h, typeNamesData)
+	writeHashPart(h, relatedData)
+	writeHashPart(h, metadataData)
+
+	examplesDir := filepath.Join(g.ProviderExamplesDir(), dir, name)
+
+	err = filepath.WalkDir(examplesDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			if path == examplesDir && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return fmt.Errorf("unable to walk path %q: %w", path, err)
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read example file %q: %w", path, err)
+		}
+
+		rel, err := filepath.Rel(examplesDir, path)
+		if err != nil {
+			return err
+		}
+
+		writeHashPart(h, []byte(filepath.ToSlash(rel)))
+		writeHashPart(h, content)
+		return nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashFile returns the content hash of the file.
+func hashFile(file string) (string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("unable to read file %q: %w", file, err)
+	}
+
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// writeHashPart writes a length-prefixed part to the hash, so the boundaries
+// between parts are unambiguous.
+func writeHashPart(h hash.Hash, part []byte) {
+	_, _ = fmt.Fprintf(h, "%d:", len(part))
+	_, _ = h.Write(part)
+}
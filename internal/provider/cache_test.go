@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_renderCache(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cache.json")
+
+	c, err := loadRenderCache(path, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if c.Unchanged("resources/example.md", "abc") {
+		t.Error("expected page to be changed without cache file")
+	}
+
+	includedFile := filepath.Join(dir, "examples", "shared.tf")
+
+	err = os.MkdirAll(filepath.Dir(includedFile), 0755)
+	if err != nil {
+		t.Fatalf("unexpected error creating directory: %s", err)
+	}
+
+	err = os.WriteFile(includedFile, []byte("resource \"test_example\" \"shared\" {}\n"), 0644)
+	if err != nil {
+		t.Fatalf("unexpected error writing included file: %s", err)
+	}
+
+	err = c.Set("resources/example.md", "abc", []string{includedFile}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.Set("resources/removed.md", "def", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	err = c.Save(false)
+	if err != nil {
+		t.Fatalf("unexpected error saving cache: %s", err)
+	}
+
+	c, err = loadRenderCache(path, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if !c.Unchanged("resources/example.md", "abc") {
+		t.Error("expected page with same hash to be unchanged")
+	}
+
+	if c.Unchanged("resources/example.md", "xyz") {
+		t.Error("expected page with different hash to be changed")
+	}
+
+	err = os.WriteFile(includedFile, []byte("resource \"test_example\" \"changed\" {}\n"), 0644)
+	if err != nil {
+		t.Fatalf("unexpected error writing included file: %s", err)
+	}
+
+	if c.Unchanged("resources/example.md", "abc") {
+		t.Error("expected page with changed included file to be changed")
+	}
+
+	err = c.Set("resources/example.md", "xyz", nil, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff([]string{"resources/removed.md"}, c.StaleFiles()); diff != "" {
+		t.Errorf("unexpected stale files difference: %s", diff)
+	}
+
+	err = c.Save(true)
+	if err != nil {
+		t.Fatalf("unexpected error saving cache: %s", err)
+	}
+
+	c, err = loadRenderCache(path, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := map[string]renderCacheEntry{
+		"resources/example.md": {Sum: "xyz"},
+		"resources/removed.md": {Sum: "def"},
+	}
+
+	if diff := cmp.Diff(expected, c.previous); diff != "" {
+		t.Errorf("unexpected cache entries difference: %s", diff)
+	}
+}
+
+func Test_loadRenderCache_version(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cache.json")
+
+	err := os.WriteFile(path, []byte(`{"version": 1, "entries": {"resources/example.md": "abc"}}`), 0644)
+	if err != nil {
+		t.Fatalf("unexpected error writing cache file: %s", err)
+	}
+
+	c, err := loadRenderCache(path, dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if c.Unchanged("resources/example.md", "abc") {
+		t.Error("expected entries of other cache format versions to be discarded")
+	}
+}
+
+func Test_renderCache_splitPages(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	c, err := loadRenderCache(filepath.Join(dir, "cache.json"), dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	c.previous = map[string]renderCacheEntry{
+		"resources/example.md": {Sum: "abc", Pages: []string{"resources/example-rule.md", "resources/example-versioning.md"}},
+	}
+
+	expectedPrevious := map[string]bool{
+		"resources/example.md":            true,
+		"resources/example-rule.md":       true,
+		"resources/example-versioning.md": true,
+	}
+
+	if diff := cmp.Diff(expectedPrevious, c.PreviousFiles()); diff != "" {
+		t.Errorf("unexpected previous files difference: %s", diff)
+	}
+
+	err = c.Set("resources/example.md", "xyz", nil, []string{"resources/example-versioning.md"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff([]string{"resources/example-rule.md"}, c.StaleFiles()); diff != "" {
+		t.Errorf("unexpected stale files difference: %s", diff)
+	}
+}
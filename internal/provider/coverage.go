@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/coverage"
+)
+
+const (
+	CoverageFormatMarkdown = "markdown"
+	CoverageFormatJSON     = "json"
+)
+
+// CoverageOptions are the options of Coverage.
+type CoverageOptions struct {
+	// ProviderDir is the path to the root provider directory, which contains
+	// the ignore file, and is built to export the provider schema with
+	// Terraform if there is no ProvidersSchemaPath. Defaults to the working
+	// directory.
+	ProviderDir string
+
+	// ProviderName is the name of the provider. With a ProvidersSchemaPath,
+	// it is only required if the providers schema JSON contains more than one
+	// provider, otherwise it defaults to the name of the provider directory.
+	ProviderName string
+
+	// ProvidersSchemaPath is the path, or URL, of the providers schema JSON,
+	// which contains the output of the terraform providers schema -json
+	// command, instead of building the provider and running Terraform.
+	ProvidersSchemaPath string
+
+	TFVersion    string
+	TFBinary     string
+	UseOpenTofu  bool
+	TFInstallDir string
+	Offline      bool
+
+	// Ignore are the item patterns, in addition to the patterns of the
+	// ignore file, of the items which are not included in the coverage, as
+	// they are not documented.
+	Ignore []string
+
+	// Format is the output format of the report, CoverageFormatMarkdown or
+	// CoverageFormatJSON. Defaults to Markdown.
+	Format string
+
+	// MinCoverage is the minimum total coverage percentage, below which an
+	// error is returned.
+	MinCoverage float64
+}
+
+// Coverage reports the description coverage of the provider schema, except
+// for ignored items, in the given format. An error is returned if the total
+// coverage percentage is below the minimum coverage.
+func Coverage(ui cli.Ui, opts CoverageOptions) error {
+	format := opts.Format
+	if format == "" {
+		format = CoverageFormatMarkdown
+	}
+
+	switch format {
+	case CoverageFormatMarkdown, CoverageFormatJSON:
+	default:
+		return fmt.Errorf("unsupported format %q, expected %q or %q", format, CoverageFormatMarkdown, CoverageFormatJSON)
+	}
+
+	if opts.MinCoverage < 0 || opts.MinCoverage > 100 {
+		return fmt.Errorf("minimum coverage must be a percentage between 0 and 100, got %g", opts.MinCoverage)
+	}
+
+	providerDir := opts.ProviderDir
+
+	// Ensure provider directory is resolved absolute path
+	if providerDir == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("error getting working directory: %w", err)
+		}
+
+		providerDir = wd
+	} else {
+		absProviderDir, err := filepath.Abs(providerDir)
+		if err != nil {
+			return fmt.Errorf("error getting absolute path with provider directory %q: %w", providerDir, err)
+		}
+
+		providerDir = absProviderDir
+	}
+
+	cliOpts := terraformCLIOptions{
+		binary:      opts.TFBinary,
+		useOpenTofu: opts.UseOpenTofu,
+		version:     opts.TFVersion,
+		installDir:  opts.TFInstallDir,
+		offline:     opts.Offline,
+	}
+
+	err := validateTerraformCLIOptions(cliOpts)
+	if err != nil {
+		return err
+	}
+
+	err = validateOfflineProvidersSchema(opts.Offline, opts.ProvidersSchemaPath)
+	if err != nil {
+		return err
+	}
+
+	ignoreFilter, err := loadIgnoreFilter(providerDir, opts.Ignore)
+	if err != nil {
+		return err
+	}
+
+	// Progress messages would otherwise be interleaved with the JSON report
+	// on standard output.
+	logger := NewLogger(ui)
+	if format == CoverageFormatJSON {
+		logger = NewLogger(&cli.BasicUi{Writer: io.Discard, ErrorWriter: io.Discard})
+	}
+
+	var schema *tfjson.ProviderSchema
+
+	if opts.ProvidersSchemaPath != "" {
+		schema, err = providerSchemaFromFile(opts.ProvidersSchemaPath, opts.ProviderName)
+		if err != nil {
+			return err
+		}
+	} else {
+		providerName := opts.ProviderName
+		if providerName == "" {
+			providerName = filepath.Base(providerDir)
+		}
+
+		logger.infof("exporting schema from Terraform")
+		schema, err = TerraformProviderSchemaFromTerraform(context.Background(), providerName, providerDir, cliOpts, logger)
+		if err != nil {
+			return fmt.Errorf("error exporting provider schema from Terraform: %w", err)
+		}
+	}
+
+	schema = ignoreFilter.RemoveMatching(schema)
+
+	report := coverage.Compute(schema)
+
+	b := &strings.Builder{}
+
+	switch format {
+	case CoverageFormatJSON:
+		err = coverage.RenderJSON(b, report)
+	default:
+		err = coverage.RenderMarkdown(b, report)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to render coverage report: %w", err)
+	}
+
+	ui.Output(strings.TrimSuffix(b.String(), "\n"))
+
+	if total := report.Total().Percentage; total < opts.MinCoverage {
+		return fmt.Errorf("description coverage of %.1f%% is below the minimum of %.1f%%", total, opts.MinCoverage)
+	}
+
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// attributeDefaults are the rendered default values of attributes.
+type attributeDefaults = attributeMetadata[string]
+
+// extractAttributeDefaultsByAddress returns the default values of the
+// attributes of the first of the given provider addresses in the providers
+// schema JSON. No error is returned if the schema has no default values.
+func extractAttributeDefaultsByAddress(schemajson []byte, addresses ...string) (attributeDefaults, error) {
+	defaults := make(attributeDefaults)
+
+	err := walkSchemaExtensions(schemajson, func(dir, name string, path []string, att *schemaExtensionsAttribute) error {
+		value, ok, err := renderJSONValue(att.Default)
+		if err != nil {
+			return fmt.Errorf("invalid default value of %q: %w", strings.Join(path, "."), err)
+		}
+		if ok {
+			defaults.set(dir, name, strings.Join(path, "."), value)
+		}
+
+		return nil
+	}, addresses...)
+	if err != nil {
+		return nil, err
+	}
+
+	return defaults, nil
+}
+
+// loadAttributeDefaults sets the default values of the attribute defaults file
+// at path into defaults, over the default values of the providers schema
+// JSON. The file is an attribute metadata file of JSON values, for example:
+//
+//	{
+//	  "provider": {"region": "us-east-1"},
+//	  "resources": {
+//	    "scaffolding_example": {"configurable_attribute": "some-value", "settings.enabled": true}
+//	  }
+//	}
+func loadAttributeDefaults(path string, defaults attributeDefaults) error {
+	values, err := readAttributeMetadataFile[json.RawMessage](path, "attribute defaults")
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range sortedKeys(values) {
+		for _, name := range sortedKeys(values[dir]) {
+			for _, attPath := range sortedKeys(values[dir][name]) {
+				value, ok, err := renderJSONValue(values[dir][name][attPath])
+				if err != nil {
+					return fmt.Errorf("invalid default value of %q in attribute defaults file %q: %w", attPath, path, err)
+				}
+				if ok {
+					defaults.set(dir, name, attPath, value)
+				}
+			}
+		}
+	}
+
+	return nil
+}
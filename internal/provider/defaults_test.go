@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExtractAttributeDefaultsByAddress(t *testing.T) {
+	t.Parallel()
+
+	schemajson := []byte(`{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/scaffolding": {
+      "provider": {
+        "version": 0,
+        "block": {"attributes": {"endpoint": {"type": "string", "optional": true, "default": "https://example.com"}}}
+      },
+      "resource_schemas": {
+        "scaffolding_example": {
+          "version": 0,
+          "block": {
+            "attributes": {
+              "id": {"type": "string", "computed": true},
+              "count": {"type": "number", "optional": true, "default": 3},
+              "settings": {
+                "nested_type": {
+                  "nesting_mode": "single",
+                  "attributes": {"enabled": {"type": "bool", "optional": true, "default": true}}
+                },
+                "optional": true
+              }
+            },
+            "block_types": {
+              "timeouts": {
+                "nesting_mode": "single",
+                "block": {"attributes": {"create": {"type": "string", "optional": true, "default": "20m"}}}
+              }
+            }
+          }
+        }
+      },
+      "data_source_schemas": {
+        "scaffolding_example": {
+          "version": 0,
+          "block": {"attributes": {"tags": {"type": ["map", "string"], "optional": true, "default": {"a": "b"}}}}
+        }
+      }
+    }
+  }
+}`)
+
+	actual, err := extractAttributeDefaultsByAddress(schemajson, "scaffolding", "registry.terraform.io/hashicorp/scaffolding")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := attributeDefaults{
+		"": {
+			"": {"endpoint": "https://example.com"},
+		},
+		"resources": {
+			"scaffolding_example": {
+				"count":            "3",
+				"settings.enabled": "true",
+				"timeouts.create":  "20m",
+			},
+		},
+		"data-sources": {
+			"scaffolding_example": {"tags": `{"a":"b"}`},
+		},
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestLoadAttributeDefaults(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		file          string
+		expected      attributeDefaults
+		expectedError string
+	}{
+		"overrides": {
+			file: `{
+  "provider": {"endpoint": "https://example.org"},
+  "resources": {"scaffolding_example": {"count": 5, "name": null, "settings.mode": "fast"}},
+  "actions": {"scaffolding_example": {"wait": false}}
+}`,
+			expected: attributeDefaults{
+				"": {
+					"": {"endpoint": "https://example.org"},
+				},
+				"resources": {
+					"scaffolding_example": {
+						"count":         "5",
+						"settings.mode": "fast",
+						"timeouts.read": "5m",
+					},
+				},
+				"actions": {
+					"scaffolding_example": {"wait": "false"},
+				},
+			},
+		},
+		"unknown section": {
+			file:          `{"functions": {}}`,
+			expectedError: `unknown field "functions"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "defaults.json")
+			err := os.WriteFile(path, []byte(testCase.file), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			defaults := attributeDefaults{
+				"resources": {
+					"scaffolding_example": {
+						"count":         "3",
+						"timeouts.read": "5m",
+					},
+				},
+			}
+
+			err = loadAttributeDefaults(path, defaults)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.expectedError)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, defaults); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
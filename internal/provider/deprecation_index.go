@@ -0,0 +1,209 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"golang.org/x/exp/slices"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+// deprecationItem is a resource, data source, ephemeral resource, list
+// resource, action, or function listed in the deprecations guide, which is
+// deprecated itself or has deprecated attributes.
+type deprecationItem struct {
+	name string
+
+	// path is the slash-separated path of the rendered page of the item,
+	// relative to the rendered website directory.
+	path string
+
+	// deprecated is true if the item itself is deprecated, with the
+	// replacement of the deprecations file, or the deprecation message of a
+	// function, as note.
+	deprecated bool
+	note       string
+
+	attributes []deprecatedAttribute
+}
+
+// deprecatedAttribute is a deprecated attribute or block of an item, by dot
+// separated path, with the replacement of the deprecations file, if any.
+type deprecatedAttribute struct {
+	path        string
+	replacement string
+}
+
+// deprecationSection contains the deprecation items of an item type, sorted
+// by name.
+type deprecationSection struct {
+	title string
+	items []deprecationItem
+}
+
+// deprecationsMarkdown returns a Markdown list of the deprecated resources,
+// data sources, ephemeral resources, list resources, actions, and functions,
+// and of the items with deprecated attributes, with a section for each item
+// type, or an empty string if nothing is deprecated. Each item is listed with
+// a link to its page, relative to the guides subdirectory.
+func (g *generator) deprecationsMarkdown(providerSchema *tfjson.ProviderSchema) string {
+	b := &strings.Builder{}
+
+	for _, section := range g.deprecationSections(providerSchema) {
+		writeDeprecationSection(b, section)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// deprecationSections returns the sections of the item types with
+// deprecations.
+func (g *generator) deprecationSections(providerSchema *tfjson.ProviderSchema) []deprecationSection {
+	schemaSections := []struct {
+		title   string
+		dir     string
+		schemas map[string]*tfjson.Schema
+	}{
+		{"Resources", "resources", providerSchema.ResourceSchemas},
+		{"Data Sources", "data-sources", providerSchema.DataSourceSchemas},
+		{"Ephemeral Resources", "ephemeral-resources", providerSchema.EphemeralResourceSchemas},
+		{"List Resources", "list-resources", providerSchema.ListResourceSchemas},
+		{"Actions", "actions", g.actionSchemas},
+	}
+
+	var sections []deprecationSection
+
+	for _, schemaSection := range schemaSections {
+		section := deprecationSection{title: schemaSection.title}
+
+		for name, schema := range schemaSection.schemas {
+			if g.ignoreDeprecated && schema.Block.Deprecated {
+				continue
+			}
+
+			if g.skipItem(schemaSection.dir, name) {
+				continue
+			}
+
+			item := deprecationItem{
+				name:       name,
+				path:       g.renderedItemPath(schemaSection.dir, name),
+				deprecated: schema.Block.Deprecated,
+				attributes: g.deprecatedAttributes(schemaSection.dir, name, schema),
+			}
+
+			if replacement := g.deprecations[schemaSection.dir][name][""]; replacement != "" {
+				item.deprecated = true
+				item.note = "Use `" + replacement + "` instead."
+			}
+
+			if !item.deprecated && len(item.attributes) == 0 {
+				continue
+			}
+
+			section.items = append(section.items, item)
+		}
+
+		sections = appendDeprecationSection(sections, section)
+	}
+
+	functions := deprecationSection{title: "Functions"}
+
+	for name, signature := range providerSchema.Functions {
+		if signature.DeprecationMessage == "" || g.ignoreDeprecated {
+			continue
+		}
+
+		if g.skipItem("functions", name) {
+			continue
+		}
+
+		functions.items = append(functions.items, deprecationItem{
+			name:       name,
+			path:       g.renderedItemPath("functions", name),
+			deprecated: true,
+			note:       firstLine(signature.DeprecationMessage),
+		})
+	}
+
+	return appendDeprecationSection(sections, functions)
+}
+
+// deprecatedAttributes returns the sorted deprecated attributes and blocks of
+// the schema of the named item, including the attributes with a replacement
+// in the deprecations file.
+func (g *generator) deprecatedAttributes(dir, name string, schema *tfjson.Schema) []deprecatedAttribute {
+	replacements := g.deprecations[dir][name]
+
+	paths := schemamd.DeprecatedAttributes(schema)
+	for attPath, replacement := range replacements {
+		if attPath != "" && replacement != "" && !slices.Contains(paths, attPath) {
+			paths = append(paths, attPath)
+		}
+	}
+	sort.Strings(paths)
+
+	attributes := make([]deprecatedAttribute, 0, len(paths))
+	for _, attPath := range paths {
+		attributes = append(attributes, deprecatedAttribute{
+			path:        attPath,
+			replacement: replacements[attPath],
+		})
+	}
+
+	return attributes
+}
+
+// appendDeprecationSection sorts the items of the section and appends it to
+// sections, unless there are no items.
+func appendDeprecationSection(sections []deprecationSection, section deprecationSection) []deprecationSection {
+	if len(section.items) == 0 {
+		return sections
+	}
+
+	sort.Slice(section.items, func(i, j int) bool {
+		return section.items[i].name < section.items[j].name
+	})
+
+	return append(sections, section)
+}
+
+// writeDeprecationSection writes the section of an item type.
+func writeDeprecationSection(b *strings.Builder, section deprecationSection) {
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(b, "## %s\n\n", section.title)
+
+	for _, item := range section.items {
+		fmt.Fprintf(b, "- [%s](%s)", item.name, path.Join("..", item.path))
+
+		if item.deprecated {
+			b.WriteString(" - Deprecated.")
+
+			if item.note != "" {
+				fmt.Fprintf(b, " %s", item.note)
+			}
+		}
+
+		b.WriteString("\n")
+
+		for _, att := range item.attributes {
+			fmt.Fprintf(b, "  - `%s`", att.path)
+
+			if att.replacement != "" {
+				fmt.Fprintf(b, " - Use `%s` instead.", att.replacement)
+			}
+
+			b.WriteString("\n")
+		}
+	}
+}
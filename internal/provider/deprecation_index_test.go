@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestGenerator_deprecationsMarkdown(t *testing.T) {
+	t.Parallel()
+
+	g := &generator{
+		providerName:    "terraform-provider-scaffolding",
+		outputExtension: ".md",
+		deprecations: deprecations{
+			"resources": {
+				"scaffolding_legacy":  {"": "scaffolding_example"},
+				"scaffolding_example": {"name": "display_name"},
+			},
+		},
+	}
+
+	providerSchema := &tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"id":     {Computed: true},
+						"name":   {Optional: true},
+						"region": {Optional: true, Deprecated: true},
+					},
+				},
+			},
+			"scaffolding_legacy": {
+				Block: &tfjson.SchemaBlock{Deprecated: true},
+			},
+			"scaffolding_current": {
+				Block: &tfjson.SchemaBlock{},
+			},
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {
+				Block: &tfjson.SchemaBlock{Deprecated: true},
+			},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"echo": {
+				DeprecationMessage: "Use the built-in function instead.",
+			},
+			"parse": {},
+		},
+	}
+
+	expected := "## Resources\n" +
+		"\n" +
+		"- [scaffolding_example](../resources/example.md)\n" +
+		"  - `name` - Use `display_name` instead.\n" +
+		"  - `region`\n" +
+		"- [scaffolding_legacy](../resources/legacy.md) - Deprecated. Use `scaffolding_example` instead.\n" +
+		"\n" +
+		"## Data Sources\n" +
+		"\n" +
+		"- [scaffolding_example](../data-sources/example.md) - Deprecated.\n" +
+		"\n" +
+		"## Functions\n" +
+		"\n" +
+		"- [echo](../functions/echo.md) - Deprecated. Use the built-in function instead."
+
+	actual := g.deprecationsMarkdown(providerSchema)
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// deprecations are the replacements of deprecated items and attributes. The
+// replacement of an item itself has an empty attribute path.
+type deprecations = attributeMetadata[string]
+
+// loadDeprecations sets the replacements of the deprecations file at path
+// into deprecations. The file is an attribute metadata file of strings, where
+// the empty attribute path is the replacement of the item itself, for
+// example:
+//
+//	{
+//	  "resources": {
+//	    "scaffolding_example": {"": "scaffolding_widget", "name": "display_name"}
+//	  }
+//	}
+func loadDeprecations(path string, deprecations deprecations) error {
+	values, err := readAttributeMetadataFile[string](path, "deprecations")
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range sortedKeys(values) {
+		for _, name := range sortedKeys(values[dir]) {
+			for _, attPath := range sortedKeys(values[dir][name]) {
+				deprecations.set(dir, name, attPath, values[dir][name][attPath])
+			}
+		}
+	}
+
+	return nil
+}
+
+// findDeprecatedItems returns the deprecated resources, data sources,
+// ephemeral resources, list resources, actions, and functions of the
+// provider, by rendered website subdirectory and name, which are deprecated in
+// the schema or have a replacement in the deprecations file.
+func (g *generator) findDeprecatedItems(providerSchema *tfjson.ProviderSchema) map[string]map[string]bool {
+	items := make(map[string]map[string]bool)
+
+	add := func(dir, name string) {
+		if items[dir] == nil {
+			items[dir] = make(map[string]bool)
+		}
+
+		items[dir][name] = true
+	}
+
+	for dir, schemas := range map[string]map[string]*tfjson.Schema{
+		"resources":           providerSchema.ResourceSchemas,
+		"data-sources":        providerSchema.DataSourceSchemas,
+		"ephemeral-resources": providerSchema.EphemeralResourceSchemas,
+		"list-resources":      providerSchema.ListResourceSchemas,
+		"actions":             g.actionSchemas,
+	} {
+		for name, schema := range schemas {
+			if schema.Block.Deprecated || g.deprecations[dir][name][""] != "" {
+				add(dir, name)
+			}
+		}
+	}
+
+	for name, signature := range providerSchema.Functions {
+		if signature.DeprecationMessage != "" || g.deprecations["functions"][name][""] != "" {
+			add("functions", name)
+		}
+	}
+
+	return items
+}
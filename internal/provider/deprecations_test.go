@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadDeprecations(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		file          string
+		expected      deprecations
+		expectedError string
+	}{
+		"replacements": {
+			file: `{
+  "resources": {"scaffolding_example": {"": "scaffolding_widget", "name": "display_name"}},
+  "data-sources": {"scaffolding_example": {"settings.mode": "settings.speed"}}
+}`,
+			expected: deprecations{
+				"resources": {
+					"scaffolding_example": {
+						"":     "scaffolding_widget",
+						"name": "display_name",
+					},
+				},
+				"data-sources": {
+					"scaffolding_example": {"settings.mode": "settings.speed"},
+				},
+			},
+		},
+		"invalid replacement": {
+			file:          `{"resources": {"scaffolding_example": {"name": true}}}`,
+			expectedError: `unable to parse deprecations file`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "deprecations.json")
+			err := os.WriteFile(path, []byte(testCase.file), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual := make(deprecations)
+
+			err = loadDeprecations(path, actual)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.expectedError)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
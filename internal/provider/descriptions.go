@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/coverage"
+)
+
+// checkDescriptions returns an error listing the resources, data sources,
+// ephemeral resources, list resources, actions, and functions, and their
+// attributes, blocks, and function parameters, which lack a description.
+// Items which are not generated are skipped. The provider configuration is
+// only checked for attribute descriptions, as the provider description is
+// commonly written in the provider template instead.
+func (g *generator) checkDescriptions(providerSchema *tfjson.ProviderSchema) error {
+	filterSchemas := func(dir string, schemas map[string]*tfjson.Schema) map[string]*tfjson.Schema {
+		filtered := make(map[string]*tfjson.Schema, len(schemas))
+		for name, schema := range schemas {
+			if g.ignoreDeprecated && schema.Block.Deprecated {
+				continue
+			}
+
+			if g.skipItem(dir, name) {
+				continue
+			}
+
+			filtered[name] = schema
+		}
+		return filtered
+	}
+
+	functions := make(map[string]*tfjson.FunctionSignature, len(providerSchema.Functions))
+	for name, signature := range providerSchema.Functions {
+		if g.ignoreDeprecated && signature.DeprecationMessage != "" {
+			continue
+		}
+
+		if g.skipItem("functions", name) {
+			continue
+		}
+
+		functions[name] = signature
+	}
+
+	report := coverage.Compute(&tfjson.ProviderSchema{
+		ConfigSchema:             providerSchema.ConfigSchema,
+		ResourceSchemas:          filterSchemas("resources", providerSchema.ResourceSchemas),
+		DataSourceSchemas:        filterSchemas("data-sources", providerSchema.DataSourceSchemas),
+		EphemeralResourceSchemas: filterSchemas("ephemeral-resources", providerSchema.EphemeralResourceSchemas),
+		ListResourceSchemas:      filterSchemas("list-resources", providerSchema.ListResourceSchemas),
+		Functions:                functions,
+	})
+	report.Actions = coverage.SchemaItems(filterSchemas("actions", g.actionSchemas))
+
+	var errs []error
+
+	if report.Provider != nil && len(report.Provider.Undescribed) > 0 {
+		errs = append(errs, fmt.Errorf("provider attributes without description: %s", strings.Join(report.Provider.Undescribed, ", ")))
+	}
+
+	sections := []struct {
+		kind       string
+		attributes string
+		items      []coverage.Item
+	}{
+		{"resource", "attributes", report.Resources},
+		{"data source", "attributes", report.DataSources},
+		{"ephemeral resource", "attributes", report.EphemeralResources},
+		{"list resource", "attributes", report.ListResources},
+		{"action", "attributes", report.Actions},
+		{"function", "parameters", report.Functions},
+	}
+
+	for _, section := range sections {
+		for _, item := range section.items {
+			if !item.Described {
+				errs = append(errs, fmt.Errorf("%s %q has no description", section.kind, item.Name))
+			}
+
+			if len(item.Undescribed) > 0 {
+				errs = append(errs, fmt.Errorf("%s %q %s without description: %s", section.kind, item.Name, section.attributes, strings.Join(item.Undescribed, ", ")))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
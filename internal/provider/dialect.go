@@ -0,0 +1,303 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	FileExtensionMdx = `.mdx`
+)
+
+// OutputExtensions are the supported file extensions of rendered website
+// files. The extension also determines the dialect of the rendered content:
+//
+//   - .md: Terraform Registry Markdown, rendered content is unmodified.
+//   - .markdown and .html.markdown: legacy website Markdown, a layout is added
+//     to the frontmatter if not already present.
+//   - .mdx: MDX, such as Docusaurus, where HTML comments are converted to MDX
+//     comments and JSX special characters are escaped outside of code.
+var OutputExtensions = []string{
+	FileExtensionMd,
+	FileExtensionMarkdown,
+	FileExtensionHtmlMarkdown,
+	FileExtensionMdx,
+}
+
+// legacyWebsiteSubDirectories are the legacy website subdirectories of the
+// rendered website subdirectories of item types, which are used with the
+// legacy website output extensions.
+var legacyWebsiteSubDirectories = map[string]string{
+	"data-sources": "d",
+	"resources":    "r",
+}
+
+// mdxHTMLElements are the HTML elements which are passed through unescaped
+// when rendering MDX, all other angle brackets are escaped.
+var mdxHTMLElements = []string{
+	"a", "b", "blockquote", "br", "code", "details", "div", "em", "h1", "h2",
+	"h3", "h4", "h5", "h6", "hr", "i", "img", "kbd", "li", "ol", "p", "pre",
+	"span", "strong", "sub", "summary", "sup", "table", "tbody", "td", "th",
+	"thead", "tr", "ul",
+}
+
+// mdxVoidHTMLElements are the HTML elements which must be self-closing in MDX.
+var mdxVoidHTMLElements = []string{
+	"br", "hr", "img",
+}
+
+var mdxHTMLTagRegexp = regexp.MustCompile(`^<(/?)([a-zA-Z][a-zA-Z0-9]*)((?:\s+[a-zA-Z_:][a-zA-Z0-9_:.-]*(?:\s*=\s*(?:"[^"]*"|'[^']*'))?)*)\s*(/?)>`)
+
+// validateOutputExtension returns an error if the output extension is not
+// supported.
+func validateOutputExtension(outputExtension string) error {
+	if outputExtension != "" && !slices.Contains(OutputExtensions, outputExtension) {
+		return fmt.Errorf("unsupported output extension %q, expected one of: %s", outputExtension, strings.Join(OutputExtensions, ", "))
+	}
+
+	return nil
+}
+
+// isLegacyOutputExtension returns true if the output extension is one of the
+// legacy website extensions.
+func isLegacyOutputExtension(outputExtension string) bool {
+	return outputExtension == FileExtensionMarkdown || outputExtension == FileExtensionHtmlMarkdown
+}
+
+// renderedSubDirectory returns the rendered website subdirectory of a
+// template subdirectory, such as "r" for "resources" with a legacy website
+// output extension. Nested and other subdirectories are unchanged.
+func renderedSubDirectory(rel, outputExtension string) string {
+	if !isLegacyOutputExtension(outputExtension) {
+		return rel
+	}
+
+	dir, rest, found := strings.Cut(filepath.ToSlash(rel), "/")
+	legacyDir, ok := legacyWebsiteSubDirectories[dir]
+	if !found || !ok {
+		return rel
+	}
+
+	return filepath.FromSlash(legacyDir + "/" + rest)
+}
+
+// managedSubDirectories returns the rendered website subdirectories which are
+// removed and regenerated by generate with the output extension.
+func managedSubDirectories(outputExtension string) []string {
+	if !isLegacyOutputExtension(outputExtension) {
+		return managedWebsiteSubDirectories
+	}
+
+	dirs := slices.Clone(managedWebsiteSubDirectories)
+	for _, legacyDir := range legacyWebsiteSubDirectories {
+		dirs = append(dirs, legacyDir)
+	}
+
+	return dirs
+}
+
+// renderedFilePath returns the path of a rendered template file, replacing
+// the Markdown extension of the template name, including compound extensions
+// such as .html.md, with the output extension.
+func renderedFilePath(path, outputExtension string) string {
+	if outputExtension == "" {
+		return path
+	}
+
+	for _, ext := range []string{FileExtensionHtmlMarkdown, FileExtensionHtmlMd, FileExtensionMarkdown, FileExtensionMd} {
+		if strings.HasSuffix(path, ext) {
+			return strings.TrimSuffix(path, ext) + outputExtension
+		}
+	}
+
+	return path
+}
+
+// convertOutputDialect converts rendered Markdown content into the dialect of
+// the output extension.
+func convertOutputDialect(content, outputExtension, providerShortName string) string {
+	switch outputExtension {
+	case FileExtensionMarkdown, FileExtensionHtmlMarkdown:
+		return addFrontmatterLayout(content, providerShortName)
+	case FileExtensionMdx:
+		return escapeMDX(content)
+	}
+
+	return content
+}
+
+// addFrontmatterLayout adds the layout key, expected by legacy provider
+// websites, to the YAML frontmatter of content if it is not already present.
+// Content without frontmatter is returned unmodified.
+func addFrontmatterLayout(content, providerShortName string) string {
+	lines := strings.SplitAfter(content, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != "---" {
+		return content
+	}
+
+	for i := 1; i < len(lines); i++ {
+		line := strings.TrimSpace(lines[i])
+
+		if strings.HasPrefix(line, "layout:") {
+			return content
+		}
+
+		if line == "---" {
+			layout := fmt.Sprintf("layout: %q\n", providerShortName)
+			return lines[0] + layout + strings.Join(lines[1:], "")
+		}
+	}
+
+	return content
+}
+
+// escapeMDX escapes content which is valid Markdown, but is not valid MDX.
+// HTML comments are converted to MDX comments, curly braces are escaped, and
+// angle brackets are escaped unless they are part of a known HTML element.
+// Frontmatter, fenced code blocks, and code spans are not modified.
+func escapeMDX(content string) string {
+	var b strings.Builder
+
+	lines := strings.SplitAfter(content, "\n")
+	inFrontmatter := len(lines) > 0 && strings.TrimSpace(lines[0]) == "---"
+	inComment := false
+	fence := ""
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		if inFrontmatter {
+			b.WriteString(line)
+			if i > 0 && trimmed == "---" {
+				inFrontmatter = false
+			}
+			continue
+		}
+
+		if fence != "" {
+			b.WriteString(line)
+			if strings.HasPrefix(trimmed, fence) && strings.Trim(trimmed, fence[:1]) == "" {
+				fence = ""
+			}
+			continue
+		}
+
+		if !inComment && (strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")) {
+			fence = trimmed[:3]
+			for len(fence) < len(trimmed) && trimmed[len(fence)] == fence[0] {
+				fence += fence[:1]
+			}
+			b.WriteString(line)
+			continue
+		}
+
+		inComment = escapeMDXLine(&b, line, inComment)
+	}
+
+	return b.String()
+}
+
+// escapeMDXLine writes the MDX escaped line to b, returning whether the line
+// ends within an HTML comment.
+func escapeMDXLine(b *strings.Builder, line string, inComment bool) bool {
+	for i := 0; i < len(line); {
+		if inComment {
+			end := strings.Index(line[i:], "-->")
+			if end == -1 {
+				b.WriteString(line[i:])
+				return true
+			}
+			b.WriteString(line[i : i+end])
+			b.WriteString("*/}")
+			i += end + len("-->")
+			inComment = false
+			continue
+		}
+
+		switch c := line[i]; c {
+		case '`':
+			n := 1
+			for i+n < len(line) && line[i+n] == '`' {
+				n++
+			}
+			ticks := line[i : i+n]
+			end := strings.Index(line[i+n:], ticks)
+			if end == -1 {
+				b.WriteString(ticks)
+				i += n
+				continue
+			}
+			b.WriteString(line[i : i+n+end+n])
+			i += n + end + n
+		case '\\':
+			if i+1 < len(line) {
+				b.WriteString(line[i : i+2])
+				i += 2
+				continue
+			}
+			b.WriteByte(c)
+			i++
+		case '{', '}':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+			i++
+		case '<':
+			if strings.HasPrefix(line[i:], "<!--") {
+				b.WriteString("{/*")
+				i += len("<!--")
+				inComment = true
+				continue
+			}
+
+			if tag, ok := mdxHTMLTag(line[i:]); ok {
+				b.WriteString(tag.output)
+				i += tag.length
+				continue
+			}
+
+			b.WriteString(`\<`)
+			i++
+		default:
+			b.WriteByte(c)
+			i++
+		}
+	}
+
+	return inComment
+}
+
+type mdxTag struct {
+	// output is the MDX compatible tag.
+	output string
+
+	// length is the length of the original tag.
+	length int
+}
+
+// mdxHTMLTag returns the MDX compatible version of a known HTML element tag
+// at the start of s. Void elements, such as <br>, are made self-closing.
+func mdxHTMLTag(s string) (mdxTag, bool) {
+	match := mdxHTMLTagRegexp.FindStringSubmatch(s)
+	if match == nil {
+		return mdxTag{}, false
+	}
+
+	closing, name, attrs, selfClosing := match[1], strings.ToLower(match[2]), match[3], match[4]
+	if !slices.Contains(mdxHTMLElements, name) {
+		return mdxTag{}, false
+	}
+
+	output := match[0]
+	if closing == "" && selfClosing == "" && slices.Contains(mdxVoidHTMLElements, name) {
+		output = "<" + match[2] + attrs + " />"
+	}
+
+	return mdxTag{output: output, length: len(match[0])}, true
+}
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_renderedFilePath(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		path            string
+		outputExtension string
+		expected        string
+	}{
+		"default": {
+			path:     "docs/index.md",
+			expected: "docs/index.md",
+		},
+		"md": {
+			path:            "docs/resources/example.md",
+			outputExtension: ".md",
+			expected:        "docs/resources/example.md",
+		},
+		"html.markdown": {
+			path:            "website/docs/r/example.md",
+			outputExtension: ".html.markdown",
+			expected:        "website/docs/r/example.html.markdown",
+		},
+		"mdx": {
+			path:            "docs/guides/example.md",
+			outputExtension: ".mdx",
+			expected:        "docs/guides/example.mdx",
+		},
+		"html.md to mdx": {
+			path:            "docs/guides/example.html.md",
+			outputExtension: ".mdx",
+			expected:        "docs/guides/example.mdx",
+		},
+		"html.markdown to html.markdown": {
+			path:            "website/docs/r/example.html.markdown",
+			outputExtension: ".html.markdown",
+			expected:        "website/docs/r/example.html.markdown",
+		},
+		"non-md template": {
+			path:            "docs/guides/example.txt",
+			outputExtension: ".mdx",
+			expected:        "docs/guides/example.txt",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := renderedFilePath(c.path, c.outputExtension)
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_renderedSubDirectory(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		rel             string
+		outputExtension string
+		expected        string
+	}{
+		"md resource": {
+			rel:             "resources/example.md.tmpl",
+			outputExtension: ".md",
+			expected:        "resources/example.md.tmpl",
+		},
+		"html.markdown resource": {
+			rel:             "resources/example.md.tmpl",
+			outputExtension: ".html.markdown",
+			expected:        "r/example.md.tmpl",
+		},
+		"markdown data source": {
+			rel:             "data-sources/example.md.tmpl",
+			outputExtension: ".markdown",
+			expected:        "d/example.md.tmpl",
+		},
+		"html.markdown guide": {
+			rel:             "guides/example.md.tmpl",
+			outputExtension: ".html.markdown",
+			expected:        "guides/example.md.tmpl",
+		},
+		"html.markdown index": {
+			rel:             "index.md.tmpl",
+			outputExtension: ".html.markdown",
+			expected:        "index.md.tmpl",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := filepath.ToSlash(renderedSubDirectory(filepath.FromSlash(c.rel), c.outputExtension))
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_validateOutputExtension(t *testing.T) {
+	t.Parallel()
+
+	for _, ext := range append([]string{""}, OutputExtensions...) {
+		if err := validateOutputExtension(ext); err != nil {
+			t.Errorf("unexpected error for %q: %s", ext, err)
+		}
+	}
+
+	err := validateOutputExtension(".txt")
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expectedErr := `unsupported output extension ".txt", expected one of: .md, .markdown, .html.markdown, .mdx`
+	if diff := cmp.Diff(expectedErr, err.Error()); diff != "" {
+		t.Errorf("unexpected error difference: %s", diff)
+	}
+}
+
+func Test_convertOutputDialect(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		content         string
+		outputExtension string
+		expected        string
+	}{
+		"md": {
+			content:         "---\npage_title: \"Example\"\n---\n\n<!-- comment -->\nExample {value} <name>\n",
+			outputExtension: ".md",
+			expected:        "---\npage_title: \"Example\"\n---\n\n<!-- comment -->\nExample {value} <name>\n",
+		},
+		"markdown layout": {
+			content:         "---\npage_title: \"Example\"\n---\n\n# Example\n",
+			outputExtension: ".markdown",
+			expected:        "---\nlayout: \"scaffolding\"\npage_title: \"Example\"\n---\n\n# Example\n",
+		},
+		"html.markdown layout": {
+			content:         "---\npage_title: \"Example\"\n---\n\n# Example\n",
+			outputExtension: ".html.markdown",
+			expected:        "---\nlayout: \"scaffolding\"\npage_title: \"Example\"\n---\n\n# Example\n",
+		},
+		"html.markdown existing layout": {
+			content:         "---\nlayout: \"custom\"\npage_title: \"Example\"\n---\n\n# Example\n",
+			outputExtension: ".html.markdown",
+			expected:        "---\nlayout: \"custom\"\npage_title: \"Example\"\n---\n\n# Example\n",
+		},
+		"html.markdown no frontmatter": {
+			content:         "# Example\n\n---\n",
+			outputExtension: ".html.markdown",
+			expected:        "# Example\n\n---\n",
+		},
+		"mdx frontmatter": {
+			content:         "---\ndescription: |-\n  Example {value} <name>\n---\n\nExample {value}\n",
+			outputExtension: ".mdx",
+			expected:        "---\ndescription: |-\n  Example {value} <name>\n---\n\nExample \\{value\\}\n",
+		},
+		"mdx comment": {
+			content:         "<!-- schema generated by tfplugindocs -->\n## Schema\n",
+			outputExtension: ".mdx",
+			expected:        "{/* schema generated by tfplugindocs */}\n## Schema\n",
+		},
+		"mdx multiline comment": {
+			content:         "<!--\n{value} <name>\n-->\nExample\n",
+			outputExtension: ".mdx",
+			expected:        "{/*\n{value} <name>\n*/}\nExample\n",
+		},
+		"mdx fenced code": {
+			content:         "```terraform\nvalue = \"${var.name}/{id}\"\n```\n\n~~~~\n<name>\n~~~~\n{value}\n",
+			outputExtension: ".mdx",
+			expected:        "```terraform\nvalue = \"${var.name}/{id}\"\n```\n\n~~~~\n<name>\n~~~~\n\\{value\\}\n",
+		},
+		"mdx code spans": {
+			content:         "- `value` (String) Defaults to `{}` or ``{`name`}``, {value}\n",
+			outputExtension: ".mdx",
+			expected:        "- `value` (String) Defaults to `{}` or ``{`name`}``, \\{value\\}\n",
+		},
+		"mdx unclosed code span": {
+			content:         "Example ` {value}\n",
+			outputExtension: ".mdx",
+			expected:        "Example ` \\{value\\}\n",
+		},
+		"mdx escaped characters": {
+			content:         "Example \\<name> \\{value}\n",
+			outputExtension: ".mdx",
+			expected:        "Example \\<name> \\{value\\}\n",
+		},
+		"mdx html elements": {
+			content:         "<a id=\"nestedblock--setting\"></a>\nLine one<br>Line two<br/><img src=\"example.png\" alt='example'>\n",
+			outputExtension: ".mdx",
+			expected:        "<a id=\"nestedblock--setting\"></a>\nLine one<br />Line two<br/><img src=\"example.png\" alt='example' />\n",
+		},
+		"mdx angle brackets": {
+			content:         "Format <name>/<resource-id>, where count < 5 and <https://example.com>\n",
+			outputExtension: ".mdx",
+			expected:        "Format \\<name>/\\<resource-id>, where count \\< 5 and \\<https://example.com>\n",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := convertOutputDialect(c.content, c.outputExtension, "scaffolding")
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
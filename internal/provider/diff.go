@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"golang.org/x/exp/slices"
+)
+
+// fileDiff describes a difference between a file in the existing rendered
+// website directory and the newly rendered website.
+type fileDiff struct {
+	// Path is the slash separated path of the file, relative to the rendered
+	// website directory.
+	Path string
+
+	// Unified is the unified diff of the existing and rendered file content.
+	Unified string
+}
+
+const (
+	fileChangeCreated = "created"
+	fileChangeUpdated = "updated"
+	fileChangeDeleted = "deleted"
+)
+
+// Change returns whether the file is created, updated, or deleted.
+func (d fileDiff) Change() string {
+	switch {
+	case strings.HasPrefix(d.Unified, "--- /dev/null\n"):
+		return fileChangeCreated
+	case strings.Contains(d.Unified, "\n+++ /dev/null\n"):
+		return fileChangeDeleted
+	default:
+		return fileChangeUpdated
+	}
+}
+
+// Stats returns the number of added and removed lines of the unified diff.
+func (d fileDiff) Stats() (int, int) {
+	var added, removed int
+
+	for i, line := range splitLines(d.Unified) {
+		// skip file headers
+		if i < 2 {
+			continue
+		}
+
+		switch {
+		case strings.HasPrefix(line, "+"):
+			added++
+		case strings.HasPrefix(line, "-"):
+			removed++
+		}
+	}
+
+	return added, removed
+}
+
+// diffRenderedWebsite compares the existing rendered website directory,
+// currentDir, with a freshly rendered website directory, renderedDir, and
+// returns the differences sorted by path. Files which only exist in
+// currentDir are only reported if they are managed by tfplugindocs, as they
+// would be removed by generate. The displayDir is prepended to paths in the
+// unified diff headers. The output extension determines the managed
+// subdirectories.
+func diffRenderedWebsite(currentDir, renderedDir, displayDir, outputExtension string) ([]fileDiff, error) {
+	renderedFiles, err := walkFiles(renderedDir)
+	if err != nil {
+		return nil, err
+	}
+
+	currentFiles, err := walkFiles(currentDir)
+	if err != nil {
+		return nil, err
+	}
+
+	renderedSet := make(map[string]bool, len(renderedFiles))
+	for _, rel := range renderedFiles {
+		renderedSet[rel] = true
+	}
+
+	currentSet := make(map[string]bool, len(currentFiles))
+	for _, rel := range currentFiles {
+		currentSet[rel] = true
+	}
+
+	var diffs []fileDiff
+
+	for _, rel := range renderedFiles {
+		rendered, err := os.ReadFile(filepath.Join(renderedDir, filepath.FromSlash(rel)))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read rendered file %q: %w", rel, err)
+		}
+
+		fromFile := "/dev/null"
+		var current []byte
+
+		if currentSet[rel] {
+			fromFile = path.Join("a", filepath.ToSlash(displayDir), rel)
+
+			current, err = os.ReadFile(filepath.Join(currentDir, filepath.FromSlash(rel)))
+			if err != nil {
+				return nil, fmt.Errorf("unable to read file %q: %w", rel, err)
+			}
+
+			if bytes.Equal(current, rendered) {
+				continue
+			}
+		}
+
+		diff, err := unifiedDiff(string(current), string(rendered), fromFile, path.Join("b", filepath.ToSlash(displayDir), rel))
+		if err != nil {
+			return nil, fmt.Errorf("unable to diff file %q: %w", rel, err)
+		}
+
+		diffs = append(diffs, fileDiff{
+			Path:    rel,
+			Unified: diff,
+		})
+	}
+
+	for _, rel := range currentFiles {
+		if renderedSet[rel] || !isManagedWebsitePath(rel, outputExtension) {
+			continue
+		}
+
+		current, err := os.ReadFile(filepath.Join(currentDir, filepath.FromSlash(rel)))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read file %q: %w", rel, err)
+		}
+
+		diff, err := unifiedDiff(string(current), "", path.Join("a", filepath.ToSlash(displayDir), rel), "/dev/null")
+		if err != nil {
+			return nil, fmt.Errorf("unable to diff file %q: %w", rel, err)
+		}
+
+		diffs = append(diffs, fileDiff{
+			Path:    rel,
+			Unified: diff,
+		})
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+
+	return diffs, nil
+}
+
+// isManagedWebsitePath returns true if the slash separated path, relative to
+// the rendered website directory, is removed and regenerated by generate with
+// the output extension.
+func isManagedWebsitePath(rel, outputExtension string) bool {
+	dir, _, found := strings.Cut(rel, "/")
+	if !found {
+		return slices.Contains(managedWebsiteFiles, rel)
+	}
+
+	return slices.Contains(managedSubDirectories(outputExtension), dir)
+}
+
+// walkFiles returns the sorted, slash separated paths of all files under dir,
+// relative to dir. A missing dir is treated as empty.
+func walkFiles(dir string) ([]string, error) {
+	var files []string
+
+	err := filepath.WalkDir(dir, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			if p == dir && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return fmt.Errorf("unable to walk path %q: %w", p, err)
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+
+		files = append(files, filepath.ToSlash(rel))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(files)
+
+	return files, nil
+}
+
+func unifiedDiff(a, b, fromFile, toFile string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(a),
+		B:        splitLines(b),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+}
+
+// splitLines splits text into newline terminated lines for diffing. Unlike
+// difflib.SplitLines, text ending with a newline does not produce an extra
+// empty line.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	lines := strings.SplitAfter(text, "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+
+	lines[len(lines)-1] += "\n"
+
+	return lines
+}
@@ -0,0 +1,180 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_diffRenderedWebsite(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		currentFiles  map[string]string
+		renderedFiles map[string]string
+		expected      []fileDiff
+	}{
+		"up to date": {
+			currentFiles: map[string]string{
+				"index.md":             "# provider\n",
+				"resources/example.md": "# resource\n",
+			},
+			renderedFiles: map[string]string{
+				"index.md":             "# provider\n",
+				"resources/example.md": "# resource\n",
+			},
+		},
+		"missing current directory": {
+			renderedFiles: map[string]string{
+				"index.md": "# provider\n",
+			},
+			expected: []fileDiff{
+				{
+					Path:    "index.md",
+					Unified: "--- /dev/null\n+++ b/docs/index.md\n@@ -0,0 +1 @@\n+# provider\n",
+				},
+			},
+		},
+		"updated file": {
+			currentFiles: map[string]string{
+				"resources/example.md": "# resource\n\nold description\n",
+			},
+			renderedFiles: map[string]string{
+				"resources/example.md": "# resource\n\nnew description\n",
+			},
+			expected: []fileDiff{
+				{
+					Path:    "resources/example.md",
+					Unified: "--- a/docs/resources/example.md\n+++ b/docs/resources/example.md\n@@ -1,3 +1,3 @@\n # resource\n \n-old description\n+new description\n",
+				},
+			},
+		},
+		"extraneous managed file": {
+			currentFiles: map[string]string{
+				"resources/example.md": "# resource\n",
+				"resources/removed.md": "# removed\n",
+			},
+			renderedFiles: map[string]string{
+				"resources/example.md": "# resource\n",
+			},
+			expected: []fileDiff{
+				{
+					Path:    "resources/removed.md",
+					Unified: "--- a/docs/resources/removed.md\n+++ /dev/null\n@@ -1 +0,0 @@\n-# removed\n",
+				},
+			},
+		},
+		"extraneous unmanaged file": {
+			currentFiles: map[string]string{
+				"cdktf/python/index.md": "# provider\n",
+				"index.md":              "# provider\n",
+			},
+			renderedFiles: map[string]string{
+				"index.md": "# provider\n",
+			},
+		},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			currentDir := filepath.Join(t.TempDir(), "docs")
+			renderedDir := t.TempDir()
+
+			writeTestFiles(t, currentDir, c.currentFiles)
+			writeTestFiles(t, renderedDir, c.renderedFiles)
+
+			actual, err := diffRenderedWebsite(currentDir, renderedDir, "docs", "")
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func writeTestFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for rel, content := range files {
+		err := writeFile(filepath.Join(dir, filepath.FromSlash(rel)), content)
+		if err != nil {
+			t.Fatalf("unable to write test file: %s", err)
+		}
+	}
+
+	if len(files) == 0 {
+		err := os.RemoveAll(dir)
+		if err != nil {
+			t.Fatalf("unable to remove test directory: %s", err)
+		}
+	}
+}
+
+func Test_fileDiff_Change_Stats(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		diff            fileDiff
+		expectedChange  string
+		expectedAdded   int
+		expectedRemoved int
+	}{
+		"created": {
+			diff: fileDiff{
+				Path:    "index.md",
+				Unified: "--- /dev/null\n+++ b/docs/index.md\n@@ -0,0 +1,2 @@\n+# provider\n+\n",
+			},
+			expectedChange: fileChangeCreated,
+			expectedAdded:  2,
+		},
+		"updated": {
+			diff: fileDiff{
+				Path:    "resources/example.md",
+				Unified: "--- a/docs/resources/example.md\n+++ b/docs/resources/example.md\n@@ -1,3 +1,3 @@\n # resource\n \n-old description\n+new description\n",
+			},
+			expectedChange:  fileChangeUpdated,
+			expectedAdded:   1,
+			expectedRemoved: 1,
+		},
+		"deleted": {
+			diff: fileDiff{
+				Path:    "resources/removed.md",
+				Unified: "--- a/docs/resources/removed.md\n+++ /dev/null\n@@ -1 +0,0 @@\n-# removed\n",
+			},
+			expectedChange:  fileChangeDeleted,
+			expectedRemoved: 1,
+		},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			if diff := cmp.Diff(c.expectedChange, c.diff.Change()); diff != "" {
+				t.Errorf("unexpected change difference: %s", diff)
+			}
+
+			added, removed := c.diff.Stats()
+
+			if diff := cmp.Diff(c.expectedAdded, added); diff != "" {
+				t.Errorf("unexpected added lines difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(c.expectedRemoved, removed); diff != "" {
+				t.Errorf("unexpected removed lines difference: %s", diff)
+			}
+		})
+	}
+}
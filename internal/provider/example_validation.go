@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	tfjson "github.com/hashicorp/terraform-json"
+	"golang.org/x/exp/slices"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/tmplfuncs"
+)
+
+// exampleMetaArguments are the meta-arguments, and meta-argument blocks, of
+// the blocks of examples, by block type, which are valid regardless of the
+// provider schema.
+var exampleMetaArguments = map[string][]string{
+	"provider":  {"alias", "version"},
+	"resource":  {"count", "for_each", "provider", "depends_on", "lifecycle", "provisioner", "connection"},
+	"data":      {"count", "for_each", "provider", "depends_on", "lifecycle"},
+	"ephemeral": {"count", "for_each", "provider", "depends_on", "lifecycle"},
+	"action":    {"count", "for_each", "provider"},
+}
+
+// checkExamples returns an error listing the arguments and blocks of the
+// provider, resources, data sources, ephemeral resources, and actions of the
+// provider in the Terraform files of the examples directory which are not in
+// the provider schema, or are read-only, such as attributes which have been
+// removed from the provider. Blocks of other providers are not checked.
+func (g *generator) checkExamples(providerSchema *tfjson.ProviderSchema) error {
+	examplesDir := g.ProviderExamplesDir()
+	if !dirExists(examplesDir) {
+		return nil
+	}
+
+	var errs []error
+
+	err := filepath.WalkDir(examplesDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Ext(path) != ".tf" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(g.providerDir, path)
+		if err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read example file %q: %w", rel, err)
+		}
+
+		content = []byte(tmplfuncs.ReplacePlaceholders(string(content), g.exampleValues))
+
+		errs = append(errs, g.checkExample(filepath.ToSlash(rel), content, providerSchema)...)
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error walking examples directory %q: %w", examplesDir, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkExample returns the errors of the blocks of the provider in the
+// content of an example file. Syntax errors are left to the --example-syntax
+// flag, and the blocks which could be parsed are checked.
+func (g *generator) checkExample(name string, content []byte, providerSchema *tfjson.ProviderSchema) []error {
+	file, _ := hclsyntax.ParseConfig(content, name, hcl.Pos{Line: 1, Column: 1})
+	if file == nil {
+		return nil
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil
+	}
+
+	shortName := providerShortName(g.providerName)
+
+	var errs []error
+
+	for _, block := range body.Blocks {
+		if len(block.Labels) == 0 {
+			continue
+		}
+
+		typeName := block.Labels[0]
+
+		var schemas map[string]*tfjson.Schema
+
+		switch block.Type {
+		case "provider":
+			if typeName != shortName || providerSchema.ConfigSchema == nil {
+				continue
+			}
+
+			errs = append(errs, checkExampleBody(name, "provider "+quoteLabels(block.Labels), block.Body, providerSchema.ConfigSchema.Block, exampleMetaArguments[block.Type])...)
+			continue
+		case "resource":
+			schemas = providerSchema.ResourceSchemas
+		case "data":
+			schemas = providerSchema.DataSourceSchemas
+		case "ephemeral":
+			schemas = providerSchema.EphemeralResourceSchemas
+		case "action":
+			schemas = g.actionSchemas
+		default:
+			continue
+		}
+
+		if !strings.HasPrefix(typeName, shortName+"_") && typeName != shortName {
+			continue
+		}
+
+		address := block.Type + " " + quoteLabels(block.Labels)
+
+		schema, ok := schemas[typeName]
+		if !ok || schema == nil || schema.Block == nil {
+			errs = append(errs, fmt.Errorf("%s:%d: %s: %s type %q is not in the provider schema", name, block.DefRange().Start.Line, address, block.Type, typeName))
+			continue
+		}
+
+		// the arguments of actions are set in their config block
+		if block.Type == "action" {
+			errs = append(errs, checkExampleBody(name, address, block.Body, &tfjson.SchemaBlock{
+				NestedBlocks: map[string]*tfjson.SchemaBlockType{
+					"config": {Block: schema.Block},
+				},
+			}, exampleMetaArguments[block.Type])...)
+			continue
+		}
+
+		errs = append(errs, checkExampleBody(name, address, block.Body, schema.Block, exampleMetaArguments[block.Type])...)
+	}
+
+	return errs
+}
+
+// checkExampleBody returns the errors of the arguments and blocks of the
+// body which are not in the schema block, or are read-only, except for the
+// meta-arguments, recursively for nested blocks, including dynamic blocks.
+func checkExampleBody(name, address string, body *hclsyntax.Body, block *tfjson.SchemaBlock, metaArguments []string) []error {
+	var errs []error
+
+	attrs := make([]*hclsyntax.Attribute, 0, len(body.Attributes))
+	for _, attr := range body.Attributes {
+		attrs = append(attrs, attr)
+	}
+
+	// attributes are checked in the order of the file
+	sort.Slice(attrs, func(i, j int) bool {
+		return attrs[i].SrcRange.Start.Byte < attrs[j].SrcRange.Start.Byte
+	})
+
+	for _, attr := range attrs {
+		if slices.Contains(metaArguments, attr.Name) {
+			continue
+		}
+
+		schemaAttr, ok := block.Attributes[attr.Name]
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("%s:%d: %s: unsupported argument %q", name, attr.SrcRange.Start.Line, address, attr.Name))
+		case schemaAttr.Computed && !schemaAttr.Optional && !schemaAttr.Required:
+			errs = append(errs, fmt.Errorf("%s:%d: %s: argument %q is read-only", name, attr.SrcRange.Start.Line, address, attr.Name))
+		}
+	}
+
+	for _, nested := range body.Blocks {
+		blockType := nested.Type
+		nestedBody := nested.Body
+
+		if blockType == "dynamic" && len(nested.Labels) > 0 {
+			blockType = nested.Labels[0]
+			nestedBody = nil
+
+			for _, content := range nested.Body.Blocks {
+				if content.Type == "content" {
+					nestedBody = content.Body
+				}
+			}
+		}
+
+		if slices.Contains(metaArguments, blockType) {
+			continue
+		}
+
+		nestedBlock, ok := block.NestedBlocks[blockType]
+		if !ok || nestedBlock.Block == nil {
+			errs = append(errs, fmt.Errorf("%s:%d: %s: unsupported block %q", name, nested.DefRange().Start.Line, address, blockType))
+			continue
+		}
+
+		if nestedBody != nil {
+			errs = append(errs, checkExampleBody(name, address+"."+blockType, nestedBody, nestedBlock.Block, nil)...)
+		}
+	}
+
+	return errs
+}
+
+// quoteLabels returns the labels of a block as they are written in Terraform
+// configurations, such as "scaffolding_example" "example".
+func quoteLabels(labels []string) string {
+	quoted := make([]string, len(labels))
+	for i, label := range labels {
+		quoted[i] = fmt.Sprintf("%q", label)
+	}
+
+	return strings.Join(quoted, " ")
+}
@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestCheckExample(t *testing.T) {
+	t.Parallel()
+
+	providerSchema := &tfjson.ProviderSchema{
+		ConfigSchema: &tfjson.Schema{
+			Block: &tfjson.SchemaBlock{
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"endpoint": {Optional: true},
+				},
+			},
+		},
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"id":   {Computed: true},
+						"name": {Required: true},
+						"tags": {Optional: true, Computed: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"settings": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"mode": {Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"name": {Required: true},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		content        string
+		expectedErrors []string
+	}{
+		"valid": {
+			content: `provider "scaffolding" {
+  endpoint = "https://example.com"
+  alias    = "other"
+}
+
+resource "scaffolding_example" "example" {
+  count = 2
+
+  name = "example"
+  tags = "example"
+
+  settings {
+    mode = "fast"
+  }
+
+  lifecycle {
+    create_before_destroy = true
+  }
+}
+
+data "scaffolding_example" "example" {
+  name = scaffolding_example.example[0].id
+}
+
+resource "other_example" "example" {
+  unknown = true
+}
+`,
+		},
+		"removed attributes": {
+			content: `resource "scaffolding_example" "example" {
+  name    = "example"
+  display = "example"
+
+  settings {
+    speed = "fast"
+  }
+}
+
+data "scaffolding_example" "example" {
+  name   = "example"
+  filter = "example"
+}
+`,
+			expectedErrors: []string{
+				`examples/resource.tf:3: resource "scaffolding_example" "example": unsupported argument "display"`,
+				`examples/resource.tf:6: resource "scaffolding_example" "example".settings: unsupported argument "speed"`,
+				`examples/resource.tf:12: data "scaffolding_example" "example": unsupported argument "filter"`,
+			},
+		},
+		"read-only attribute": {
+			content: `resource "scaffolding_example" "example" {
+  id   = "example"
+  name = "example"
+}
+`,
+			expectedErrors: []string{
+				`examples/resource.tf:2: resource "scaffolding_example" "example": argument "id" is read-only`,
+			},
+		},
+		"unsupported blocks": {
+			content: `provider "scaffolding" {
+  region = "example"
+}
+
+resource "scaffolding_example" "example" {
+  name = "example"
+
+  options {
+    mode = "fast"
+  }
+
+  dynamic "rules" {
+    for_each = []
+    content {}
+  }
+}
+`,
+			expectedErrors: []string{
+				`examples/resource.tf:2: provider "scaffolding": unsupported argument "region"`,
+				`examples/resource.tf:8: resource "scaffolding_example" "example": unsupported block "options"`,
+				`examples/resource.tf:12: resource "scaffolding_example" "example": unsupported block "rules"`,
+			},
+		},
+		"removed resource": {
+			content: `resource "scaffolding_widget" "example" {
+  name = "example"
+}
+`,
+			expectedErrors: []string{
+				`examples/resource.tf:1: resource "scaffolding_widget" "example": resource type "scaffolding_widget" is not in the provider schema`,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			g := &generator{
+				providerName: "terraform-provider-scaffolding",
+			}
+
+			var actual []string
+			for _, err := range g.checkExample("examples/resource.tf", []byte(testCase.content), providerSchema) {
+				actual = append(actual, err.Error())
+			}
+
+			if diff := cmp.Diff(testCase.expectedErrors, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckExample_Dynamic(t *testing.T) {
+	t.Parallel()
+
+	providerSchema := &tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {
+				Block: &tfjson.SchemaBlock{
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"rule": {
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"port": {Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	g := &generator{
+		providerName: "terraform-provider-scaffolding",
+	}
+
+	errs := g.checkExample("examples/resource.tf", []byte(`resource "scaffolding_example" "example" {
+  dynamic "rule" {
+    for_each = [80, 443]
+    content {
+      port     = rule.value
+      protocol = "tcp"
+    }
+  }
+}
+`), providerSchema)
+
+	expected := `examples/resource.tf:6: resource "scaffolding_example" "example".rule: unsupported argument "protocol"`
+	if err := errors.Join(errs...); err == nil || err.Error() != expected {
+		t.Errorf("expected error %q, got: %v", expected, err)
+	}
+}
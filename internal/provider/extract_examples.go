@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/tmplfuncs"
+)
+
+// exampleDirective is the comment directive which marks the Terraform
+// configuration of an acceptance test as an example, followed by the path of
+// the example file relative to the examples directory, and optionally the
+// values of the format verbs of the configuration, for example:
+//
+//	// tfplugindocs:example resources/scaffolding_example/resource.tf example
+//	return fmt.Sprintf(`resource "scaffolding_example" "test" { name = %q }`, name)
+const exampleDirective = "tfplugindocs:example"
+
+// testExample is a Terraform configuration extracted from a test file.
+type testExample struct {
+	// path is the slash separated path of the example file, relative to the
+	// examples directory.
+	path string
+
+	// source is the file and line of the directive, for messages.
+	source string
+
+	content string
+}
+
+type exampleExtractor struct {
+	// providerDir is the absolute path to the root provider directory
+	providerDir string
+
+	examplesDir string
+
+	check bool
+
+	ui cli.Ui
+}
+
+func (e *exampleExtractor) infof(format string, a ...interface{}) {
+	e.ui.Info(fmt.Sprintf(format, a...))
+}
+
+// ExtractExamples writes the Terraform configurations of the acceptance tests
+// of the provider which are marked with the example directive to the examples
+// directory. With check, the examples are not written, and an error is
+// returned if they differ from the configurations of the tests.
+func ExtractExamples(ui cli.Ui, providerDir, examplesDir string, check bool) error {
+	// Ensure provider directory is resolved absolute path
+	if providerDir == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			return fmt.Errorf("error getting working directory: %w", err)
+		}
+
+		providerDir = wd
+	} else {
+		absProviderDir, err := filepath.Abs(providerDir)
+		if err != nil {
+			return fmt.Errorf("error getting absolute path with provider directory %q: %w", providerDir, err)
+		}
+
+		providerDir = absProviderDir
+	}
+
+	e := &exampleExtractor{
+		providerDir: providerDir,
+		examplesDir: examplesDir,
+		check:       check,
+		ui:          ui,
+	}
+
+	return e.Extract()
+}
+
+func (e *exampleExtractor) Extract() error {
+	e.infof("extracting examples from test files in %q", e.providerDir)
+
+	examples, err := e.testExamples()
+	if err != nil {
+		return err
+	}
+
+	var outdated []error
+	unchanged := 0
+
+	for _, example := range examples {
+		path := filepath.Join(e.providerDir, e.examplesDir, filepath.FromSlash(example.path))
+
+		existing, err := os.ReadFile(path)
+		if err == nil && bytes.Equal(existing, []byte(example.content)) {
+			unchanged++
+			continue
+		}
+
+		rel := filepath.ToSlash(filepath.Join(e.examplesDir, filepath.FromSlash(example.path)))
+
+		if e.check {
+			outdated = append(outdated, fmt.Errorf("example %q is out of date with %s", rel, example.source))
+			continue
+		}
+
+		err = os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			return fmt.Errorf("unable to create directory for example %q: %w", rel, err)
+		}
+
+		err = os.WriteFile(path, []byte(example.content), 0644)
+		if err != nil {
+			return fmt.Errorf("unable to write example %q: %w", rel, err)
+		}
+
+		e.infof("extracted example %q from %s", rel, example.source)
+	}
+
+	if len(outdated) > 0 {
+		return fmt.Errorf("examples are out of date with acceptance tests, run the extract-examples command:\n%w", errors.Join(outdated...))
+	}
+
+	if e.check {
+		e.infof("%d examples are up to date", len(examples))
+		return nil
+	}
+
+	e.infof("%d examples extracted, %d unchanged", len(examples)-unchanged, unchanged)
+
+	return nil
+}
+
+// testExamples returns the examples of the test files of the provider
+// directory, sorted by path. Hidden, vendor, and testdata directories are
+// skipped.
+func (e *exampleExtractor) testExamples() ([]testExample, error) {
+	var examples []testExample
+
+	err := filepath.WalkDir(e.providerDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			name := d.Name()
+			if path != e.providerDir && (strings.HasPrefix(name, ".") || name == "vendor" || name == "testdata") {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !strings.HasSuffix(path, "_test.go") {
+			return nil
+		}
+
+		rel, err := filepath.Rel(e.providerDir, path)
+		if err != nil {
+			return err
+		}
+
+		src, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read test file %q: %w", rel, err)
+		}
+
+		fileExamples, err := extractTestExamples(filepath.ToSlash(rel), src)
+		if err != nil {
+			return err
+		}
+
+		examples = append(examples, fileExamples...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error walking provider directory %q: %w", e.providerDir, err)
+	}
+
+	sort.SliceStable(examples, func(i, j int) bool {
+		return examples[i].path < examples[j].path
+	})
+
+	for i := 1; i < len(examples); i++ {
+		if examples[i].path == examples[i-1].path {
+			return nil, fmt.Errorf("example %q is extracted from both %s and %s", examples[i].path, examples[i-1].source, examples[i].source)
+		}
+	}
+
+	return examples, nil
+}
+
+// extractTestExamples returns the examples of the Go test file with the given
+// name. The configuration of an example is the first string literal after its
+// directive. If the literal is the format of a fmt.Sprintf call, its format
+// verbs are replaced with the values of the directive, which are integers,
+// booleans, or otherwise strings. The common indentation of the configuration
+// is removed, and it is formatted in the canonical style of terraform fmt.
+func extractTestExamples(name string, src []byte) ([]testExample, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, name, src, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse test file %q: %w", name, err)
+	}
+
+	var literals []*ast.BasicLit
+	formats := make(map[*ast.BasicLit]bool)
+
+	ast.Inspect(file, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.BasicLit:
+			if n.Kind == token.STRING {
+				literals = append(literals, n)
+			}
+		case *ast.CallExpr:
+			if isSprintfCall(n) && len(n.Args) > 0 {
+				if lit, ok := n.Args[0].(*ast.BasicLit); ok {
+					formats[lit] = true
+				}
+			}
+		}
+		return true
+	})
+
+	sort.Slice(literals, func(i, j int) bool {
+		return literals[i].Pos() < literals[j].Pos()
+	})
+
+	var examples []testExample
+
+	for _, group := range file.Comments {
+		for _, comment := range group.List {
+			text := strings.TrimSpace(strings.TrimPrefix(comment.Text, "//"))
+			fields := strings.Fields(text)
+			if len(fields) == 0 || fields[0] != exampleDirective {
+				continue
+			}
+
+			source := fmt.Sprintf("%s:%d", name, fset.Position(comment.Pos()).Line)
+
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("%s: example directive is missing the path of the example file", source)
+			}
+
+			path := fields[1]
+			if !filepath.IsLocal(path) {
+				return nil, fmt.Errorf("%s: example path %q must be relative to the examples directory", source, path)
+			}
+
+			idx := sort.Search(len(literals), func(i int) bool {
+				return literals[i].Pos() > comment.End()
+			})
+			if idx == len(literals) {
+				return nil, fmt.Errorf("%s: no string literal after example directive", source)
+			}
+			lit := literals[idx]
+
+			content, err := strconv.Unquote(lit.Value)
+			if err != nil {
+				return nil, fmt.Errorf("%s: unable to read string literal: %w", source, err)
+			}
+
+			values := fields[2:]
+			switch {
+			case formats[lit]:
+				content = fmt.Sprintf(content, directiveValues(values)...)
+				if strings.Contains(content, "%!") {
+					return nil, fmt.Errorf("%s: the %d values of the example directive do not match the format verbs of the configuration", source, len(values))
+				}
+			case len(values) > 0:
+				return nil, fmt.Errorf("%s: example directive values are only supported for fmt.Sprintf configurations", source)
+			}
+
+			examples = append(examples, testExample{
+				path:    filepath.ToSlash(filepath.Clean(path)),
+				source:  source,
+				content: tmplfuncs.FormatTerraform(strings.TrimSpace(tmplfuncs.Dedent(content)) + "\n"),
+			})
+		}
+	}
+
+	return examples, nil
+}
+
+// isSprintfCall returns whether the call is a fmt.Sprintf call.
+func isSprintfCall(call *ast.CallExpr) bool {
+	sel, ok := call.Fun.(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Sprintf" {
+		return false
+	}
+
+	pkg, ok := sel.X.(*ast.Ident)
+	return ok && pkg.Name == "fmt"
+}
+
+// directiveValues returns the values of an example directive as integers,
+// booleans, or strings, for the format verbs of a configuration.
+func directiveValues(values []string) []interface{} {
+	args := make([]interface{}, len(values))
+	for i, value := range values {
+		if n, err := strconv.Atoi(value); err == nil {
+			args[i] = n
+		} else if b, err := strconv.ParseBool(value); err == nil {
+			args[i] = b
+		} else {
+			args[i] = value
+		}
+	}
+
+	return args
+}
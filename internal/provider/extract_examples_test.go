@@ -0,0 +1,124 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestExtractTestExamples(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		src              string
+		expectedExamples []testExample
+		expectedError    string
+	}{
+		"constant and sprintf configurations": {
+			src: "package provider\n" + `
+import (
+	"fmt"
+	"testing"
+)
+
+func TestAccExampleResource(t *testing.T) {}
+
+// tfplugindocs:example resources/scaffolding_example/resource.tf
+const testAccExampleResourceConfig = ` + "`" + `
+	resource "scaffolding_example" "test" {
+		configurable_attribute = "one"
+	}
+` + "`" + `
+
+func testAccExampleDataSourceConfig(name string, count int) string {
+	// tfplugindocs:example data-sources/scaffolding_example/data-source.tf example 2
+	return fmt.Sprintf(` + "`" + `
+data "scaffolding_example" "test" {
+  count = %[2]d
+  name = %[1]q
+}
+` + "`" + `, name, count)
+}
+`,
+			expectedExamples: []testExample{
+				{
+					path:   "resources/scaffolding_example/resource.tf",
+					source: "internal/provider/example_test.go:10",
+					content: `resource "scaffolding_example" "test" {
+  configurable_attribute = "one"
+}
+`,
+				},
+				{
+					path:   "data-sources/scaffolding_example/data-source.tf",
+					source: "internal/provider/example_test.go:18",
+					content: `data "scaffolding_example" "test" {
+  count = 2
+  name  = "example"
+}
+`,
+				},
+			},
+		},
+		"missing values": {
+			src: "package provider\n" + `
+import "fmt"
+
+func testAccExampleConfig(name string) string {
+	// tfplugindocs:example resources/scaffolding_example/resource.tf
+	return fmt.Sprintf(` + "`" + `resource "scaffolding_example" "test" { name = %q }` + "`" + `, name)
+}
+`,
+			expectedError: `internal/provider/example_test.go:6: the 0 values of the example directive do not match the format verbs of the configuration`,
+		},
+		"values without sprintf": {
+			src: "package provider\n" + `
+// tfplugindocs:example resources/scaffolding_example/resource.tf example
+const testAccExampleConfig = ` + "`" + `resource "scaffolding_example" "test" {}` + "`" + `
+`,
+			expectedError: `internal/provider/example_test.go:3: example directive values are only supported for fmt.Sprintf configurations`,
+		},
+		"path outside examples directory": {
+			src: "package provider\n" + `
+// tfplugindocs:example ../main.tf
+const testAccExampleConfig = ` + "`" + `resource "scaffolding_example" "test" {}` + "`" + `
+`,
+			expectedError: `internal/provider/example_test.go:3: example path "../main.tf" must be relative to the examples directory`,
+		},
+		"no string literal": {
+			src: "package provider\n" + `
+func TestAccExampleResource() {}
+
+// tfplugindocs:example resources/scaffolding_example/resource.tf
+`,
+			expectedError: `internal/provider/example_test.go:5: no string literal after example directive`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := extractTestExamples("internal/provider/example_test.go", []byte(testCase.src))
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expectedExamples, actual, cmp.AllowUnexported(testExample{})); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
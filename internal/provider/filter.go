@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"golang.org/x/exp/slices"
+)
+
+// ignoreFile is the name of the file in the provider directory which contains
+// patterns of resources, data sources, ephemeral resources, list resources,
+// actions, and functions to exclude from documentation.
+const ignoreFile = ".tfplugindocsignore"
+
+// filterItemDirs are the rendered website subdirectories of items which can be
+// matched by a filter pattern.
+var filterItemDirs = []string{
+	"actions",
+	"data-sources",
+	"ephemeral-resources",
+	"functions",
+	"list-resources",
+	"resources",
+}
+
+// itemFilter matches resource, data source, ephemeral resource, list resource,
+// action, and function names against glob patterns, such as "aws_internal_*".
+// A pattern can be prefixed with the rendered website subdirectory of an item
+// type to only match items of that type, such as "data-sources/aws_s3_*".
+type itemFilter struct {
+	patterns []itemPattern
+}
+
+type itemPattern struct {
+	// dir is the rendered website subdirectory of the matched item type, or
+	// empty to match items of any type.
+	dir string
+
+	// name is the glob pattern of the matched item names.
+	name string
+}
+
+// newItemFilter returns a filter of the given patterns. Empty patterns are
+// ignored. A nil filter, which matches nothing, is returned if there are no
+// patterns.
+func newItemFilter(patterns []string) (*itemFilter, error) {
+	var f *itemFilter
+
+	for _, pattern := range patterns {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		p := itemPattern{
+			name: pattern,
+		}
+
+		if dir, name, found := strings.Cut(pattern, "/"); found {
+			if !slices.Contains(filterItemDirs, dir) {
+				return nil, fmt.Errorf("invalid pattern %q, expected prefix to be one of: %s", pattern, strings.Join(filterItemDirs, ", "))
+			}
+
+			p.dir = dir
+			p.name = name
+		}
+
+		if _, err := path.Match(p.name, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+
+		if f == nil {
+			f = &itemFilter{}
+		}
+
+		f.patterns = append(f.patterns, p)
+	}
+
+	return f, nil
+}
+
+// Match returns true if the named item, in the given rendered website
+// subdirectory, matches any of the filter patterns.
+func (f *itemFilter) Match(dir, name string) bool {
+	if f == nil {
+		return false
+	}
+
+	for _, p := range f.patterns {
+		if p.dir != "" && p.dir != dir {
+			continue
+		}
+
+		if ok, _ := path.Match(p.name, name); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// MatchingNames returns the names of the schemas and functions of the provider
+// schema, and the action schemas, which match any of the filter patterns, by
+// rendered website subdirectory of their item type. Names are only matched by
+// the patterns of their own type, so "data-sources/aws_s3_bucket" does not
+// match the resource of the same name.
+func (f *itemFilter) MatchingNames(providerSchema *tfjson.ProviderSchema, actionSchemas map[string]*tfjson.Schema) map[string][]string {
+	if f == nil || providerSchema == nil {
+		return nil
+	}
+
+	names := make(map[string][]string)
+
+	sections := []struct {
+		dir     string
+		schemas map[string]*tfjson.Schema
+	}{
+		{"resources", providerSchema.ResourceSchemas},
+		{"data-sources", providerSchema.DataSourceSchemas},
+		{"ephemeral-resources", providerSchema.EphemeralResourceSchemas},
+		{"list-resources", providerSchema.ListResourceSchemas},
+		{"actions", actionSchemas},
+	}
+
+	for _, section := range sections {
+		for name := range section.schemas {
+			if f.Match(section.dir, name) {
+				names[section.dir] = append(names[section.dir], name)
+			}
+		}
+	}
+
+	for name := range providerSchema.Functions {
+		if f.Match("functions", name) {
+			names["functions"] = append(names["functions"], name)
+		}
+	}
+
+	for _, dirNames := range names {
+		slices.Sort(dirNames)
+	}
+
+	return names
+}
+
+// RemoveMatching returns a copy of the provider schema without the schemas and
+// functions which match any of the filter patterns. The provider schema is
+// returned if the filter is nil.
+func (f *itemFilter) RemoveMatching(providerSchema *tfjson.ProviderSchema) *tfjson.ProviderSchema {
+	if f == nil || providerSchema == nil {
+		return providerSchema
+	}
+
+	filterSchemas := func(dir string, schemas map[string]*tfjson.Schema) map[string]*tfjson.Schema {
+		if schemas == nil {
+			return nil
+		}
+
+		result := make(map[string]*tfjson.Schema, len(schemas))
+		for name, schema := range schemas {
+			if !f.Match(dir, name) {
+				result[name] = schema
+			}
+		}
+
+		return result
+	}
+
+	result := *providerSchema
+	result.ResourceSchemas = filterSchemas("resources", providerSchema.ResourceSchemas)
+	result.DataSourceSchemas = filterSchemas("data-sources", providerSchema.DataSourceSchemas)
+	result.EphemeralResourceSchemas = filterSchemas("ephemeral-resources", providerSchema.EphemeralResourceSchemas)
+	result.ListResourceSchemas = filterSchemas("list-resources", providerSchema.ListResourceSchemas)
+
+	if providerSchema.Functions != nil {
+		result.Functions = make(map[string]*tfjson.FunctionSignature, len(providerSchema.Functions))
+		for name, signature := range providerSchema.Functions {
+			if !f.Match("functions", name) {
+				result.Functions[name] = signature
+			}
+		}
+	}
+
+	return &result
+}
+
+// ignorePatterns returns the patterns of the ignore file in the provider
+// directory, if it exists, followed by the given patterns. Blank lines and
+// lines starting with # in the ignore file are skipped.
+func ignorePatterns(providerDir string, patterns []string) ([]string, error) {
+	content, err := os.ReadFile(filepath.Join(providerDir, ignoreFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return patterns, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read ignore file %q: %w", ignoreFile, err)
+	}
+
+	var result []string
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		result = append(result, line)
+	}
+
+	return append(result, patterns...), nil
+}
+
+// loadIgnoreFilter returns the filter of the ignore file in the provider
+// directory and the given patterns.
+func loadIgnoreFilter(providerDir string, patterns []string) (*itemFilter, error) {
+	patterns, err := ignorePatterns(providerDir, patterns)
+	if err != nil {
+		return nil, err
+	}
+
+	f, err := newItemFilter(patterns)
+	if err != nil {
+		return nil, fmt.Errorf("invalid ignore patterns: %w", err)
+	}
+
+	return f, nil
+}
+
+// templateItem returns the rendered website subdirectory and name of the item
+// documented by a template or static file in the temporary templates
+// directory, or false if the file does not document a resource, data source,
+// ephemeral resource, list resource, action, or function.
+func templateItem(relDir, relFile, shortName string, providerSchema *tfjson.ProviderSchema, actionSchemas map[string]*tfjson.Schema) (string, string, bool) {
+	dir := strings.TrimSuffix(relDir, "/")
+
+	var schemas map[string]*tfjson.Schema
+
+	switch dir {
+	case "resources":
+		schemas = providerSchema.ResourceSchemas
+	case "data-sources":
+		schemas = providerSchema.DataSourceSchemas
+	case "ephemeral-resources":
+		schemas = providerSchema.EphemeralResourceSchemas
+	case "list-resources":
+		schemas = providerSchema.ListResourceSchemas
+	case "actions":
+		schemas = actionSchemas
+	case "functions":
+		return dir, removeAllExt(relFile), true
+	default:
+		return "", "", false
+	}
+
+	_, name := resourceSchema(schemas, shortName, relFile)
+
+	return dir, name, true
+}
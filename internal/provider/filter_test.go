@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func Test_itemFilter_Match(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		patterns []string
+		dir      string
+		name     string
+		expected bool
+	}{
+		"no patterns": {
+			dir:      "resources",
+			name:     "aws_internal_example",
+			expected: false,
+		},
+		"blank patterns": {
+			patterns: []string{"", " "},
+			dir:      "resources",
+			name:     "aws_internal_example",
+			expected: false,
+		},
+		"exact name": {
+			patterns: []string{"aws_internal_example"},
+			dir:      "data-sources",
+			name:     "aws_internal_example",
+			expected: true,
+		},
+		"glob": {
+			patterns: []string{"aws_s3_*", "aws_internal_*"},
+			dir:      "functions",
+			name:     "aws_internal_example",
+			expected: true,
+		},
+		"glob no match": {
+			patterns: []string{"aws_internal_*"},
+			dir:      "resources",
+			name:     "aws_s3_bucket",
+			expected: false,
+		},
+		"directory prefix": {
+			patterns: []string{"data-sources/aws_s3_*"},
+			dir:      "data-sources",
+			name:     "aws_s3_bucket",
+			expected: true,
+		},
+		"directory prefix other directory": {
+			patterns: []string{"data-sources/aws_s3_*"},
+			dir:      "resources",
+			name:     "aws_s3_bucket",
+			expected: false,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := newItemFilter(c.patterns)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			actual := f.Match(c.dir, c.name)
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_newItemFilter_errors(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		patterns    []string
+		expectedErr string
+	}{
+		"unknown directory": {
+			patterns:    []string{"guides/example"},
+			expectedErr: `invalid pattern "guides/example", expected prefix to be one of: actions, data-sources, ephemeral-resources, functions, list-resources, resources`,
+		},
+		"malformed pattern": {
+			patterns:    []string{"aws_[internal"},
+			expectedErr: `invalid pattern "aws_[internal": syntax error in pattern`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := newItemFilter(c.patterns)
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			if diff := cmp.Diff(c.expectedErr, err.Error()); diff != "" {
+				t.Errorf("unexpected error difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_itemFilter_MatchingNames(t *testing.T) {
+	t.Parallel()
+
+	f, err := newItemFilter([]string{"scaffolding_internal_*", "actions/scaffolding_*", "data-sources/scaffolding_example"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	providerSchema := &tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example":          {},
+			"scaffolding_internal_example": {},
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_internal_lookup": {},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"scaffolding_internal_parse": {},
+			"parse":                      {},
+		},
+	}
+
+	actionSchemas := map[string]*tfjson.Schema{
+		"scaffolding_invoke": {},
+	}
+
+	expected := map[string][]string{
+		"actions":      {"scaffolding_invoke"},
+		"data-sources": {"scaffolding_internal_lookup"},
+		"functions":    {"scaffolding_internal_parse"},
+		"resources":    {"scaffolding_internal_example"},
+	}
+
+	actual := f.MatchingNames(providerSchema, actionSchemas)
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func Test_itemFilter_RemoveMatching(t *testing.T) {
+	t.Parallel()
+
+	f, err := newItemFilter([]string{"scaffolding_internal_*", "data-sources/scaffolding_example"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	providerSchema := &tfjson.ProviderSchema{
+		ConfigSchema: &tfjson.Schema{},
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example":          {},
+			"scaffolding_internal_example": {},
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"scaffolding_internal_parse": {},
+			"parse":                      {},
+		},
+	}
+
+	actual := f.RemoveMatching(providerSchema)
+
+	if actual.ConfigSchema != providerSchema.ConfigSchema {
+		t.Errorf("expected provider config schema to be kept")
+	}
+
+	expected := map[string][]string{
+		"data-sources": {},
+		"functions":    {"parse"},
+		"resources":    {"scaffolding_example"},
+	}
+
+	actualNames := map[string][]string{
+		"data-sources": sortedKeys(actual.DataSourceSchemas),
+		"functions":    sortedKeys(actual.Functions),
+		"resources":    sortedKeys(actual.ResourceSchemas),
+	}
+
+	if diff := cmp.Diff(expected, actualNames); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if len(providerSchema.ResourceSchemas) != 2 {
+		t.Errorf("expected provider schema to be unchanged, got %d resource schemas", len(providerSchema.ResourceSchemas))
+	}
+}
+
+func Test_ignorePatterns(t *testing.T) {
+	t.Parallel()
+
+	providerDir := t.TempDir()
+
+	patterns, err := ignorePatterns(providerDir, []string{"scaffolding_flag_*"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff([]string{"scaffolding_flag_*"}, patterns); diff != "" {
+		t.Errorf("unexpected difference without ignore file: %s", diff)
+	}
+
+	content := "# Internal resources\nscaffolding_internal_*\n\n  data-sources/scaffolding_legacy  \n"
+	err = os.WriteFile(filepath.Join(providerDir, ".tfplugindocsignore"), []byte(content), 0644)
+	if err != nil {
+		t.Fatalf("unexpected error writing ignore file: %s", err)
+	}
+
+	patterns, err = ignorePatterns(providerDir, []string{"scaffolding_flag_*"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := []string{
+		"scaffolding_internal_*",
+		"data-sources/scaffolding_legacy",
+		"scaffolding_flag_*",
+	}
+
+	if diff := cmp.Diff(expected, patterns); diff != "" {
+		t.Errorf("unexpected difference with ignore file: %s", diff)
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	FrontmatterDialectDocusaurus = "docusaurus"
+	FrontmatterDialectHugo       = "hugo"
+	FrontmatterDialectRegistry   = "registry"
+)
+
+// FrontmatterDialects are the supported dialects of the frontmatter of
+// rendered templates:
+//
+//   - registry: Terraform Registry YAML frontmatter, rendered unmodified.
+//   - hugo: the YAML frontmatter is converted to TOML frontmatter, delimited
+//     by +++ lines.
+//   - docusaurus: sidebar_label and slug keys are added to the YAML
+//     frontmatter if not already present.
+var FrontmatterDialects = []string{
+	FrontmatterDialectRegistry,
+	FrontmatterDialectHugo,
+	FrontmatterDialectDocusaurus,
+}
+
+// tomlBareKey matches keys which do not need to be quoted in TOML.
+var tomlBareKey = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// validateFrontmatterDialect returns an error if the frontmatter dialect is
+// not supported.
+func validateFrontmatterDialect(frontmatterDialect string) error {
+	if frontmatterDialect != "" && !slices.Contains(FrontmatterDialects, frontmatterDialect) {
+		return fmt.Errorf("unsupported frontmatter dialect %q, expected one of: %s", frontmatterDialect, strings.Join(FrontmatterDialects, ", "))
+	}
+
+	return nil
+}
+
+// splitFrontmatter returns the YAML frontmatter of content, without its ---
+// delimiter lines, and the remaining content. The returned bool is false if
+// content has no frontmatter.
+func splitFrontmatter(content string) (string, string, bool) {
+	return splitDelimitedFrontmatter(content, "---")
+}
+
+// splitDelimitedFrontmatter returns the frontmatter of content between the
+// first line and the next line which are equal to delimiter, such as +++ for
+// TOML frontmatter, and the remaining content.
+func splitDelimitedFrontmatter(content, delimiter string) (string, string, bool) {
+	lines := strings.SplitAfter(content, "\n")
+	if len(lines) == 0 || strings.TrimSpace(lines[0]) != delimiter {
+		return "", content, false
+	}
+
+	for i := 1; i < len(lines); i++ {
+		if strings.TrimSpace(lines[i]) == delimiter {
+			return strings.Join(lines[1:i], ""), strings.Join(lines[i+1:], ""), true
+		}
+	}
+
+	return "", content, false
+}
+
+// convertFrontmatterDialect converts the YAML frontmatter of rendered content
+// into the frontmatter dialect. The rendered path is the slash-separated path
+// of the rendered file, relative to the rendered website directory, and label
+// is the name of the rendered item, or empty if the file is not an item page.
+// Content without frontmatter is returned unmodified.
+func convertFrontmatterDialect(content, frontmatterDialect, renderedPath, label string) (string, error) {
+	switch frontmatterDialect {
+	case FrontmatterDialectHugo:
+		return convertFrontmatterTOML(content)
+	case FrontmatterDialectDocusaurus:
+		return addFrontmatterDocusaurusKeys(content, renderedPath, label)
+	}
+
+	return content, nil
+}
+
+// addFrontmatterDocusaurusKeys adds the sidebar_label key, for item pages,
+// and the slug key, derived from the rendered path, to the YAML frontmatter of
+// content if they are not already present.
+func addFrontmatterDocusaurusKeys(content, renderedPath, label string) (string, error) {
+	frontmatter, body, ok := splitFrontmatter(content)
+	if !ok {
+		return content, nil
+	}
+
+	var keys map[string]interface{}
+
+	err := yaml.Unmarshal([]byte(frontmatter), &keys)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse YAML frontmatter: %w", err)
+	}
+
+	if _, ok := keys["sidebar_label"]; !ok && label != "" {
+		frontmatter += fmt.Sprintf("sidebar_label: %q\n", label)
+	}
+
+	if _, ok := keys["slug"]; !ok {
+		slug := "/" + trimOutputExtension(renderedPath)
+		if path.Base(slug) == "index" {
+			slug = path.Dir(slug)
+		}
+
+		frontmatter += fmt.Sprintf("slug: %q\n", slug)
+	}
+
+	return "---\n" + frontmatter + "---\n" + body, nil
+}
+
+// convertFrontmatterTOML converts the YAML frontmatter of content to TOML
+// frontmatter, keeping the order of keys. Values must be strings, numbers,
+// booleans, or lists of those; null values are omitted.
+func convertFrontmatterTOML(content string) (string, error) {
+	frontmatter, body, ok := splitFrontmatter(content)
+	if !ok {
+		return content, nil
+	}
+
+	var doc yaml.Node
+
+	err := yaml.Unmarshal([]byte(frontmatter), &doc)
+	if err != nil {
+		return "", fmt.Errorf("unable to parse YAML frontmatter: %w", err)
+	}
+
+	b := &strings.Builder{}
+	b.WriteString("+++\n")
+
+	if len(doc.Content) > 0 {
+		root := doc.Content[0]
+		if root.Kind != yaml.MappingNode {
+			return "", fmt.Errorf("expected YAML frontmatter to be a mapping")
+		}
+
+		for i := 0; i+1 < len(root.Content); i += 2 {
+			key, value := root.Content[i], root.Content[i+1]
+
+			if value.Kind == yaml.ScalarNode && value.Tag == "!!null" {
+				continue
+			}
+
+			tomlValue, err := tomlValue(value)
+			if err != nil {
+				return "", fmt.Errorf("unable to convert frontmatter key %q to TOML: %w", key.Value, err)
+			}
+
+			fmt.Fprintf(b, "%s = %s\n", tomlKey(key.Value), tomlValue)
+		}
+	}
+
+	b.WriteString("+++\n")
+
+	return b.String() + body, nil
+}
+
+func tomlKey(key string) string {
+	if tomlBareKey.MatchString(key) {
+		return key
+	}
+
+	return tomlString(key)
+}
+
+func tomlValue(node *yaml.Node) (string, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		switch node.Tag {
+		case "!!bool":
+			value, err := strconv.ParseBool(node.Value)
+			if err == nil {
+				return strconv.FormatBool(value), nil
+			}
+		case "!!int":
+			value, err := strconv.ParseInt(node.Value, 0, 64)
+			if err == nil {
+				return strconv.FormatInt(value, 10), nil
+			}
+		case "!!float":
+			value, err := strconv.ParseFloat(node.Value, 64)
+			if err == nil {
+				return strconv.FormatFloat(value, 'g', -1, 64), nil
+			}
+		}
+
+		return tomlString(node.Value), nil
+	case yaml.SequenceNode:
+		values := make([]string, 0, len(node.Content))
+
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return "", fmt.Errorf("expected list items to be strings, numbers, or booleans")
+			}
+
+			value, err := tomlValue(item)
+			if err != nil {
+				return "", err
+			}
+
+			values = append(values, value)
+		}
+
+		return "[" + strings.Join(values, ", ") + "]", nil
+	}
+
+	return "", fmt.Errorf("expected a string, number, boolean, or list")
+}
+
+// tomlString returns s as a TOML basic string. JSON string escapes are a
+// subset of TOML basic string escapes.
+func tomlString(s string) string {
+	b := &bytes.Buffer{}
+
+	enc := json.NewEncoder(b)
+	enc.SetEscapeHTML(false)
+
+	// Encoding a string cannot fail.
+	_ = enc.Encode(s)
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
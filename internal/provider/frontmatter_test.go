@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_convertFrontmatterDialect(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		content            string
+		frontmatterDialect string
+		renderedPath       string
+		label              string
+		expected           string
+		expectedError      string
+	}{
+		"registry": {
+			content:            "---\npage_title: \"Example\"\n---\n\n# Example\n",
+			frontmatterDialect: "registry",
+			renderedPath:       "resources/example.md",
+			label:              "scaffolding_example",
+			expected:           "---\npage_title: \"Example\"\n---\n\n# Example\n",
+		},
+		"hugo": {
+			content:            "---\npage_title: \"scaffolding_example Resource - terraform-provider-scaffolding\"\nsubcategory: \"\"\ndescription: |-\n  Example resource\n  with \"quotes\"\nweight: 10\ndraft: false\ntags: [storage, example]\nempty:\n---\n\n# Example\n",
+			frontmatterDialect: "hugo",
+			renderedPath:       "resources/example.md",
+			label:              "scaffolding_example",
+			expected:           "+++\npage_title = \"scaffolding_example Resource - terraform-provider-scaffolding\"\nsubcategory = \"\"\ndescription = \"Example resource\\nwith \\\"quotes\\\"\"\nweight = 10\ndraft = false\ntags = [\"storage\", \"example\"]\n+++\n\n# Example\n",
+		},
+		"hugo quoted key": {
+			content:            "---\n\"sidebar label\": <Example>\n---\n",
+			frontmatterDialect: "hugo",
+			renderedPath:       "index.md",
+			expected:           "+++\n\"sidebar label\" = \"<Example>\"\n+++\n",
+		},
+		"hugo nested mapping": {
+			content:            "---\nparams:\n  key: value\n---\n",
+			frontmatterDialect: "hugo",
+			renderedPath:       "index.md",
+			expectedError:      "unable to convert frontmatter key \"params\" to TOML: expected a string, number, boolean, or list",
+		},
+		"hugo no frontmatter": {
+			content:            "# Example\n\n---\n",
+			frontmatterDialect: "hugo",
+			renderedPath:       "index.md",
+			expected:           "# Example\n\n---\n",
+		},
+		"docusaurus item": {
+			content:            "---\npage_title: \"Example\"\n---\n\n# Example\n",
+			frontmatterDialect: "docusaurus",
+			renderedPath:       "resources/example.mdx",
+			label:              "scaffolding_example",
+			expected:           "---\npage_title: \"Example\"\nsidebar_label: \"scaffolding_example\"\nslug: \"/resources/example\"\n---\n\n# Example\n",
+		},
+		"docusaurus index": {
+			content:            "---\npage_title: \"Provider\"\n---\n\n# Provider\n",
+			frontmatterDialect: "docusaurus",
+			renderedPath:       "index.md",
+			expected:           "---\npage_title: \"Provider\"\nslug: \"/\"\n---\n\n# Provider\n",
+		},
+		"docusaurus existing keys": {
+			content:            "---\nsidebar_label: \"Custom\"\nslug: \"/custom\"\n---\n\n# Example\n",
+			frontmatterDialect: "docusaurus",
+			renderedPath:       "resources/example.md",
+			label:              "scaffolding_example",
+			expected:           "---\nsidebar_label: \"Custom\"\nslug: \"/custom\"\n---\n\n# Example\n",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := convertFrontmatterDialect(c.content, c.frontmatterDialect, c.renderedPath, c.label)
+
+			if c.expectedError != "" {
+				if err == nil || err.Error() != c.expectedError {
+					t.Fatalf("expected error %q, got: %v", c.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
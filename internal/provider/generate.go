@@ -4,25 +4,34 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
-	"os/exec"
+	"os/signal"
+	"path"
 	"path/filepath"
+	"regexp"
 	"runtime"
+	"strconv"
 	"strings"
+	"sync"
+	"syscall"
+	"text/template"
+	"time"
 
 	"github.com/hashicorp/cli"
 	"github.com/hashicorp/go-version"
-	install "github.com/hashicorp/hc-install"
-	"github.com/hashicorp/hc-install/checkpoint"
-	"github.com/hashicorp/hc-install/fs"
-	"github.com/hashicorp/hc-install/product"
-	"github.com/hashicorp/hc-install/releases"
-	"github.com/hashicorp/hc-install/src"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
 	"golang.org/x/exp/slices"
+
+	"github.com/hashicorp/terraform-plugin-docs/cmd/tfplugindocs/build"
+	"github.com/hashicorp/terraform-plugin-docs/internal/mdplain"
+	"github.com/hashicorp/terraform-plugin-docs/internal/tmplfuncs"
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
 )
 
 var (
@@ -59,6 +68,7 @@ var (
 		"functions/%s.html.md",
 	}
 	websiteProviderFile                 = "index.md.tmpl"
+	websitePartialsDir                  = "partials"
 	websiteProviderFileStaticCandidates = []string{
 		"index.markdown",
 		"index.md",
@@ -66,22 +76,365 @@ var (
 		"index.html.md",
 	}
 
+	websiteDeprecationsGuideFile             = "guides/deprecations.md.tmpl"
+	websiteDeprecationsGuideStaticCandidates = []string{
+		"guides/deprecations.markdown",
+		"guides/deprecations.md",
+		"guides/deprecations.html.markdown",
+		"guides/deprecations.html.md",
+	}
+
 	managedWebsiteSubDirectories = []string{
+		"actions",
 		"data-sources",
+		"ephemeral-resources",
 		"guides",
+		"list-resources",
 		"resources",
 		"functions",
 	}
 
 	managedWebsiteFiles = []string{
+		"index.html.markdown",
+		"index.markdown",
 		"index.md",
+		"index.mdx",
+	}
+)
+
+// itemTemplateType is a type of item whose missing templates are generated
+// from the same conventional paths and default template, such as list
+// resources.
+type itemTemplateType struct {
+	// name is the name of the type in messages, such as "list resource".
+	name string
+
+	// dir is the templates subdirectory of the type, such as
+	// "list-resources". The generic template of the type is the file of the
+	// same name with the .md.tmpl extension in the templates directory.
+	dir string
+}
+
+var (
+	ephemeralResourceTemplateType = itemTemplateType{name: "ephemeral resource", dir: "ephemeral-resources"}
+	listResourceTemplateType      = itemTemplateType{name: "list resource", dir: "list-resources"}
+	actionTemplateType            = itemTemplateType{name: "action", dir: "actions"}
+
+	// itemStaticFileExtensions are the extensions of static files in the
+	// templates subdirectory of an item type, which are rendered instead of a
+	// generated template.
+	itemStaticFileExtensions = []string{
+		".md",
+		".markdown",
+		".html.markdown",
+		".html.md",
 	}
 )
 
 type generator struct {
 	ignoreDeprecated bool
+	check            bool
+	dryRun           bool
+	parallel         int
 	tfVersion        string
 
+	// tfBinary is the path, or name in PATH, of the Terraform or OpenTofu CLI
+	// binary used to export the provider schema, instead of finding or
+	// downloading Terraform. It is empty if not set.
+	tfBinary string
+
+	// useOpenTofu exports the provider schema with the OpenTofu CLI.
+	useOpenTofu bool
+
+	// tfInstallDir is the directory in which downloaded Terraform CLI
+	// binaries are kept and reused. It is empty if not set.
+	tfInstallDir string
+
+	// offline fails instead of accessing the network to export the provider
+	// schema.
+	offline bool
+
+	// pluginDirs are the absolute paths of the provider plugin directories,
+	// which the registry provider is installed from instead of the registry.
+	pluginDirs []string
+
+	// failOnEmptyDescription fails generation if any generated item, or its
+	// attributes, blocks, or function parameters, lacks a description.
+	failOnEmptyDescription bool
+
+	// validateExamples fails generation if the Terraform files of the
+	// examples directory set arguments or blocks of the provider which are
+	// not in the provider schema, or are read-only.
+	validateExamples bool
+
+	// schemaStyle is the schemamd style used to render schemas.
+	schemaStyle string
+
+	// inlineNestedDepth is the number of nesting levels of nested schemas
+	// which are rendered inline under their parent attribute or block.
+	inlineNestedDepth int
+
+	// maxNestedDepth is the number of nesting levels of nested schemas which
+	// are rendered, or 0 for all.
+	maxNestedDepth int
+
+	// inlineObjectMaxAttributes is the number of attributes of object types
+	// up to which they are listed after the description of their attribute,
+	// instead of in a nested schema section, or 0 for none.
+	inlineObjectMaxAttributes int
+
+	// stripExampleHeaders removes copyright and license header comments and
+	// directive comments from files included by the codefile and tffile
+	// functions.
+	stripExampleHeaders bool
+
+	// formatExamples formats the Terraform files included by the codefile
+	// and tffile functions, and the examples of the JSON docs model, in the
+	// canonical style of terraform fmt.
+	formatExamples bool
+
+	// exampleSyntax is the severity of the syntax errors of the Terraform
+	// files included by the codefile and tffile functions, and of the
+	// examples of the JSON docs model, one of RuleSeverities.
+	exampleSyntax string
+
+	// generateExamples synthesizes a skeleton example from the schema of
+	// resources, data sources, ephemeral resources, list resources, and
+	// actions without an example, for the GeneratedExample field.
+	generateExamples bool
+
+	// exampleValues are the values of the @@<name>@@ placeholder tokens of
+	// examples, by name.
+	exampleValues map[string]string
+
+	// headings overrides the text of the headings of the default templates
+	// and schemas, by name.
+	headings map[string]string
+
+	// schemaGroupOrder is the order of the Required, Optional, and Read-Only
+	// schema groups, as names of schemamd.Groups. Empty is the default order.
+	schemaGroupOrder []string
+
+	// attributeAnchors writes an HTML anchor before every attribute and
+	// block of rendered schemas, for deep links.
+	attributeAnchors bool
+
+	// collapsibleNestedSchemas wraps the nested schema sections of rendered
+	// schemas in HTML details elements.
+	collapsibleNestedSchemas bool
+
+	// writeOnlySection renders the write-only attributes of rendered schemas
+	// in a section of their own.
+	writeOnlySection bool
+
+	// timeoutsSection renders the timeouts block, or attribute, of rendered
+	// schemas in a section of its own.
+	timeoutsSection bool
+
+	// sensitiveAttributesSection lists the sensitive attributes of resources
+	// in a section of the default templates.
+	sensitiveAttributesSection bool
+
+	// linkItemMentions converts code spans of rendered pages which mention
+	// generated resources and data sources into relative links to their
+	// pages, using the mentionTargets of the rendered website.
+	linkItemMentions bool
+	mentionTargets   *mentionTargets
+
+	// attributeSort is the sort order of the attributes and blocks of
+	// rendered schemas, one of schemamd.AttributeSorts.
+	attributeSort string
+
+	// attributeOrder are the names, or paths, of attributes and blocks which
+	// are ordered before, or after "*", the others within their group.
+	attributeOrder []string
+
+	// typeSyntax is how the types of attributes of rendered schemas are
+	// written, one of schemamd.TypeSyntaxes.
+	typeSyntax string
+
+	// debugTemplates outputs the template, or static file, which each page
+	// of the rendered website is rendered from.
+	debugTemplates bool
+
+	// templateSources are the sources of the templates in the temporary
+	// templates directory which were created from a type default or the
+	// built-in default template, by slash-separated path relative to the
+	// temporary templates directory. It is only set with debugTemplates.
+	templateSources map[string]string
+
+	// strictMetadata fails generation if the metadata files contain items
+	// or attributes which do not exist in the provider schema.
+	strictMetadata bool
+
+	// failFast stops rendering files at the first file which fails to
+	// render, instead of rendering the other files and returning all errors.
+	failFast bool
+
+	// progress outputs the duration of each phase of the generation, and
+	// the number of files rendered so far.
+	progress bool
+
+	// showStats outputs the summary of stats at the end of the generation.
+	showStats bool
+
+	// stats are the counts of files and the durations of the phases of the
+	// generation.
+	stats *generateStats
+
+	// locales are the locales which documentation is generated for in
+	// addition to the default documentation.
+	locales []string
+
+	// locale is the locale being generated, or empty for the default
+	// documentation.
+	locale string
+
+	// outputExtension is the file extension, and dialect, of rendered
+	// templates. Refer to OutputExtensions for supported values.
+	outputExtension string
+
+	// outputFormat is the output format of generation. Refer to
+	// OutputFormats for supported values.
+	outputFormat string
+
+	// htmlDir is the path, relative to the provider directory, of the static
+	// HTML site, which is written with the html output format.
+	htmlDir string
+
+	// frontmatterDialect is the dialect of the frontmatter of rendered
+	// templates. Refer to FrontmatterDialects for supported values.
+	frontmatterDialect string
+
+	// frontmatterDescriptionMaxLength, frontmatterDescriptionFirstSentence,
+	// and frontmatterDescriptionOmitLinkURLs configure the plain text of the
+	// plainmarkdown function, which the default templates render the
+	// description frontmatter with.
+	frontmatterDescriptionMaxLength     int
+	frontmatterDescriptionFirstSentence bool
+	frontmatterDescriptionOmitLinkURLs  bool
+
+	// splitPageSize is the size in bytes above which the nested schema
+	// sections of rendered item pages are moved into separate pages, or 0 to
+	// not split pages.
+	splitPageSize int
+
+	// emitJSONModel is the path, relative to the provider directory, of the
+	// machine-readable JSON documentation model file. No file is written if
+	// empty.
+	emitJSONModel string
+
+	// emitNav is the path, relative to the provider directory, of the
+	// navigation file of the rendered website. No file is written if empty.
+	emitNav string
+
+	// navFormat is the format of the navigation file, one of NavFormats.
+	navFormat string
+
+	// emitSinglePage is the path, relative to the provider directory, of the
+	// single page documentation file. No file is written if empty.
+	emitSinglePage string
+
+	// ignore matches the resources, data sources, ephemeral resources, list
+	// resources, actions, and functions which are excluded from generation.
+	ignore *itemFilter
+
+	// cacheFile is the path, relative to the provider directory, of the
+	// render cache file. Pages are always rendered if empty.
+	cacheFile string
+
+	// attributeDefaultsFile is the path, relative to the provider directory,
+	// of a JSON file with default values of attributes, which take precedence
+	// over the default values of the providers schema JSON.
+	attributeDefaultsFile string
+
+	// attributeDefaults are the rendered default values of attributes, from
+	// the providers schema JSON and the attribute defaults file.
+	attributeDefaults attributeDefaults
+
+	// attributeValidatorsFile is the path, relative to the provider
+	// directory, of a JSON file with validators of attributes, which take
+	// precedence over the validators of the providers schema JSON.
+	attributeValidatorsFile string
+
+	// attributeValidators are the validators of attributes, from the
+	// providers schema JSON and the attribute validators file.
+	attributeValidators attributeValidators
+
+	// requiresReplaceFile is the path, relative to the provider directory,
+	// of a JSON file which marks the attributes which force replacement,
+	// over the attributes of the providers schema JSON.
+	requiresReplaceFile string
+
+	// attributeReplacements are the attributes which force replacement, from
+	// the providers schema JSON and the requires replace file.
+	attributeReplacements attributeReplacements
+
+	// deprecationsFile is the path, relative to the provider directory, of a
+	// JSON file with the replacements of deprecated items and attributes.
+	deprecationsFile string
+
+	// deprecations are the replacements of deprecated items and attributes,
+	// from the deprecations file.
+	deprecations deprecations
+
+	// attributeTypesFile is the path, relative to the provider directory, of
+	// a JSON file with the names rendered instead of the types of attributes.
+	attributeTypesFile string
+
+	// attributeTypeNames are the names rendered instead of the types of
+	// attributes, from the attribute types file.
+	attributeTypeNames attributeTypeNames
+
+	// itemMetadataFile is the path, relative to the provider directory, of a
+	// JSON file with the metadata of items, such as related resources.
+	itemMetadataFile string
+
+	// itemMetadata is the metadata of items by rendered website
+	// subdirectory and name, from the item metadata file.
+	itemMetadata map[string]map[string]itemMetadata
+
+	// deprecationsGuide generates a guide which lists the deprecated items
+	// and attributes of the provider.
+	deprecationsGuide bool
+
+	// deprecatedSubcategory is the subcategory of deprecated items, which
+	// overrides the subcategory file, or empty to keep their subcategory.
+	deprecatedSubcategory string
+
+	// templateEnv are the names of the environment variables which the env
+	// template function can read.
+	templateEnv []string
+
+	// buildTimestamp is the generation timestamp of the buildinfo template
+	// function, which is the start of the run unless buildTimestampFixed is
+	// set.
+	buildTimestamp      time.Time
+	buildTimestampFixed bool
+
+	// deprecatedItems are the deprecated items of the provider, by rendered
+	// website subdirectory and name. It is only set with
+	// deprecatedSubcategory.
+	deprecatedItems map[string]map[string]bool
+
+	// cache contains the content hashes of rendered pages, if cacheFile is
+	// set and the rendered website directory is not checked.
+	cache *renderCache
+
+	// customRegions are the custom regions of the files in the rendered
+	// website directory before generation, which are preserved in the newly
+	// rendered files.
+	customRegions map[string][]customRegion
+
+	// only matches the resources, data sources, ephemeral resources, list
+	// resources, actions, and functions which are generated. All items are
+	// generated if nil.
+	only *itemFilter
+
+	// subcategories assigns the subcategories of rendered items, from the
+	// subcategory file in the provider directory.
+	subcategories *subcategoryMapping
+
 	// providerDir is the absolute path to the root provider directory
 	providerDir string
 
@@ -93,6 +446,53 @@ type generator struct {
 	templatesDir         string
 	websiteTmpDir        string
 
+	// providerVersion is the version of the documented provider, without a
+	// leading v, which is available to templates. It is empty if not set.
+	providerVersion string
+
+	// providerSource is the source address of the documented provider, such
+	// as "hashicorp/random", which is available to templates.
+	providerSource string
+
+	// registryProvider is the source address of a provider published in a
+	// registry, such as "hashicorp/aws", whose schema is exported by
+	// installing it with Terraform instead of building the provider
+	// directory. It is empty if not set.
+	registryProvider string
+
+	// registryVersion is the exact version of the registry provider, without
+	// a leading v, or empty for the latest version.
+	registryVersion string
+
+	// providersSchemaJSON is the providers schema JSON used instead of
+	// exporting the provider schema, if set.
+	providersSchemaJSON []byte
+
+	// templatesFS is the file system which templates are read from instead
+	// of the templates directory, if set.
+	templatesFS fs.FS
+
+	// templateFuncs are the additional template functions.
+	templateFuncs template.FuncMap
+
+	// templateFuncsPlugin is the plugin which provides some of the
+	// additional template functions, if set.
+	templateFuncsPlugin *templateFuncsPlugin
+
+	// postProcessors rewrite the content of each rendered page, including
+	// the post-process command, if set.
+	postProcessors []PostProcessor
+	postProcessCmd string
+
+	// preGenerateCmd and postGenerateCmd are the commands of the hooks run
+	// before the provider schema is read and after the website is written.
+	preGenerateCmd  string
+	postGenerateCmd string
+
+	// actionSchemas are the provider-defined action schemas, which are
+	// decoded separately from the provider schema.
+	actionSchemas map[string]*tfjson.Schema
+
 	ui cli.Ui
 }
 
@@ -104,13 +504,463 @@ func (g *generator) warnf(format string, a ...interface{}) {
 	g.ui.Warn(fmt.Sprintf(format, a...))
 }
 
-func Generate(ui cli.Ui, providerDir, providerName, providersSchemaPath, renderedProviderName, renderedWebsiteDir, examplesDir, websiteTmpDir, templatesDir, tfVersion string, ignoreDeprecated bool) error {
+// GenerateOptions are the options of website generation, which correspond to
+// the flags of the generate command.
+type GenerateOptions struct {
+	// ProviderDir is the path to the root provider directory. Defaults to the
+	// working directory.
+	ProviderDir string
+
+	ProviderName         string
+	ProvidersSchemaPath  string
+	RenderedProviderName string
+	RenderedWebsiteDir   string
+	ExamplesDir          string
+	WebsiteTmpDir        string
+	TemplatesDir         string
+	TFVersion            string
+
+	// TFBinary is the path, or name in PATH, of the Terraform or OpenTofu CLI
+	// binary used to export the provider schema, instead of finding or
+	// downloading Terraform. It cannot be used with TFVersion.
+	TFBinary string
+
+	// UseOpenTofu exports the provider schema with the OpenTofu CLI binary
+	// in PATH, or TFBinary if set, which must be OpenTofu.
+	UseOpenTofu bool
+
+	// TFInstallDir is the directory in which downloaded Terraform CLI
+	// binaries are kept, in a subdirectory named after the TFVersion, or
+	// "latest", and reused by later runs instead of downloading them again.
+	TFInstallDir string
+
+	// Offline fails instead of accessing the network to export the provider
+	// schema: the Terraform CLI binary is not downloaded, the provider is
+	// built without downloading modules, the ProvidersSchemaPath cannot be a
+	// URL, and the RegistryProvider is only installed from the PluginDirs and
+	// the TF_PLUGIN_CACHE_DIR plugin cache directory.
+	Offline bool
+
+	// PluginDirs are the provider plugin directories, in the layout of a
+	// Terraform filesystem mirror or plugin cache, which the RegistryProvider
+	// is installed from instead of the registry, as with the -plugin-dir
+	// flag of terraform init. Relative paths are relative to the working
+	// directory.
+	PluginDirs []string
+
+	// ProviderVersion is the version of the documented provider, which is
+	// available to templates as the ProviderVersion field. A leading v, as
+	// in release tags, is removed.
+	ProviderVersion string
+
+	// ProviderSource is the source address of the documented provider, in
+	// the [<hostname>/]<namespace>/<type> format, for the
+	// RequiredProvidersBlock field of the provider template. Defaults to
+	// hashicorp/<provider short name>.
+	ProviderSource string
+
+	// RegistryProvider is the source address of a provider published in a
+	// registry, in the [<hostname>/]<namespace>/<type> format, whose schema
+	// is exported by installing it with Terraform instead of building the
+	// provider directory. It cannot be used with ProvidersSchemaPath, and is
+	// the default of ProviderName, ProviderSource, and ProviderVersion.
+	RegistryProvider string
+
+	// RegistryVersion is the exact version of the registry provider to
+	// install, or empty for the latest version. A leading v is removed.
+	RegistryVersion string
+
+	// SchemaStyle is the style of rendered schemas, one of schemamd.Styles.
+	SchemaStyle string
+
+	// OutputExtension is the extension of rendered files, one of
+	// OutputExtensions.
+	OutputExtension string
+
+	// OutputFormat is the output format, one of OutputFormats. With the html
+	// output format, the rendered website directory is additionally
+	// converted into a static HTML site in HTMLDir.
+	OutputFormat string
+	HTMLDir      string
+
+	// FrontmatterDialect is the dialect of the frontmatter of rendered
+	// files, one of FrontmatterDialects.
+	FrontmatterDialect string
+
+	// FrontmatterDescriptionMaxLength is the maximum number of characters
+	// of the plain text of the plainmarkdown template function, which the
+	// default templates render the description frontmatter with. Longer text
+	// is truncated at a word boundary. The default of 0 does not limit it.
+	FrontmatterDescriptionMaxLength int
+
+	// FrontmatterDescriptionFirstSentence keeps only the first sentence of
+	// the plain text of the plainmarkdown template function.
+	FrontmatterDescriptionFirstSentence bool
+
+	// FrontmatterDescriptionOmitLinkURLs writes only the text of links in
+	// the plain text of the plainmarkdown template function, without their
+	// URL.
+	FrontmatterDescriptionOmitLinkURLs bool
+
+	// SplitPageSize is the size in bytes above which the nested schema
+	// sections of rendered resource, data source, and other item pages are
+	// moved into sibling pages, largest first, until the page is at most
+	// that size, such as to keep pages under the size limits of the
+	// Terraform Registry. The default of 0 does not split pages.
+	SplitPageSize int
+
+	// EmitJSONModel is the path, relative to the provider directory, of the
+	// JSON documentation model file. The file is not written if empty.
+	EmitJSONModel string
+
+	// EmitNav is the path, relative to the provider directory, of the
+	// navigation file of the rendered website, for use by external site
+	// generators. The file is not written if empty.
+	EmitNav string
+
+	// NavFormat is the format of the navigation file, one of NavFormats.
+	NavFormat string
+
+	// EmitSinglePage is the path, relative to the provider directory, of a
+	// file with all rendered pages combined into a single document, written
+	// as HTML if the path has an .html or .htm extension, otherwise as
+	// Markdown. The file is not written if empty.
+	EmitSinglePage string
+
+	// CacheFile is the path, relative to the provider directory, of the
+	// render cache file. Pages are always rendered if empty.
+	CacheFile string
+
+	// AttributeDefaultsFile is the path, relative to the provider directory,
+	// of a JSON file with default values of attributes, for providers schema
+	// JSONs which do not include them. Refer to the README for the format.
+	AttributeDefaultsFile string
+
+	// AttributeValidatorsFile is the path, relative to the provider
+	// directory, of a JSON file with validators of attributes, such as their
+	// allowed values, for providers schema JSONs which do not include them.
+	// Refer to the README for the format.
+	AttributeValidatorsFile string
+
+	// RequiresReplaceFile is the path, relative to the provider directory,
+	// of a JSON file which marks the attributes which force replacement of
+	// their resource, for providers schema JSONs which do not mark them.
+	// Refer to the README for the format.
+	RequiresReplaceFile string
+
+	// DeprecationsFile is the path, relative to the provider directory, of a
+	// JSON file with the replacements of deprecated resources, data sources,
+	// other items, and attributes, which are rendered after their
+	// deprecation. Refer to the README for the format.
+	DeprecationsFile string
+
+	// AttributeTypesFile is the path, relative to the provider directory, of
+	// a JSON file with the names rendered instead of the types of
+	// attributes, such as the names of custom types of a provider framework,
+	// which providers schema JSONs only contain the underlying type of.
+	// Refer to the README for the format.
+	AttributeTypesFile string
+
+	// ItemMetadataFile is the path, relative to the provider directory, of a
+	// JSON file with the metadata of resources, data sources, and other
+	// items, such as the related resources which are linked in a "Related
+	// Resources" section of the default templates. Refer to the README for
+	// the format.
+	ItemMetadataFile string
+
+	// DeprecationsGuide generates a deprecations guide, guides/deprecations.md
+	// in the rendered website directory, which lists the deprecated
+	// resources, data sources, other items, and attributes of the provider
+	// with links to their pages, unless the guide has a template or static
+	// file in the templates directory.
+	DeprecationsGuide bool
+
+	// DeprecatedSubcategory is the subcategory of deprecated resources, data
+	// sources, other items, and functions, such as "Deprecated", which
+	// overrides the subcategory file, to group them in the navigation of the
+	// Terraform Registry. It cannot be used with IgnoreDeprecated.
+	DeprecatedSubcategory string
+
+	// TemplateEnv are the names of the environment variables which templates
+	// can read with the env template function, such as GITHUB_SHA. Reading
+	// other environment variables is an error.
+	TemplateEnv []string
+
+	// BuildTimestamp is the RFC 3339 timestamp returned by the buildinfo
+	// template function, for reproducible output. The default of an empty
+	// string uses the time of the generation.
+	BuildTimestamp string
+
+	// Reproducible makes the rendered files only depend on the inputs of
+	// generation, so the same inputs always render byte-identical files.
+	// Without BuildTimestamp, the timestamp of the buildinfo template
+	// function is read from the SOURCE_DATE_EPOCH environment variable, or
+	// is the Unix epoch, instead of the time of the generation.
+	Reproducible bool
+
+	// Ignore and Only are the item patterns which are excluded from, and
+	// limit, generation.
+	Ignore []string
+	Only   []string
+
+	IgnoreDeprecated bool
+	Check            bool
+
+	// FailOnEmptyDescription fails generation if any generated item, or its
+	// attributes, blocks, or function parameters, lacks a description.
+	FailOnEmptyDescription bool
+
+	// ValidateExamples fails generation if the Terraform files of the
+	// examples directory set arguments or blocks of the provider,
+	// resources, data sources, ephemeral resources, or actions which are not
+	// in the provider schema, or are read-only.
+	ValidateExamples bool
+
+	DryRun              bool
+	StripExampleHeaders bool
+
+	// FormatExamples formats the Terraform files included by the codefile
+	// and tffile template functions in the canonical style of terraform
+	// fmt, without changing the files.
+	FormatExamples bool
+
+	// ExampleSyntax is the severity of the syntax errors of the Terraform
+	// files included by the codefile and tffile template functions: error
+	// fails generation, warn outputs them as warnings, and off, or empty,
+	// does not check the syntax of examples.
+	ExampleSyntax string
+
+	// GenerateExamples synthesizes a skeleton example, with the required
+	// attributes and blocks and placeholder values, for resources, data
+	// sources, ephemeral resources, list resources, and actions without an
+	// example file, which the default templates render as their example
+	// usage.
+	GenerateExamples bool
+
+	// ExampleValues are the values of the @@<name>@@ placeholder tokens of
+	// the files included by the codefile and tffile template functions, as
+	// <name>=<value> values, such as "VERSION=1.2.0", so that examples can
+	// be shared with tests which replace the tokens with other values.
+	ExampleValues []string
+
+	// Headings are the texts of the headings of the default templates and
+	// schemas, as <name>=<text> values, such as "read-only=Exported
+	// Attributes". Refer to the README for the heading names.
+	Headings []string
+
+	// SchemaGroupOrder is the order of the Required, Optional, and Read-Only
+	// schema groups, as names of schemamd.Groups.
+	SchemaGroupOrder []string
+
+	// AttributeAnchors writes an HTML anchor before every attribute and
+	// block of rendered schemas, such as "attr-versioning-enabled", for deep
+	// links to attributes.
+	AttributeAnchors bool
+
+	// CollapsibleNestedSchemas wraps the nested schema sections of rendered
+	// schemas in HTML details elements, which are collapsed by default, for
+	// output targets which render HTML.
+	CollapsibleNestedSchemas bool
+
+	// WriteOnlySection renders the write-only attributes of rendered schemas
+	// in a "Write-Only Arguments" section of their own, which explains that
+	// they are not persisted to the plan or state, instead of in the
+	// Required or Optional group.
+	WriteOnlySection bool
+
+	// TimeoutsSection renders the conventional "timeouts" block, or
+	// attribute, of rendered schemas in a "Timeouts" section of its own,
+	// which lists the create, read, update, and delete timeouts with their
+	// default values, instead of in a nested schema section.
+	TimeoutsSection bool
+
+	// SensitiveAttributesSection lists the sensitive attributes of
+	// resources, data sources, and other items in a "Sensitive Attributes"
+	// section of the default templates, with a warning that their values are
+	// stored in plain text in the state. Custom templates can use the
+	// SensitiveAttributes field instead.
+	SensitiveAttributesSection bool
+
+	// LinkItemMentions converts code spans of rendered pages which only
+	// contain the name of a generated resource or data source, such as
+	// `scaffolding_example` or `data.scaffolding_example`, into relative
+	// links to its page. Code spans in frontmatter, headings, code blocks,
+	// and existing links are not linked.
+	LinkItemMentions bool
+
+	// AttributeSort is the sort order of the attributes and blocks of
+	// rendered schemas, one of schemamd.AttributeSorts.
+	AttributeSort string
+
+	// AttributeOrder are the names, or dot separated paths, of attributes
+	// and blocks which are ordered before the others within their group.
+	// Entries after a "*" entry are ordered after the others instead.
+	AttributeOrder []string
+
+	// TypeSyntax is how the types of attributes of rendered schemas are
+	// written, one of schemamd.TypeSyntaxes, such as "Map of String" for the
+	// default, or "map(string)" for the Terraform type constraint syntax.
+	TypeSyntax string
+
+	// DebugTemplates outputs the template, or static file, which each
+	// generated page is rendered from, and which step of the template
+	// resolution order it was chosen by.
+	DebugTemplates bool
+
+	// StrictMetadata fails generation if the attribute defaults,
+	// validators, requires replace, deprecations, attribute types, or item
+	// metadata files contain items or attribute paths which do not exist in
+	// the provider schema, instead of ignoring them.
+	StrictMetadata bool
+
+	// FailFast stops generation at the first template which fails to render.
+	// By default, the other files are rendered, and the errors of all files
+	// which failed to render are returned.
+	FailFast bool
+
+	// Progress outputs the duration of each phase of the generation, such
+	// as exporting the provider schema and rendering pages, and the number
+	// of files rendered so far, for large providers.
+	Progress bool
+
+	// Stats outputs a summary of the number of pages rendered, unchanged,
+	// and split, and of the duration of each phase, at the end of the
+	// generation.
+	Stats bool
+
+	// Locales are the locales, such as "ja", which translated documentation
+	// is generated for in addition to the default documentation. Refer to
+	// the README for the locale templates, examples, and output directories.
+	Locales []string
+
+	// Parallel is the number of files rendered concurrently, at least 1.
+	Parallel int
+
+	// InlineNestedDepth is the number of nesting levels of nested schemas
+	// rendered inline under their parent.
+	InlineNestedDepth int
+
+	// MaxNestedDepth is the number of nesting levels of nested schemas
+	// rendered. Deeper nested schemas are replaced by a note with a summary
+	// of their type, for pathological schemas, such as deeply nested or
+	// recursive object types. The default of 0 renders all nested schemas.
+	MaxNestedDepth int
+
+	// InlineObjectMaxAttributes is the number of attributes of object
+	// attribute types up to which their attributes are listed after the
+	// description of the attribute, instead of in a nested schema section.
+	// Objects with attributes of object types are always rendered in nested
+	// schema sections. The default of 0 renders all objects in sections.
+	InlineObjectMaxAttributes int
+
+	// ProvidersSchemaJSON is the providers schema, in the JSON format of the
+	// terraform providers schema -json command, which is used instead of
+	// exporting the provider schema. It cannot be used with
+	// ProvidersSchemaPath or RegistryProvider.
+	ProvidersSchemaJSON []byte
+
+	// TemplatesFS is the file system which templates are read from instead
+	// of the TemplatesDir. Locale templates are still read from the
+	// TemplatesDir.
+	TemplatesFS fs.FS
+
+	// TemplateFuncs are additional template functions. They take precedence
+	// over Sprig functions, but not over the built-in functions. The render
+	// cache only detects which functions are set, not changes to them.
+	TemplateFuncs template.FuncMap
+
+	// TemplateFuncsPlugin is the path, or name in PATH, of an external
+	// binary which provides additional template functions. Refer to the
+	// README for its protocol. TemplateFuncs take precedence over its
+	// functions.
+	TemplateFuncsPlugin string
+
+	// PostProcessors rewrite the content of each rendered page, in order,
+	// before it is written. The render cache does not detect changes to
+	// them.
+	PostProcessors []PostProcessor
+
+	// PostProcessCmd is a command, a program and its arguments separated by
+	// spaces, which rewrites the content of each rendered page after the
+	// PostProcessors, with the path of the page as an additional argument,
+	// the content on stdin, and the rewritten content on stdout.
+	PostProcessCmd string
+
+	// PreGenerateCmd is a command, a program and its arguments separated by
+	// spaces, which is run in the provider directory before the provider
+	// schema is read, such as to regenerate examples. Refer to the README
+	// for the environment variables which describe the generation.
+	PreGenerateCmd string
+
+	// PostGenerateCmd is a command, as with PreGenerateCmd, which is run
+	// after the website is written, such as to run formatters. It is not run
+	// when checking or in a dry run.
+	PostGenerateCmd string
+}
+
+// DefaultGenerateOptions returns the options of the generate command without
+// flags, which are the defaults of its flags and of the tfplugindocs library.
+func DefaultGenerateOptions() GenerateOptions {
+	return GenerateOptions{
+		RenderedWebsiteDir: "docs",
+		ExamplesDir:        "examples",
+		TemplatesDir:       "templates",
+		Parallel:           1,
+		SchemaStyle:        "default",
+		AttributeSort:      "alphabetical",
+		TypeSyntax:         "default",
+		ExampleSyntax:      "off",
+		OutputExtension:    ".md",
+		OutputFormat:       "markdown",
+		HTMLDir:            "docs-html",
+		FrontmatterDialect: "registry",
+		NavFormat:          "json",
+	}
+}
+
+// Generate generates the website with the options, until it is interrupted,
+// such as with Ctrl-C.
+func Generate(ui cli.Ui, opts GenerateOptions) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	return GenerateContext(ctx, ui, opts)
+}
+
+// GenerateContext is Generate with a context. When the context is done, such
+// as when it is cancelled, or its deadline expires, exporting the provider
+// schema, such as building the provider and running Terraform, is stopped, no
+// more files are rendered, and the context error is returned.
+func GenerateContext(ctx context.Context, ui cli.Ui, opts GenerateOptions) error {
+	g, err := newGenerator(ctx, ui, opts)
+	if err != nil {
+		return err
+	}
+
+	err = g.Generate(ctx)
+	if err != nil {
+		return err
+	}
+
+	if g.showStats {
+		ui.Output(g.stats.String())
+	}
+
+	return nil
+}
+
+// newGenerator validates the options and returns the generator of the
+// website. The functions of the template functions plugin are listed with the
+// context.
+func newGenerator(ctx context.Context, ui cli.Ui, opts GenerateOptions) (*generator, error) {
+	providerDir := opts.ProviderDir
+
 	// Ensure provider directory is resolved absolute path
 	if providerDir == "" {
 		wd, err := os.Getwd()
 
 		if err != nil {
-			return fmt.Errorf("error getting working directory: %w", err)
+			return nil, fmt.Errorf("error getting working directory: %w", err)
 		}
 
 		providerDir = wd
@@ -118,7 +968,7 @@ func Generate(ui cli.Ui, providerDir, providerName, providersSchemaPath, rendere
 		absProviderDir, err := filepath.Abs(providerDir)
 
 		if err != nil {
-			return fmt.Errorf("error getting absolute path with provider directory %q: %w", providerDir, err)
+			return nil, fmt.Errorf("error getting absolute path with provider directory %q: %w", providerDir, err)
 		}
 
 		providerDir = absProviderDir
@@ -128,47 +978,323 @@ func Generate(ui cli.Ui, providerDir, providerName, providersSchemaPath, rendere
 	providerDirFileInfo, err := os.Stat(providerDir)
 
 	if err != nil {
-		return fmt.Errorf("error getting information for provider directory %q: %w", providerDir, err)
+		return nil, fmt.Errorf("error getting information for provider directory %q: %w", providerDir, err)
 	}
 
 	if !providerDirFileInfo.IsDir() {
-		return fmt.Errorf("expected %q to be a directory", providerDir)
+		return nil, fmt.Errorf("expected %q to be a directory", providerDir)
+	}
+
+	if opts.Parallel < 1 {
+		return nil, fmt.Errorf("expected parallel to be at least 1, got %d", opts.Parallel)
+	}
+
+	err = validateSchemaOptions(opts.SchemaStyle, opts.InlineNestedDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	err = schemamd.ValidateMaxNestedDepth(opts.MaxNestedDepth)
+	if err != nil {
+		return nil, err
+	}
+
+	err = schemamd.ValidateInlineObjectMaxAttributes(opts.InlineObjectMaxAttributes)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ExampleSyntax != "" && !slices.Contains(RuleSeverities, opts.ExampleSyntax) {
+		return nil, fmt.Errorf("unsupported example syntax severity %q, expected one of: %s", opts.ExampleSyntax, strings.Join(RuleSeverities, ", "))
+	}
+
+	if opts.FrontmatterDescriptionMaxLength < 0 {
+		return nil, fmt.Errorf("expected frontmatter description max length to be at least 0, got %d", opts.FrontmatterDescriptionMaxLength)
+	}
+
+	if opts.SplitPageSize < 0 {
+		return nil, fmt.Errorf("expected split page size to be at least 0, got %d", opts.SplitPageSize)
+	}
+
+	headings, err := parseHeadings(opts.Headings)
+	if err != nil {
+		return nil, err
+	}
+
+	exampleValues, err := parseExampleValues(opts.ExampleValues)
+	if err != nil {
+		return nil, err
+	}
+
+	buildTimestamp, buildTimestampFixed, err := parseBuildTimestamp(opts.BuildTimestamp, opts.Reproducible)
+	if err != nil {
+		return nil, err
+	}
+
+	err = schemamd.ValidateGroupOrder(opts.SchemaGroupOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.DeprecatedSubcategory != "" && opts.IgnoreDeprecated {
+		return nil, fmt.Errorf("deprecated subcategory cannot be used with ignore deprecated, as deprecated items are not generated")
+	}
+
+	if opts.AttributeSort != "" && !slices.Contains(schemamd.AttributeSorts, opts.AttributeSort) {
+		return nil, fmt.Errorf("unsupported attribute sort %q, expected one of: %s", opts.AttributeSort, strings.Join(schemamd.AttributeSorts, ", "))
+	}
+
+	err = schemamd.ValidateAttributeOrder(opts.AttributeOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.TypeSyntax != "" && !slices.Contains(schemamd.TypeSyntaxes, opts.TypeSyntax) {
+		return nil, fmt.Errorf("unsupported type syntax %q, expected one of: %s", opts.TypeSyntax, strings.Join(schemamd.TypeSyntaxes, ", "))
+	}
+
+	err = validateOutputExtension(opts.OutputExtension)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateFrontmatterDialect(opts.FrontmatterDialect)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateOutputFormat(opts.OutputFormat)
+	if err != nil {
+		return nil, err
+	}
+
+	providerVersion, err := parseProviderVersion(opts.ProviderVersion)
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateTerraformCLIOptions(terraformCLIOptions{
+		binary:      opts.TFBinary,
+		useOpenTofu: opts.UseOpenTofu,
+		version:     opts.TFVersion,
+		installDir:  opts.TFInstallDir,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateOfflineProvidersSchema(opts.Offline, opts.ProvidersSchemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	registryVersion := strings.TrimPrefix(opts.RegistryVersion, "v")
+
+	err = validateRegistryOptions(opts.RegistryProvider, registryVersion, opts.ProvidersSchemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.ProvidersSchemaJSON != nil && (opts.ProvidersSchemaPath != "" || opts.RegistryProvider != "") {
+		return nil, fmt.Errorf("providers schema JSON cannot be used with a providers schema path or a registry provider")
+	}
+
+	templateFuncs := opts.TemplateFuncs
+
+	postProcessors := opts.PostProcessors
+	if opts.PostProcessCmd != "" {
+		postProcessor, err := postProcessCommand(ctx, opts.PostProcessCmd)
+		if err != nil {
+			return nil, err
+		}
+
+		postProcessors = append(append([]PostProcessor{}, opts.PostProcessors...), postProcessor)
+	}
+
+	var funcsPlugin *templateFuncsPlugin
+	if opts.TemplateFuncsPlugin != "" {
+		funcsPlugin, err = newTemplateFuncsPlugin(opts.TemplateFuncsPlugin)
+		if err != nil {
+			return nil, err
+		}
+
+		pluginFuncs, err := funcsPlugin.funcs(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for name, fn := range opts.TemplateFuncs {
+			pluginFuncs[name] = fn
+		}
+
+		templateFuncs = pluginFuncs
+	}
+
+	if len(opts.PluginDirs) > 0 && opts.RegistryProvider == "" {
+		return nil, fmt.Errorf("plugin dirs require a registry provider")
+	}
+
+	pluginDirs := make([]string, 0, len(opts.PluginDirs))
+	for _, dir := range opts.PluginDirs {
+		absDir, err := filepath.Abs(dir)
+		if err != nil {
+			return nil, fmt.Errorf("error getting absolute path of plugin dir %q: %w", dir, err)
+		}
+
+		pluginDirs = append(pluginDirs, absDir)
+	}
+
+	if opts.EmitNav != "" {
+		err = validateNavFormat(opts.NavFormat)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	ignoreFilter, err := loadIgnoreFilter(providerDir, opts.Ignore)
+	if err != nil {
+		return nil, err
+	}
+
+	subcategories, err := loadSubcategoryMapping(providerDir)
+	if err != nil {
+		return nil, err
+	}
+
+	onlyFilter, err := newItemFilter(opts.Only)
+	if err != nil {
+		return nil, fmt.Errorf("invalid only patterns: %w", err)
+	}
+
+	if onlyFilter != nil && opts.Check {
+		return nil, fmt.Errorf("only patterns cannot be used with check, as the rendered website directory is only partially generated")
+	}
+
+	if onlyFilter != nil && opts.DryRun {
+		return nil, fmt.Errorf("only patterns cannot be used with dry run, as the rendered website directory is only partially generated")
+	}
+
+	if opts.Check && opts.DryRun {
+		return nil, fmt.Errorf("check and dry run cannot be used together")
+	}
+
+	err = validateLocales(opts.Locales)
+	if err != nil {
+		return nil, err
 	}
 
 	g := &generator{
-		ignoreDeprecated: ignoreDeprecated,
-		tfVersion:        tfVersion,
+		ignoreDeprecated:       opts.IgnoreDeprecated,
+		failOnEmptyDescription: opts.FailOnEmptyDescription,
+		validateExamples:       opts.ValidateExamples,
+		check:                  opts.Check,
+		dryRun:                 opts.DryRun,
+		parallel:               opts.Parallel,
+		tfVersion:              opts.TFVersion,
+		tfBinary:               opts.TFBinary,
+		useOpenTofu:            opts.UseOpenTofu,
+		tfInstallDir:           opts.TFInstallDir,
+		offline:                opts.Offline,
+		pluginDirs:             pluginDirs,
+
+		schemaStyle:                opts.SchemaStyle,
+		inlineNestedDepth:          opts.InlineNestedDepth,
+		maxNestedDepth:             opts.MaxNestedDepth,
+		inlineObjectMaxAttributes:  opts.InlineObjectMaxAttributes,
+		stripExampleHeaders:        opts.StripExampleHeaders,
+		formatExamples:             opts.FormatExamples,
+		exampleSyntax:              opts.ExampleSyntax,
+		generateExamples:           opts.GenerateExamples,
+		exampleValues:              exampleValues,
+		headings:                   headings,
+		schemaGroupOrder:           opts.SchemaGroupOrder,
+		attributeAnchors:           opts.AttributeAnchors,
+		collapsibleNestedSchemas:   opts.CollapsibleNestedSchemas,
+		writeOnlySection:           opts.WriteOnlySection,
+		timeoutsSection:            opts.TimeoutsSection,
+		sensitiveAttributesSection: opts.SensitiveAttributesSection,
+		linkItemMentions:           opts.LinkItemMentions,
+		attributeSort:              opts.AttributeSort,
+		typeSyntax:                 opts.TypeSyntax,
+		attributeOrder:             opts.AttributeOrder,
+		debugTemplates:             opts.DebugTemplates,
+		strictMetadata:             opts.StrictMetadata,
+		failFast:                   opts.FailFast,
+		progress:                   opts.Progress,
+		showStats:                  opts.Stats,
+		stats:                      newGenerateStats(),
+		locales:                    opts.Locales,
+		outputExtension:            opts.OutputExtension,
+		frontmatterDialect:         opts.FrontmatterDialect,
+
+		frontmatterDescriptionMaxLength:     opts.FrontmatterDescriptionMaxLength,
+		frontmatterDescriptionFirstSentence: opts.FrontmatterDescriptionFirstSentence,
+		frontmatterDescriptionOmitLinkURLs:  opts.FrontmatterDescriptionOmitLinkURLs,
+		splitPageSize:                       opts.SplitPageSize,
+		outputFormat:                        opts.OutputFormat,
+		htmlDir:                             opts.HTMLDir,
+		emitJSONModel:                       opts.EmitJSONModel,
+		emitNav:                             opts.EmitNav,
+		navFormat:                           opts.NavFormat,
+		emitSinglePage:                      opts.EmitSinglePage,
+		cacheFile:                           opts.CacheFile,
+		attributeDefaultsFile:               opts.AttributeDefaultsFile,
+		attributeValidatorsFile:             opts.AttributeValidatorsFile,
+		requiresReplaceFile:                 opts.RequiresReplaceFile,
+		deprecationsFile:                    opts.DeprecationsFile,
+		itemMetadataFile:                    opts.ItemMetadataFile,
+		attributeTypesFile:                  opts.AttributeTypesFile,
+		deprecationsGuide:                   opts.DeprecationsGuide,
+		deprecatedSubcategory:               opts.DeprecatedSubcategory,
+		templateEnv:                         opts.TemplateEnv,
+		buildTimestamp:                      buildTimestamp,
+		buildTimestampFixed:                 buildTimestampFixed,
+		ignore:                              ignoreFilter,
+		only:                                onlyFilter,
+		subcategories:                       subcategories,
 
 		providerDir:          providerDir,
-		providerName:         providerName,
-		providersSchemaPath:  providersSchemaPath,
-		renderedProviderName: renderedProviderName,
-		renderedWebsiteDir:   renderedWebsiteDir,
-		examplesDir:          examplesDir,
-		templatesDir:         templatesDir,
-		websiteTmpDir:        websiteTmpDir,
+		providerName:         opts.ProviderName,
+		providersSchemaPath:  opts.ProvidersSchemaPath,
+		renderedProviderName: opts.RenderedProviderName,
+		renderedWebsiteDir:   opts.RenderedWebsiteDir,
+		examplesDir:          opts.ExamplesDir,
+		templatesDir:         opts.TemplatesDir,
+		websiteTmpDir:        opts.WebsiteTmpDir,
+		providerVersion:      providerVersion,
+		providerSource:       opts.ProviderSource,
+		registryProvider:     opts.RegistryProvider,
+		registryVersion:      registryVersion,
+		providersSchemaJSON:  opts.ProvidersSchemaJSON,
+		templatesFS:          opts.TemplatesFS,
+		templateFuncs:        templateFuncs,
+		templateFuncsPlugin:  funcsPlugin,
+		postProcessors:       postProcessors,
+		postProcessCmd:       opts.PostProcessCmd,
+		preGenerateCmd:       opts.PreGenerateCmd,
+		postGenerateCmd:      opts.PostGenerateCmd,
 
 		ui: ui,
 	}
 
-	ctx := context.Background()
-
-	return g.Generate(ctx)
+	return g, nil
 }
 
 func (g *generator) Generate(ctx context.Context) error {
-	var err error
-
-	if g.providerName == "" {
-		g.providerName = filepath.Base(g.providerDir)
-	}
-
-	if g.renderedProviderName == "" {
-		g.renderedProviderName = g.providerName
+	err := g.setProviderDefaults()
+	if err != nil {
+		return err
 	}
 
 	g.infof("rendering website for provider %q (as %q)", g.providerName, g.renderedProviderName)
 
+	if g.preGenerateCmd != "" {
+		err = g.runHook(ctx, "pre-generate", g.preGenerateCmd, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	switch {
 	case g.websiteTmpDir == "":
 		g.websiteTmpDir, err = os.MkdirTemp("", "tfws")
@@ -190,131 +1316,434 @@ func (g *generator) Generate(ctx context.Context) error {
 		}
 	}
 
-	templatesDirInfo, err := os.Stat(g.ProviderTemplatesDir())
-	switch {
-	case os.IsNotExist(err):
-		// do nothing, no template dir
-	case err != nil:
-		return fmt.Errorf("error getting information for provider templates directory %q: %w", g.ProviderTemplatesDir(), err)
-	default:
-		if !templatesDirInfo.IsDir() {
-			return fmt.Errorf("template path is not a directory: %s", g.ProviderTemplatesDir())
-		}
+	err = g.copyTemplates()
+	if err != nil {
+		return err
+	}
 
-		g.infof("copying any existing content to tmp dir")
-		err = cp(g.ProviderTemplatesDir(), g.TempTemplatesDir())
-		if err != nil {
-			return fmt.Errorf("error copying exiting content to temporary directory %q: %w", g.TempTemplatesDir(), err)
-		}
+	endPhase := g.phase("schema")
+	providerSchema, err := g.providerSchema(ctx)
+	if err != nil {
+		return err
 	}
+	endPhase()
 
-	var providerSchema *tfjson.ProviderSchema
+	err = g.loadMetadataFiles(providerSchema)
+	if err != nil {
+		return err
+	}
 
-	if g.providersSchemaPath == "" {
-		g.infof("exporting schema from Terraform")
-		providerSchema, err = g.terraformProviderSchemaFromTerraform(ctx)
+	if g.failOnEmptyDescription {
+		g.infof("checking schema descriptions")
+		err = g.checkDescriptions(providerSchema)
 		if err != nil {
-			return fmt.Errorf("error exporting provider schema from Terraform: %w", err)
+			return fmt.Errorf("error checking schema descriptions: %w", err)
 		}
-	} else {
-		g.infof("exporting schema from JSON file")
-		providerSchema, err = g.terraformProviderSchemaFromFile()
+	}
+
+	if g.validateExamples {
+		g.infof("validating examples against the provider schema")
+		err = g.checkExamples(providerSchema)
 		if err != nil {
-			return fmt.Errorf("error exporting provider schema from JSON file: %w", err)
+			return fmt.Errorf("error validating examples: %w", err)
 		}
 	}
 
+	endPhase = g.phase("templates")
 	g.infof("generating missing templates")
 	err = g.generateMissingTemplates(providerSchema)
 	if err != nil {
 		return fmt.Errorf("error generating missing templates: %w", err)
 	}
+	endPhase()
 
-	g.infof("rendering static website")
-	err = g.renderStaticWebsite(providerSchema)
+	g.customRegions, err = loadCustomRegions(g.ProviderDocsDir())
 	if err != nil {
-		return fmt.Errorf("error rendering static website: %w", err)
+		return fmt.Errorf("error loading custom regions: %w", err)
 	}
 
-	return nil
-}
-
-// ProviderDocsDir returns the absolute path to the joined provider and
-// given website documentation directory, which defaults to "docs".
-func (g *generator) ProviderDocsDir() string {
-	return filepath.Join(g.providerDir, g.renderedWebsiteDir)
-}
-
-// ProviderExamplesDir returns the absolute path to the joined provider and
-// given examples directory, which defaults to "examples".
-func (g *generator) ProviderExamplesDir() string {
-	return filepath.Join(g.providerDir, g.examplesDir)
-}
+	endPhase = g.phase("render")
 
-// ProviderTemplatesDir returns the absolute path to the joined provider and
-// given templates directory, which defaults to "templates".
-func (g *generator) ProviderTemplatesDir() string {
-	return filepath.Join(g.providerDir, g.templatesDir)
-}
+	if g.check {
+		g.infof("checking static website")
+		err = g.checkStaticWebsite(ctx, providerSchema)
+		if err != nil {
+			return fmt.Errorf("error checking static website: %w", err)
+		}
 
-// TempTemplatesDir returns the absolute path to the joined temporary and
-// hardcoded "templates" subdirectory, which is where provider templates are
-// copied.
-func (g *generator) TempTemplatesDir() string {
-	return filepath.Join(g.websiteTmpDir, "templates")
-}
+		err = g.generateLocales(ctx, providerSchema)
+		if err != nil {
+			return err
+		}
 
-func (g *generator) generateMissingResourceTemplate(resourceName string) error {
-	templatePath := fmt.Sprintf(websiteResourceFile, resourceShortName(resourceName, g.providerName))
-	templatePath = filepath.Join(g.TempTemplatesDir(), templatePath)
-	if fileExists(templatePath) {
-		g.infof("resource %q template exists, skipping", resourceName)
+		endPhase()
 		return nil
 	}
 
-	fallbackTemplatePath := filepath.Join(g.TempTemplatesDir(), websiteResourceFallbackFile)
-	if fileExists(fallbackTemplatePath) {
-		g.infof("resource %q fallback template exists, creating template", resourceName)
-		err := cp(fallbackTemplatePath, templatePath)
+	if g.dryRun {
+		g.infof("dry run of static website")
+		err = g.dryRunStaticWebsite(ctx, providerSchema)
 		if err != nil {
-			return fmt.Errorf("unable to copy fallback template for %q: %w", resourceName, err)
+			return fmt.Errorf("error in dry run of static website: %w", err)
+		}
+
+		err = g.generateLocales(ctx, providerSchema)
+		if err != nil {
+			return err
 		}
+
+		endPhase()
 		return nil
 	}
 
-	for _, candidate := range websiteResourceFileStaticCandidates {
-		candidatePath := fmt.Sprintf(candidate, resourceShortName(resourceName, g.providerName))
-		candidatePath = filepath.Join(g.TempTemplatesDir(), candidatePath)
-		if fileExists(candidatePath) {
-			g.infof("resource %q static file exists, skipping", resourceName)
-			return nil
+	if g.cacheFile != "" {
+		g.cache, err = loadRenderCache(g.CacheFilePath(), g.providerDir)
+		if err != nil {
+			return fmt.Errorf("error loading render cache: %w", err)
 		}
 	}
 
-	g.infof("generating new template for %q", resourceName)
-	err := writeFile(templatePath, string(defaultResourceTemplate))
+	g.infof("rendering static website")
+	err = g.renderStaticWebsite(ctx, providerSchema)
 	if err != nil {
-		return fmt.Errorf("unable to write template for %q: %w", resourceName, err)
+		return fmt.Errorf("error rendering static website: %w", err)
 	}
 
-	return nil
-}
+	err = g.generateLocales(ctx, providerSchema)
+	if err != nil {
+		return err
+	}
+	endPhase()
 
-func (g *generator) generateMissingDataSourceTemplate(datasourceName string) error {
-	templatePath := fmt.Sprintf(websiteDataSourceFile, resourceShortName(datasourceName, g.providerName))
-	templatePath = filepath.Join(g.TempTemplatesDir(), templatePath)
-	if fileExists(templatePath) {
-		g.infof("data-source %q template exists, skipping", datasourceName)
-		return nil
+	// nothing else is written once the context is done
+	if err := ctx.Err(); err != nil {
+		return err
 	}
 
-	fallbackTemplatePath := filepath.Join(g.TempTemplatesDir(), websiteDataSourceFallbackFile)
+	endPhase = g.phase("write")
+
+	if g.cache != nil {
+		err = g.cache.Save(g.only != nil)
+		if err != nil {
+			return fmt.Errorf("error writing render cache: %w", err)
+		}
+	}
+
+	if g.emitJSONModel != "" {
+		err = g.writeJSONModel(providerSchema)
+		if err != nil {
+			return fmt.Errorf("error writing JSON docs model: %w", err)
+		}
+	}
+
+	if g.emitNav != "" {
+		err = g.writeNav(providerSchema)
+		if err != nil {
+			return fmt.Errorf("error writing navigation: %w", err)
+		}
+	}
+
+	if g.emitSinglePage != "" {
+		err = g.writeSinglePage(providerSchema)
+		if err != nil {
+			return fmt.Errorf("error writing single page documentation: %w", err)
+		}
+	}
+
+	if g.outputFormat == OutputFormatHTML {
+		err = g.writeHTMLSite()
+		if err != nil {
+			return fmt.Errorf("error writing static HTML site: %w", err)
+		}
+	}
+	endPhase()
+
+	if g.postGenerateCmd != "" {
+		err = g.runHook(ctx, "post-generate", g.postGenerateCmd, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// copyTemplates copies the contents of the provider templates directory, if
+// it exists, into the temporary templates directory.
+
+// setProviderDefaults sets the provider name, rendered provider name, and
+// provider source which were not set, and validates the provider source.
+func (g *generator) setProviderDefaults() error {
+	if g.registryProvider != "" {
+		if g.providerName == "" {
+			g.providerName = "terraform-provider-" + g.registryProvider[strings.LastIndex(g.registryProvider, "/")+1:]
+		}
+
+		if g.providerSource == "" {
+			g.providerSource = g.registryProvider
+		}
+
+		if g.providerVersion == "" {
+			g.providerVersion = g.registryVersion
+		}
+	}
+
+	if g.providerName == "" {
+		g.providerName = filepath.Base(g.providerDir)
+	}
+
+	if g.renderedProviderName == "" {
+		g.renderedProviderName = g.providerName
+	}
+
+	if g.providerSource == "" {
+		g.providerSource = "hashicorp/" + providerShortName(g.providerName)
+	}
+
+	return validateProviderSource(g.providerSource)
+}
+
+// loadMetadataFiles loads the attribute defaults, validators, requires
+// replace, deprecations, attribute types, and item metadata files, and the
+// deprecated items of the deprecated subcategory.
+func (g *generator) loadMetadataFiles(providerSchema *tfjson.ProviderSchema) error {
+	var err error
+
+	if g.attributeDefaultsFile != "" {
+		g.infof("loading attribute defaults file %q", g.attributeDefaultsFile)
+		if g.attributeDefaults == nil {
+			g.attributeDefaults = make(attributeDefaults)
+		}
+
+		err = loadAttributeDefaults(g.metadataFilePath(g.attributeDefaultsFile), g.attributeDefaults)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.attributeValidatorsFile != "" {
+		g.infof("loading attribute validators file %q", g.attributeValidatorsFile)
+		if g.attributeValidators == nil {
+			g.attributeValidators = make(attributeValidators)
+		}
+
+		err = loadAttributeValidators(g.metadataFilePath(g.attributeValidatorsFile), g.attributeValidators)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.requiresReplaceFile != "" {
+		g.infof("loading requires replace file %q", g.requiresReplaceFile)
+		if g.attributeReplacements == nil {
+			g.attributeReplacements = make(attributeReplacements)
+		}
+
+		err = loadAttributeReplacements(g.metadataFilePath(g.requiresReplaceFile), g.attributeReplacements)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.deprecationsFile != "" {
+		g.infof("loading deprecations file %q", g.deprecationsFile)
+		g.deprecations = make(deprecations)
+
+		err = loadDeprecations(g.metadataFilePath(g.deprecationsFile), g.deprecations)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.attributeTypesFile != "" {
+		g.infof("loading attribute types file %q", g.attributeTypesFile)
+		g.attributeTypeNames = make(attributeTypeNames)
+
+		err = loadAttributeTypeNames(g.metadataFilePath(g.attributeTypesFile), g.attributeTypeNames)
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.itemMetadataFile != "" {
+		g.infof("loading item metadata file %q", g.itemMetadataFile)
+
+		g.itemMetadata, err = loadItemMetadata(g.metadataFilePath(g.itemMetadataFile))
+		if err != nil {
+			return err
+		}
+	}
+
+	if g.strictMetadata {
+		g.infof("checking metadata files")
+		err = g.checkMetadataFiles(providerSchema)
+		if err != nil {
+			return fmt.Errorf("error checking metadata files: %w", err)
+		}
+	}
+
+	if g.deprecatedSubcategory != "" {
+		g.deprecatedItems = g.findDeprecatedItems(providerSchema)
+	}
+
+	return nil
+}
+func (g *generator) copyTemplates() error {
+	if g.templatesFS != nil {
+		g.infof("copying templates file system to tmp dir")
+		err := copyFS(g.templatesFS, g.TempTemplatesDir())
+		if err != nil {
+			return fmt.Errorf("error copying templates file system to temporary directory %q: %w", g.TempTemplatesDir(), err)
+		}
+
+		return nil
+	}
+
+	templatesDirInfo, err := os.Stat(g.ProviderTemplatesDir())
+	switch {
+	case os.IsNotExist(err):
+		// do nothing, no template dir
+	case err != nil:
+		return fmt.Errorf("error getting information for provider templates directory %q: %w", g.ProviderTemplatesDir(), err)
+	default:
+		if !templatesDirInfo.IsDir() {
+			return fmt.Errorf("template path is not a directory: %s", g.ProviderTemplatesDir())
+		}
+
+		g.infof("copying any existing content to tmp dir")
+		err = cp(g.ProviderTemplatesDir(), g.TempTemplatesDir())
+		if err != nil {
+			return fmt.Errorf("error copying exiting content to temporary directory %q: %w", g.TempTemplatesDir(), err)
+		}
+
+		// Locale templates are only rendered when generating their locale.
+		for _, locale := range g.locales {
+			err = os.RemoveAll(filepath.Join(g.TempTemplatesDir(), locale))
+			if err != nil {
+				return fmt.Errorf("error removing locale %q templates from temporary directory %q: %w", locale, g.TempTemplatesDir(), err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// providerSchema exports the provider schema, either from the providers
+// schema JSON file, by installing the registry provider with Terraform, or by
+// building the provider and running Terraform.
+func (g *generator) providerSchema(ctx context.Context) (*tfjson.ProviderSchema, error) {
+	if g.registryProvider != "" {
+		g.infof("exporting schema of registry provider %q", g.registryProvider)
+		providerSchema, err := g.terraformProviderSchemaFromRegistry(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error exporting provider schema from registry: %w", err)
+		}
+
+		return providerSchema, nil
+	}
+
+	if g.providersSchemaPath == "" && g.providersSchemaJSON == nil {
+		g.infof("exporting schema from Terraform")
+		providerSchema, err := g.terraformProviderSchemaFromTerraform(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error exporting provider schema from Terraform: %w", err)
+		}
+
+		return providerSchema, nil
+	}
+
+	g.infof("exporting schema from JSON file")
+	providerSchema, err := g.terraformProviderSchemaFromFile(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error exporting provider schema from JSON file: %w", err)
+	}
+
+	return providerSchema, nil
+}
+
+// ProviderDocsDir returns the absolute path to the joined provider and
+// given website documentation directory, which defaults to "docs".
+func (g *generator) ProviderDocsDir() string {
+	return filepath.Join(g.providerDir, g.renderedWebsiteDir)
+}
+
+// ProviderExamplesDir returns the absolute path to the joined provider and
+// given examples directory, which defaults to "examples".
+func (g *generator) ProviderExamplesDir() string {
+	return filepath.Join(g.providerDir, g.examplesDir)
+}
+
+// exampleFilePath returns the absolute path of the example file with the
+// given path elements in the examples directory. When generating a locale,
+// the file of the locale subdirectory of the examples directory is preferred
+// if it exists.
+func (g *generator) exampleFilePath(elem ...string) string {
+	if g.locale != "" {
+		localePath := filepath.Join(append([]string{g.ProviderExamplesDir(), g.locale}, elem...)...)
+		if fileExists(localePath) {
+			return localePath
+		}
+	}
+
+	return filepath.Join(append([]string{g.ProviderExamplesDir()}, elem...)...)
+}
+
+// ProviderTemplatesDir returns the absolute path to the joined provider and
+// given templates directory, which defaults to "templates".
+func (g *generator) ProviderTemplatesDir() string {
+	return filepath.Join(g.providerDir, g.templatesDir)
+}
+
+// TempTemplatesDir returns the absolute path to the joined temporary and
+// hardcoded "templates" subdirectory, which is where provider templates are
+// copied.
+func (g *generator) TempTemplatesDir() string {
+	return filepath.Join(g.websiteTmpDir, "templates")
+}
+
+func (g *generator) generateMissingResourceTemplate(resourceName string) error {
+	templatePath := fmt.Sprintf(websiteResourceFile, resourceShortName(resourceName, g.providerName))
+	templatePath = filepath.Join(g.TempTemplatesDir(), templatePath)
+	if fileExists(templatePath) {
+		g.infof("resource %q template exists, skipping", resourceName)
+		return nil
+	}
+
+	for _, candidate := range websiteResourceFileStaticCandidates {
+		candidatePath := fmt.Sprintf(candidate, resourceShortName(resourceName, g.providerName))
+		candidatePath = filepath.Join(g.TempTemplatesDir(), candidatePath)
+		if fileExists(candidatePath) {
+			g.infof("resource %q static file exists, skipping", resourceName)
+			return nil
+		}
+	}
+
+	fallbackTemplatePath := filepath.Join(g.TempTemplatesDir(), websiteResourceFallbackFile)
 	if fileExists(fallbackTemplatePath) {
-		g.infof("data-source %q fallback template exists, creating template", datasourceName)
+		g.infof("resource %q fallback template exists, creating template", resourceName)
 		err := cp(fallbackTemplatePath, templatePath)
 		if err != nil {
-			return fmt.Errorf("unable to copy fallback template for %q: %w", datasourceName, err)
+			return fmt.Errorf("unable to copy fallback template for %q: %w", resourceName, err)
 		}
+		g.setTypeDefaultTemplateSource(templatePath, websiteResourceFallbackFile)
+		return nil
+	}
+
+	g.infof("generating new template for %q", resourceName)
+	err := writeFile(templatePath, string(defaultResourceTemplate))
+	if err != nil {
+		return fmt.Errorf("unable to write template for %q: %w", resourceName, err)
+	}
+	g.setGlobalDefaultTemplateSource(templatePath, "resource")
+
+	return nil
+}
+
+func (g *generator) generateMissingDataSourceTemplate(datasourceName string) error {
+	templatePath := fmt.Sprintf(websiteDataSourceFile, resourceShortName(datasourceName, g.providerName))
+	templatePath = filepath.Join(g.TempTemplatesDir(), templatePath)
+	if fileExists(templatePath) {
+		g.infof("data-source %q template exists, skipping", datasourceName)
 		return nil
 	}
 
@@ -327,11 +1756,65 @@ func (g *generator) generateMissingDataSourceTemplate(datasourceName string) err
 		}
 	}
 
+	fallbackTemplatePath := filepath.Join(g.TempTemplatesDir(), websiteDataSourceFallbackFile)
+	if fileExists(fallbackTemplatePath) {
+		g.infof("data-source %q fallback template exists, creating template", datasourceName)
+		err := cp(fallbackTemplatePath, templatePath)
+		if err != nil {
+			return fmt.Errorf("unable to copy fallback template for %q: %w", datasourceName, err)
+		}
+		g.setTypeDefaultTemplateSource(templatePath, websiteDataSourceFallbackFile)
+		return nil
+	}
+
 	g.infof("generating new template for data-source %q", datasourceName)
 	err := writeFile(templatePath, string(defaultResourceTemplate))
 	if err != nil {
 		return fmt.Errorf("unable to write template for %q: %w", datasourceName, err)
 	}
+	g.setGlobalDefaultTemplateSource(templatePath, "data source")
+
+	return nil
+}
+
+// generateMissingItemTemplate generates the template of the named item of the
+// given type, unless a template or static file for the item exists. The
+// generic template of the type is used if it exists, otherwise the default
+// template. Refer to the template resolution order in the README.
+func (g *generator) generateMissingItemTemplate(t itemTemplateType, name string) error {
+	shortName := resourceShortName(name, g.providerName)
+
+	templatePath := filepath.Join(g.TempTemplatesDir(), t.dir, shortName+".md.tmpl")
+	if fileExists(templatePath) {
+		g.infof("%s %q template exists, skipping", t.name, name)
+		return nil
+	}
+
+	for _, ext := range itemStaticFileExtensions {
+		candidatePath := filepath.Join(g.TempTemplatesDir(), t.dir, shortName+ext)
+		if fileExists(candidatePath) {
+			g.infof("%s %q static file exists, skipping", t.name, name)
+			return nil
+		}
+	}
+
+	fallbackTemplatePath := filepath.Join(g.TempTemplatesDir(), t.dir+".md.tmpl")
+	if fileExists(fallbackTemplatePath) {
+		g.infof("%s %q fallback template exists, creating template", t.name, name)
+		err := cp(fallbackTemplatePath, templatePath)
+		if err != nil {
+			return fmt.Errorf("unable to copy fallback template for %q: %w", name, err)
+		}
+		g.setTypeDefaultTemplateSource(templatePath, t.dir+".md.tmpl")
+		return nil
+	}
+
+	g.infof("generating new template for %s %q", t.name, name)
+	err := writeFile(templatePath, string(defaultItemTemplate))
+	if err != nil {
+		return fmt.Errorf("unable to write template for %q: %w", name, err)
+	}
+	g.setGlobalDefaultTemplateSource(templatePath, t.name)
 
 	return nil
 }
@@ -344,268 +1827,1205 @@ func (g *generator) generateMissingFunctionTemplate(functionName string) error {
 		return nil
 	}
 
-	fallbackTemplatePath := filepath.Join(g.TempTemplatesDir(), websiteFunctionFallbackFile)
-	if fileExists(fallbackTemplatePath) {
-		g.infof("function %q fallback template exists, creating template", functionName)
-		err := cp(fallbackTemplatePath, templatePath)
+	for _, candidate := range websiteFunctionFileStaticCandidates {
+		candidatePath := fmt.Sprintf(candidate, resourceShortName(functionName, g.providerName))
+		candidatePath = filepath.Join(g.TempTemplatesDir(), candidatePath)
+		if fileExists(candidatePath) {
+			g.infof("function %q static file exists, skipping", functionName)
+			return nil
+		}
+	}
+
+	fallbackTemplatePath := filepath.Join(g.TempTemplatesDir(), websiteFunctionFallbackFile)
+	if fileExists(fallbackTemplatePath) {
+		g.infof("function %q fallback template exists, creating template", functionName)
+		err := cp(fallbackTemplatePath, templatePath)
+		if err != nil {
+			return fmt.Errorf("unable to copy fallback template for %q: %w", functionName, err)
+		}
+		g.setTypeDefaultTemplateSource(templatePath, websiteFunctionFallbackFile)
+		return nil
+	}
+
+	g.infof("generating new template for function %q", functionName)
+	err := writeFile(templatePath, string(defaultFunctionTemplate))
+	if err != nil {
+		return fmt.Errorf("unable to write template for %q: %w", functionName, err)
+	}
+	g.setGlobalDefaultTemplateSource(templatePath, "function")
+
+	return nil
+}
+
+func (g *generator) generateMissingProviderTemplate() error {
+	templatePath := filepath.Join(g.TempTemplatesDir(), websiteProviderFile)
+	if fileExists(templatePath) {
+		g.infof("provider %q template exists, skipping", g.providerName)
+		return nil
+	}
+
+	for _, candidate := range websiteProviderFileStaticCandidates {
+		candidatePath := filepath.Join(g.TempTemplatesDir(), candidate)
+		if fileExists(candidatePath) {
+			g.infof("provider %q static file exists, skipping", g.providerName)
+			return nil
+		}
+	}
+
+	g.infof("generating new template for %q", g.providerName)
+	err := writeFile(templatePath, string(defaultProviderTemplate))
+	if err != nil {
+		return fmt.Errorf("unable to write template for %q: %w", g.providerName, err)
+	}
+	g.setGlobalDefaultTemplateSource(templatePath, "provider")
+
+	return nil
+}
+
+func (g *generator) generateMissingDeprecationsGuideTemplate() error {
+	templatePath := filepath.Join(g.TempTemplatesDir(), websiteDeprecationsGuideFile)
+	if fileExists(templatePath) {
+		g.infof("deprecations guide template exists, skipping")
+		return nil
+	}
+
+	for _, candidate := range websiteDeprecationsGuideStaticCandidates {
+		candidatePath := filepath.Join(g.TempTemplatesDir(), candidate)
+		if fileExists(candidatePath) {
+			g.infof("deprecations guide static file exists, skipping")
+			return nil
+		}
+	}
+
+	g.infof("generating new template for deprecations guide")
+	err := writeFile(templatePath, string(defaultDeprecationsTemplate))
+	if err != nil {
+		return fmt.Errorf("unable to write template for deprecations guide: %w", err)
+	}
+	g.setGlobalDefaultTemplateSource(templatePath, "deprecations guide")
+
+	return nil
+}
+
+// setTypeDefaultTemplateSource records that the template at templatePath was
+// created from the type default template with the given file name, for
+// output with debugTemplates.
+func (g *generator) setTypeDefaultTemplateSource(templatePath, fallbackFile string) {
+	g.setTemplateSource(templatePath, fmt.Sprintf("%q (type default)", path.Join(filepath.ToSlash(g.templatesDir), fallbackFile)))
+}
+
+// setGlobalDefaultTemplateSource records that the template at templatePath
+// was created from the built-in default template of the given type, for
+// output with debugTemplates.
+func (g *generator) setGlobalDefaultTemplateSource(templatePath, typeName string) {
+	g.setTemplateSource(templatePath, fmt.Sprintf("built-in %s template (global default)", typeName))
+}
+
+func (g *generator) setTemplateSource(templatePath, source string) {
+	if !g.debugTemplates {
+		return
+	}
+
+	rel, err := filepath.Rel(g.TempTemplatesDir(), templatePath)
+	if err != nil {
+		return
+	}
+
+	if g.templateSources == nil {
+		g.templateSources = make(map[string]string)
+	}
+
+	g.templateSources[filepath.ToSlash(rel)] = source
+}
+
+// templateSource returns the source of the template, or static file, with
+// the given path relative to the temporary templates directory, which is
+// either the file of the same path in the templates directory (exact name),
+// or the recorded type default or built-in default template.
+func (g *generator) templateSource(rel string) string {
+	if source, ok := g.templateSources[filepath.ToSlash(rel)]; ok {
+		return source
+	}
+
+	return fmt.Sprintf("%q (exact name)", path.Join(filepath.ToSlash(g.templatesDir), filepath.ToSlash(rel)))
+}
+
+// skipItem returns true if templates are not generated or rendered for the
+// resource, data source, ephemeral resource, list resource, action, or
+// function, in the given rendered website subdirectory, as it is ignored or
+// does not match the only patterns.
+func (g *generator) skipItem(dir, name string) bool {
+	if g.ignore.Match(dir, name) {
+		return true
+	}
+
+	return g.only != nil && !g.only.Match(dir, name)
+}
+
+func (g *generator) generateMissingTemplates(providerSchema *tfjson.ProviderSchema) error {
+	g.infof("generating missing resource content")
+	for _, name := range sortedKeys(providerSchema.ResourceSchemas) {
+		schema := providerSchema.ResourceSchemas[name]
+		if g.ignoreDeprecated && schema.Block.Deprecated {
+			continue
+		}
+
+		if g.skipItem("resources", name) {
+			continue
+		}
+
+		err := g.generateMissingResourceTemplate(name)
+		if err != nil {
+			return fmt.Errorf("unable to generate template for resource %q: %w", name, err)
+		}
+	}
+
+	g.infof("generating missing data source content")
+	for _, name := range sortedKeys(providerSchema.DataSourceSchemas) {
+		schema := providerSchema.DataSourceSchemas[name]
+		if g.ignoreDeprecated && schema.Block.Deprecated {
+			continue
+		}
+
+		if g.skipItem("data-sources", name) {
+			continue
+		}
+
+		err := g.generateMissingDataSourceTemplate(name)
+		if err != nil {
+			return fmt.Errorf("unable to generate template for data-source %q: %w", name, err)
+		}
+	}
+
+	g.infof("generating missing ephemeral resource content")
+	for _, name := range sortedKeys(providerSchema.EphemeralResourceSchemas) {
+		schema := providerSchema.EphemeralResourceSchemas[name]
+		if g.ignoreDeprecated && schema.Block.Deprecated {
+			continue
+		}
+
+		if g.skipItem("ephemeral-resources", name) {
+			continue
+		}
+
+		err := g.generateMissingItemTemplate(ephemeralResourceTemplateType, name)
+		if err != nil {
+			return fmt.Errorf("unable to generate template for %s %q: %w", ephemeralResourceTemplateType.name, name, err)
+		}
+	}
+
+	g.infof("generating missing list resource content")
+	for _, name := range sortedKeys(providerSchema.ListResourceSchemas) {
+		schema := providerSchema.ListResourceSchemas[name]
+		if g.ignoreDeprecated && schema.Block.Deprecated {
+			continue
+		}
+
+		if g.skipItem("list-resources", name) {
+			continue
+		}
+
+		err := g.generateMissingItemTemplate(listResourceTemplateType, name)
+		if err != nil {
+			return fmt.Errorf("unable to generate template for %s %q: %w", listResourceTemplateType.name, name, err)
+		}
+	}
+
+	g.infof("generating missing action content")
+	for _, name := range sortedKeys(g.actionSchemas) {
+		schema := g.actionSchemas[name]
+		if g.ignoreDeprecated && schema.Block.Deprecated {
+			continue
+		}
+
+		if g.skipItem("actions", name) {
+			continue
+		}
+
+		err := g.generateMissingItemTemplate(actionTemplateType, name)
+		if err != nil {
+			return fmt.Errorf("unable to generate template for %s %q: %w", actionTemplateType.name, name, err)
+		}
+	}
+
+	g.infof("generating missing function content")
+	for _, name := range sortedKeys(providerSchema.Functions) {
+		signature := providerSchema.Functions[name]
+		if g.ignoreDeprecated && signature.DeprecationMessage != "" {
+			continue
+		}
+
+		if g.skipItem("functions", name) {
+			continue
+		}
+
+		err := g.generateMissingFunctionTemplate(name)
+		if err != nil {
+			return fmt.Errorf("unable to generate template for function %q: %w", name, err)
+		}
+	}
+
+	g.infof("generating missing provider content")
+	err := g.generateMissingProviderTemplate()
+	if err != nil {
+		return fmt.Errorf("unable to generate template for provider: %w", err)
+	}
+
+	if g.deprecationsGuide {
+		g.infof("generating missing deprecations guide content")
+		err = g.generateMissingDeprecationsGuideTemplate()
+		if err != nil {
+			return fmt.Errorf("unable to generate template for deprecations guide: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) renderStaticWebsite(ctx context.Context, providerSchema *tfjson.ProviderSchema) error {
+	if g.only != nil {
+		g.infof("skipping cleaning rendered website dir, only rendering matching items")
+	} else {
+		// Pages of the previous run are kept, as they are not rendered again
+		// if unchanged.
+		var keep map[string]bool
+		if g.cache != nil {
+			keep = g.cache.PreviousFiles()
+		}
+
+		err := g.cleanRenderedWebsite(keep)
+		if err != nil {
+			return err
+		}
+	}
+
+	g.infof("rendering templated website to static markdown")
+	err := g.renderWebsite(ctx, g.ProviderDocsDir(), providerSchema)
+	if err != nil {
+		return fmt.Errorf("unable to render templated website to static markdown: %w", err)
+	}
+
+	if g.cache == nil || g.only != nil {
+		return nil
+	}
+
+	// Remove kept pages of items which no longer exist.
+	for _, rel := range g.cache.StaleFiles() {
+		g.infof("removing stale file: %q", rel)
+		err = os.Remove(filepath.Join(g.ProviderDocsDir(), filepath.FromSlash(rel)))
+		if err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("unable to remove stale file %q from rendered website directory: %w", rel, err)
+		}
+	}
+
+	return nil
+}
+
+// cleanRenderedWebsite removes the subdirectories and files managed by
+// tfplugindocs from the rendered website directory, except for the files with
+// a slash-separated path, relative to the rendered website directory, in keep.
+func (g *generator) cleanRenderedWebsite(keep map[string]bool) error {
+	g.infof("cleaning rendered website dir")
+	dirEntry, err := os.ReadDir(g.ProviderDocsDir())
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("unable to read rendered website directory %q: %w", g.ProviderDocsDir(), err)
+	}
+
+	managedDirs := managedSubDirectories(g.outputExtension)
+
+	for _, file := range dirEntry {
+
+		// Remove the files of subdirectories managed by tfplugindocs
+		if file.IsDir() && slices.Contains(managedDirs, file.Name()) && len(keep) > 0 {
+			err = g.cleanRenderedWebsiteSubDirectory(file.Name(), keep)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Remove subdirectories managed by tfplugindocs
+		if file.IsDir() && slices.Contains(managedDirs, file.Name()) {
+			g.infof("removing directory: %q", file.Name())
+			err = os.RemoveAll(filepath.Join(g.ProviderDocsDir(), file.Name()))
+			if err != nil {
+				return fmt.Errorf("unable to remove directory %q from rendered website directory: %w", file.Name(), err)
+			}
+			continue
+		}
+
+		// Remove files managed by tfplugindocs
+		if !file.IsDir() && slices.Contains(managedWebsiteFiles, file.Name()) {
+			g.infof("removing file: %q", file.Name())
+			err = os.RemoveAll(filepath.Join(g.ProviderDocsDir(), file.Name()))
+			if err != nil {
+				return fmt.Errorf("unable to remove file %q from rendered website directory: %w", file.Name(), err)
+			}
+			continue
+		}
+	}
+
+	return nil
+}
+
+// cleanRenderedWebsiteSubDirectory removes the files of a subdirectory of the
+// rendered website directory, except for the files in keep.
+func (g *generator) cleanRenderedWebsiteSubDirectory(dir string, keep map[string]bool) error {
+	subDir := filepath.Join(g.ProviderDocsDir(), dir)
+
+	return filepath.WalkDir(subDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("unable to walk path %q: %w", path, err)
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(g.ProviderDocsDir(), path)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve the relative path of basepath %q and targetpath %q: %w",
+				g.ProviderDocsDir(), path, err)
+		}
+
+		rel = filepath.ToSlash(rel)
+		if keep[rel] {
+			return nil
+		}
+
+		g.infof("removing file: %q", rel)
+		err = os.Remove(path)
+		if err != nil {
+			return fmt.Errorf("unable to remove file %q from rendered website directory: %w", rel, err)
+		}
+
+		return nil
+	})
+}
+
+// checkStaticWebsite renders the website into a temporary directory and
+// compares it with the existing rendered website directory. A unified diff
+// is output for every file which is out of date and an error is returned if
+// any differences are found. The rendered website directory is not modified.
+func (g *generator) checkStaticWebsite(ctx context.Context, providerSchema *tfjson.ProviderSchema) error {
+	diffs, err := g.diffStaticWebsite(ctx, providerSchema)
+	if err != nil {
+		return err
+	}
+
+	if len(diffs) == 0 {
+		g.infof("rendered website is up to date")
+		return nil
+	}
+
+	for _, diff := range diffs {
+		g.ui.Output(diff.Unified)
+	}
+
+	return fmt.Errorf("%d file(s) in rendered website directory %q are out of date, run generate to update them", len(diffs), g.renderedWebsiteDir)
+}
+
+// dryRunStaticWebsite renders the website into a temporary directory and
+// compares it with the existing rendered website directory. A summary line is
+// output for every file which would be created, updated, or deleted by
+// generate, with the number of added and removed lines. The rendered website
+// directory is not modified.
+func (g *generator) dryRunStaticWebsite(ctx context.Context, providerSchema *tfjson.ProviderSchema) error {
+	diffs, err := g.diffStaticWebsite(ctx, providerSchema)
+	if err != nil {
+		return err
+	}
+
+	if len(diffs) == 0 {
+		g.infof("rendered website is up to date")
+		return nil
+	}
+
+	counts := make(map[string]int)
+
+	for _, diff := range diffs {
+		change := diff.Change()
+		counts[change]++
+
+		added, removed := diff.Stats()
+
+		var stats []string
+		if added > 0 {
+			stats = append(stats, fmt.Sprintf("+%d", added))
+		}
+		if removed > 0 {
+			stats = append(stats, fmt.Sprintf("-%d", removed))
+		}
+
+		g.ui.Output(fmt.Sprintf("%s: %s (%s)", change, path.Join(filepath.ToSlash(g.renderedWebsiteDir), diff.Path), strings.Join(stats, " ")))
+	}
+
+	g.ui.Output(fmt.Sprintf("%d file(s) in rendered website directory %q would be changed: %d created, %d updated, %d deleted",
+		len(diffs), g.renderedWebsiteDir, counts[fileChangeCreated], counts[fileChangeUpdated], counts[fileChangeDeleted]))
+
+	return nil
+}
+
+// diffStaticWebsite renders the website into a temporary directory and
+// returns the differences with the existing rendered website directory.
+func (g *generator) diffStaticWebsite(ctx context.Context, providerSchema *tfjson.ProviderSchema) ([]fileDiff, error) {
+	renderedDir, err := os.MkdirTemp("", "tfws-check")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary rendered website directory: %w", err)
+	}
+	defer os.RemoveAll(renderedDir)
+
+	g.infof("rendering templated website to temporary directory")
+	err = g.renderWebsite(ctx, renderedDir, providerSchema)
+	if err != nil {
+		return nil, fmt.Errorf("unable to render templated website to static markdown: %w", err)
+	}
+
+	g.infof("comparing rendered website with %q", g.renderedWebsiteDir)
+	diffs, err := diffRenderedWebsite(g.ProviderDocsDir(), renderedDir, g.renderedWebsiteDir, g.outputExtension)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compare rendered website directory %q: %w", g.ProviderDocsDir(), err)
+	}
+
+	return diffs, nil
+}
+
+// renderWebsite renders all templates and copies all static files from the
+// temporary templates directory into renderedDir.
+func (g *generator) renderWebsite(ctx context.Context, renderedDir string, providerSchema *tfjson.ProviderSchema) error {
+	tmplOpts, err := g.templateOptions()
+	if err != nil {
+		return err
+	}
+
+	if g.linkItemMentions || g.itemMetadata != nil {
+		g.mentionTargets = g.itemMentionTargets(providerSchema)
+	}
+
+	if g.cache != nil {
+		g.cache.settings = g.cacheSettings(tmplOpts)
+	}
+
+	partialsDir := filepath.Join(g.TempTemplatesDir(), websitePartialsDir)
+
+	var paths []string
+
+	err = filepath.WalkDir(g.websiteTmpDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("unable to walk path %q: %w", path, err)
+		}
+		if d.IsDir() {
+			// partials are only included by other templates, not rendered
+			if path == partialsDir {
+				return filepath.SkipDir
+			}
+			// skip directories
+			return nil
+		}
+
+		paths = append(paths, path)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return g.renderFiles(ctx, renderedDir, paths, providerSchema, tmplOpts)
+}
+
+// validateSchemaOptions returns an error if the schema rendering options are
+// not supported.
+// parseProviderVersion returns the provider version without a leading v, or
+// an error if it is not a valid version. An empty version is returned as is.
+func parseProviderVersion(providerVersion string) (string, error) {
+	if providerVersion == "" {
+		return "", nil
+	}
+
+	providerVersion = strings.TrimPrefix(providerVersion, "v")
+
+	_, err := version.NewVersion(providerVersion)
+	if err != nil {
+		return "", fmt.Errorf("invalid provider version %q: %w", providerVersion, err)
+	}
+
+	return providerVersion, nil
+}
+
+// sourceDateEpochEnvVar is the environment variable of the reproducible
+// builds convention with the Unix timestamp of reproducible output.
+const sourceDateEpochEnvVar = "SOURCE_DATE_EPOCH"
+
+// parseBuildTimestamp returns the timestamp of the buildinfo template
+// function, and whether it is fixed, rather than the time of the generation.
+// The timestamp is the RFC 3339 value if set, or with reproducible output,
+// the Unix timestamp of the SOURCE_DATE_EPOCH environment variable if set,
+// otherwise the Unix epoch.
+func parseBuildTimestamp(value string, reproducible bool) (time.Time, bool, error) {
+	if value != "" {
+		timestamp, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, false, fmt.Errorf("invalid build timestamp %q, expected an RFC 3339 timestamp (ex. 2024-01-02T15:04:05Z): %w", value, err)
+		}
+
+		return timestamp.UTC(), true, nil
+	}
+
+	if !reproducible {
+		return time.Now().UTC().Truncate(time.Second), false, nil
+	}
+
+	epoch := os.Getenv(sourceDateEpochEnvVar)
+	if epoch == "" {
+		return time.Unix(0, 0).UTC(), true, nil
+	}
+
+	seconds, err := strconv.ParseInt(epoch, 10, 64)
+	if err != nil {
+		return time.Time{}, false, fmt.Errorf("invalid %s %q, expected a Unix timestamp: %w", sourceDateEpochEnvVar, epoch, err)
+	}
+
+	return time.Unix(seconds, 0).UTC(), true, nil
+}
+
+// validateRegistryOptions returns an error if the registry provider source
+// address or version are invalid, or conflict with the providers schema path.
+func validateRegistryOptions(registryProvider, registryVersion, providersSchemaPath string) error {
+	if registryProvider == "" {
+		if registryVersion != "" {
+			return fmt.Errorf("registry version requires a registry provider")
+		}
+
+		return nil
+	}
+
+	if providersSchemaPath != "" {
+		return fmt.Errorf("registry provider and providers schema cannot be used together")
+	}
+
+	err := validateProviderSource(registryProvider)
+	if err != nil {
+		return fmt.Errorf("invalid registry provider: %w", err)
+	}
+
+	if registryVersion != "" {
+		_, err := version.NewVersion(registryVersion)
+		if err != nil {
+			return fmt.Errorf("invalid registry version %q: %w", registryVersion, err)
+		}
+	}
+
+	return nil
+}
+
+// providerSourceAddress returns the fully qualified provider source address,
+// including the default registry hostname if omitted, which is the key of the
+// provider in the providers schema JSON.
+func providerSourceAddress(providerSource, defaultHostname string) string {
+	if strings.Count(providerSource, "/") == 1 {
+		return defaultHostname + "/" + providerSource
+	}
+
+	return providerSource
+}
+
+// validateProviderSource returns an error if the provider source address is
+// not in the [<hostname>/]<namespace>/<type> format.
+func validateProviderSource(providerSource string) error {
+	parts := strings.Split(providerSource, "/")
+	if len(parts) < 2 || len(parts) > 3 || slices.Contains(parts, "") {
+		return fmt.Errorf("invalid provider source %q, expected [<hostname>/]<namespace>/<type>", providerSource)
+	}
+
+	return nil
+}
+
+// parseHeadings returns the text of each heading of the <name>=<text> values.
+func parseHeadings(values []string) (map[string]string, error) {
+	headings := make(map[string]string, len(values))
+
+	for _, value := range values {
+		name, text, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid heading %q, expected <name>=<text>", value)
+		}
+
+		name = strings.TrimSpace(name)
+		text = strings.TrimSpace(text)
+
+		err := validateHeading(name, text)
+		if err != nil {
+			return nil, err
+		}
+
+		headings[name] = text
+	}
+
+	return headings, nil
+}
+
+// exampleValueNamePattern matches the names of the placeholder tokens of
+// examples, as in tmplfuncs.ReplacePlaceholders.
+var exampleValueNamePattern = regexp.MustCompile(`^[A-Za-z0-9_]+$`)
+
+// parseExampleValues returns the value of each placeholder name of the
+// <name>=<value> values.
+func parseExampleValues(values []string) (map[string]string, error) {
+	exampleValues := make(map[string]string, len(values))
+
+	for _, value := range values {
+		name, text, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid example value %q, expected <name>=<value>", value)
+		}
+
+		name = strings.TrimSpace(name)
+		if !exampleValueNamePattern.MatchString(name) {
+			return nil, fmt.Errorf("invalid example value name %q, expected letters, digits, and underscores", name)
+		}
+
+		exampleValues[name] = strings.TrimSpace(text)
+	}
+
+	return exampleValues, nil
+}
+
+// validateHeading returns an error if name is not a configurable heading or
+// text is empty.
+func validateHeading(name, text string) error {
+	_, isTemplateHeading := defaultTemplateHeadings[name]
+	_, isSchemaHeading := schemamd.DefaultHeadings[name]
+	if !isTemplateHeading && !isSchemaHeading {
+		return fmt.Errorf("unknown heading %q, expected one of: %s", name, strings.Join(headingNames(), ", "))
+	}
+
+	if text == "" {
+		return fmt.Errorf("expected text of heading %q", name)
+	}
+
+	return nil
+}
+
+// headingNames returns the sorted names of the configurable headings.
+func headingNames() []string {
+	names := append(sortedKeys(defaultTemplateHeadings), sortedKeys(schemamd.DefaultHeadings)...)
+	slices.Sort(names)
+
+	return names
+}
+
+func validateSchemaOptions(schemaStyle string, inlineNestedDepth int) error {
+	if schemaStyle != "" && !slices.Contains(schemamd.Styles, schemaStyle) {
+		return fmt.Errorf("unsupported schema style %q, expected one of: %s", schemaStyle, strings.Join(schemamd.Styles, ", "))
+	}
+
+	if inlineNestedDepth < 0 {
+		return fmt.Errorf("expected inline nested depth to be at least 0, got %d", inlineNestedDepth)
+	}
+
+	return nil
+}
+
+// templateOptions returns the options for rendering templates, including
+// all partial templates in the temporary templates directory.
+func (g *generator) templateOptions() (templateOptions, error) {
+	tmplOpts := templateOptions{
+		providerDir: g.providerDir,
+		partials:    make(map[string]string),
+		schemaOptions: &schemamd.RenderOptions{
+			Style:                     g.schemaStyle,
+			InlineNestedDepth:         g.inlineNestedDepth,
+			MaxNestedDepth:            g.maxNestedDepth,
+			InlineObjectMaxAttributes: g.inlineObjectMaxAttributes,
+			Headings:                  g.headings,
+			GroupOrder:                g.schemaGroupOrder,
+			AttributeAnchors:          g.attributeAnchors,
+			CollapsibleNestedSchemas:  g.collapsibleNestedSchemas,
+			WriteOnlySection:          g.writeOnlySection,
+			TimeoutsSection:           g.timeoutsSection,
+			AttributeSort:             g.attributeSort,
+			TypeSyntax:                g.typeSyntax,
+			AttributeOrder:            g.attributeOrder,
+		},
+		codeFileOptions: &tmplfuncs.CodeFileOptions{
+			StripHeaders:    g.stripExampleHeaders,
+			FormatTerraform: g.formatExamples,
+			Values:          g.exampleValues,
+		},
+		exampleSyntax:    g.exampleSyntax,
+		generateExamples: g.generateExamples,
+		plainMarkdownOptions: &mdplain.Options{
+			MaxLength:     g.frontmatterDescriptionMaxLength,
+			FirstSentence: g.frontmatterDescriptionFirstSentence,
+			OmitLinkURLs:  g.frontmatterDescriptionOmitLinkURLs,
+		},
+		providerVersion: g.providerVersion,
+		providerSource:  g.providerSource,
+		headings:        g.headings,
+
+		sensitiveAttributesSection: g.sensitiveAttributesSection,
+
+		templateEnv:   g.templateEnv,
+		templateFuncs: g.templateFuncs,
+		buildInfo: buildInfo{
+			Version:   build.GetVersionNumber(),
+			Commit:    build.GetCommit(),
+			Timestamp: g.buildTimestamp,
+		},
+	}
+
+	partialsDir := filepath.Join(g.TempTemplatesDir(), websitePartialsDir)
+
+	err := filepath.WalkDir(partialsDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			if path == partialsDir && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return fmt.Errorf("unable to walk path %q: %w", path, err)
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(g.TempTemplatesDir(), path)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve the relative path of basepath %q and targetpath %q: %w",
+				g.TempTemplatesDir(), path, err)
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read partial template %q: %w", rel, err)
+		}
+
+		tmplOpts.partials[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	if err != nil {
+		return templateOptions{}, err
+	}
+
+	return tmplOpts, nil
+}
+
+// renderFiles renders or copies each of the given temporary template
+// directory files into renderedDir, using up to g.parallel concurrent workers.
+// Log messages and errors are output in the order of paths, regardless of
+// which worker finishes first, so output is deterministic. Files which fail
+// to render do not stop the others from rendering, and all of their errors
+// are returned, unless g.failFast is set.
+func (g *generator) renderFiles(ctx context.Context, renderedDir string, paths []string, providerSchema *tfjson.ProviderSchema, tmplOpts templateOptions) error {
+	parallel := g.parallel
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	type renderResult struct {
+		logger *bufferedLogger
+		err    error
+		done   chan struct{}
+	}
+
+	results := make([]*renderResult, len(paths))
+	for i := range results {
+		results[i] = &renderResult{
+			logger: &bufferedLogger{},
+			done:   make(chan struct{}),
+		}
+	}
+
+	jobs := make(chan int)
+	stop := make(chan struct{})
+
+	var wg sync.WaitGroup
+	for w := 0; w < parallel; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				result := results[i]
+
+				// files are no longer rendered once the context is done
+				result.err = ctx.Err()
+				if result.err == nil {
+					result.err = g.renderFile(renderedDir, paths[i], providerSchema, tmplOpts, result.logger)
+				}
+				close(result.done)
+			}
+		}()
+	}
+
+	go func() {
+		defer close(jobs)
+		for i := range paths {
+			select {
+			case jobs <- i:
+			case <-stop:
+				return
+			}
+		}
+	}()
+
+	var errs []error
+	for i, result := range results {
+		<-result.done
+		result.logger.flush(g.ui)
+		g.renderProgress(i+1, len(results))
+
+		if result.err == nil {
+			continue
+		}
+
+		errs = append(errs, result.err)
+		if g.failFast {
+			break
+		}
+	}
+
+	close(stop)
+	wg.Wait()
+
+	// the files which were not rendered are not reported as failures
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	switch {
+	case len(errs) == 0:
+		return nil
+	case g.failFast:
+		return errs[0]
+	default:
+		return fmt.Errorf("unable to render %d of %d files:\n%w", len(errs), len(paths), errors.Join(errs...))
+	}
+}
+
+// renderFile renders a single template, or copies a single static file, from
+// the temporary templates directory into renderedDir.
+func (g *generator) renderFile(renderedDir, path string, providerSchema *tfjson.ProviderSchema, tmplOpts templateOptions, l *bufferedLogger) error {
+	shortName := providerShortName(g.providerName)
+
+	rel, err := filepath.Rel(filepath.Join(g.TempTemplatesDir()), path)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the relative path of basepath %q and targetpath %q: %w",
+			filepath.Join(g.TempTemplatesDir()), path, err)
+	}
+
+	l.page = filepath.ToSlash(renderedSubDirectory(rel, g.outputExtension))
+	if filepath.Ext(rel) == ".tmpl" {
+		l.page = renderedFilePath(strings.TrimSuffix(l.page, ".tmpl"), g.outputExtension)
+	}
+
+	relDir, relFile := filepath.Split(rel)
+	relDir = filepath.ToSlash(relDir)
+
+	// skip special top-level generic resource, data source, ephemeral resource, list resource, action, and function templates
+	if relDir == "" && (relFile == "resources.md.tmpl" || relFile == "data-sources.md.tmpl" || relFile == "ephemeral-resources.md.tmpl" || relFile == "list-resources.md.tmpl" || relFile == "actions.md.tmpl" || relFile == "functions.md.tmpl") {
+		return nil
+	}
+
+	dir, name, isItem := templateItem(relDir, relFile, shortName, providerSchema, g.actionSchemas)
+	if isItem && g.ignore.Match(dir, name) {
+		l.infof("skipping ignored file: %q", rel)
+		return nil
+	}
+
+	// only render the files of matching items when partially generating
+	if g.only != nil && (!isItem || !g.only.Match(dir, name)) {
+		return nil
+	}
+
+	renderedPath := filepath.Join(renderedDir, renderedSubDirectory(rel, g.outputExtension))
+	err = os.MkdirAll(filepath.Dir(renderedPath), 0755)
+	if err != nil {
+		return fmt.Errorf("unable to create rendered website subdirectory %q: %w", renderedPath, err)
+	}
+
+	ext := filepath.Ext(path)
+	if ext != ".tmpl" {
+		if g.debugTemplates {
+			l.infof("page %q: static file %s", filepath.ToSlash(renderedSubDirectory(rel, g.outputExtension)), g.templateSource(rel))
+		}
+
+		l.infof("copying non-template file: %q", rel)
+		err = cp(path, renderedPath)
+		if err != nil {
+			return err
+		}
+
+		g.stats.add(copiedFiles, 1)
+		return nil
+	}
+
+	renderedPath = renderedFilePath(strings.TrimSuffix(renderedPath, ext), g.outputExtension)
+
+	// renderedRel is the slash-separated path of the rendered file, relative
+	// to the rendered website directory
+	renderedRel, err := filepath.Rel(renderedDir, renderedPath)
+	if err != nil {
+		return fmt.Errorf("unable to retrieve the relative path of basepath %q and targetpath %q: %w",
+			renderedDir, renderedPath, err)
+	}
+	renderedRel = filepath.ToSlash(renderedRel)
+
+	if g.debugTemplates {
+		l.infof("page %q: template %s", renderedRel, g.templateSource(rel))
+	}
+
+	tmplData, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("unable to read file %q: %w", rel, err)
+	}
+
+	var sum string
+	if g.cache != nil && isItem {
+		sum, err = g.itemHash(dir, name, tmplData, providerSchema)
 		if err != nil {
-			return fmt.Errorf("unable to copy fallback template for %q: %w", functionName, err)
+			return err
 		}
-		return nil
-	}
 
-	for _, candidate := range websiteFunctionFileStaticCandidates {
-		candidatePath := fmt.Sprintf(candidate, resourceShortName(functionName, g.providerName))
-		candidatePath = filepath.Join(g.TempTemplatesDir(), candidatePath)
-		if fileExists(candidatePath) {
-			g.infof("function %q static file exists, skipping", functionName)
+		if g.cache.Unchanged(renderedRel, sum) && fileExists(renderedPath) && g.splitPagesExist(renderedDir, g.cache.PreviousPages(renderedRel)) {
+			l.infof("skipping unchanged file: %q", rel)
+			g.cache.Keep(renderedRel)
+			g.stats.add(unchangedPages, 1)
 			return nil
 		}
+
+		tmplOpts.readFiles = &fileRecorder{}
 	}
 
-	g.infof("generating new template for function %q", functionName)
-	err := writeFile(templatePath, string(defaultFunctionTemplate))
+	tmplOpts.logger = l
+
+	l.infof("rendering %q", rel)
+	var out bytes.Buffer
+	err = g.renderTemplate(&out, rel, tmplData, providerSchema, tmplOpts, l)
 	if err != nil {
-		return fmt.Errorf("unable to write template for %q: %w", functionName, err)
+		return err
 	}
 
-	return nil
-}
-
-func (g *generator) generateMissingProviderTemplate() error {
-	templatePath := filepath.Join(g.TempTemplatesDir(), websiteProviderFile)
-	if fileExists(templatePath) {
-		g.infof("provider %q template exists, skipping", g.providerName)
-		return nil
+	content := out.String()
+	if g.linkItemMentions {
+		content = linkItemMentions(content, renderedRel, g.mentionTargets)
 	}
 
-	for _, candidate := range websiteProviderFileStaticCandidates {
-		candidatePath := filepath.Join(g.TempTemplatesDir(), candidate)
-		if fileExists(candidatePath) {
-			g.infof("provider %q static file exists, skipping", g.providerName)
-			return nil
+	var pages []splitPage
+	if isItem && g.splitPageSize > 0 {
+		var fits bool
+		content, pages, fits = splitOversizedPage(content, renderedRel, name, g.splitPageSize)
+		if !fits {
+			l.warnf("page %q is larger than the split page size of %d bytes after moving its nested schema sections into separate pages", renderedRel, g.splitPageSize)
 		}
 	}
 
-	g.infof("generating new template for %q", g.providerName)
-	err := writeFile(templatePath, string(defaultProviderTemplate))
-	if err != nil {
-		return fmt.Errorf("unable to write template for %q: %w", g.providerName, err)
+	content = convertOutputDialect(content, g.outputExtension, shortName)
+
+	var label string
+	if isItem {
+		label = name
 	}
 
-	return nil
-}
+	content, err = convertFrontmatterDialect(content, g.frontmatterDialect, renderedRel, label)
+	if err != nil {
+		return fmt.Errorf("unable to convert frontmatter of %q: %w", rel, err)
+	}
 
-func (g *generator) generateMissingTemplates(providerSchema *tfjson.ProviderSchema) error {
-	g.infof("generating missing resource content")
-	for name, schema := range providerSchema.ResourceSchemas {
-		if g.ignoreDeprecated && schema.Block.Deprecated {
-			continue
-		}
+	content, appended := mergeCustomRegions(content, g.customRegions[renderedRel])
+	for _, name := range appended {
+		l.infof("appending custom region %q to the end of %q, as the template does not contain it", name, renderedRel)
+	}
 
-		err := g.generateMissingResourceTemplate(name)
-		if err != nil {
-			return fmt.Errorf("unable to generate template for resource %q: %w", name, err)
-		}
+	content, err = g.postProcess(renderedRel, content)
+	if err != nil {
+		return err
 	}
 
-	g.infof("generating missing data source content")
-	for name, schema := range providerSchema.DataSourceSchemas {
-		if g.ignoreDeprecated && schema.Block.Deprecated {
-			continue
-		}
+	err = os.WriteFile(renderedPath, []byte(content), 0644)
+	if err != nil {
+		return fmt.Errorf("unable to write file %q: %w", renderedPath, err)
+	}
 
-		err := g.generateMissingDataSourceTemplate(name)
-		if err != nil {
-			return fmt.Errorf("unable to generate template for data-source %q: %w", name, err)
-		}
+	pageRels, err := g.writeSplitPages(renderedDir, pages, l)
+	if err != nil {
+		return err
 	}
 
-	g.infof("generating missing function content")
-	for name, signature := range providerSchema.Functions {
-		if g.ignoreDeprecated && signature.DeprecationMessage != "" {
-			continue
-		}
+	g.stats.add(renderedPages, 1)
+	g.stats.add(splitPages, len(pageRels))
 
-		err := g.generateMissingFunctionTemplate(name)
+	if sum != "" {
+		err = g.cache.Set(renderedRel, sum, tmplOpts.readFiles.files, pageRels)
 		if err != nil {
-			return fmt.Errorf("unable to generate template for function %q: %w", name, err)
+			return err
 		}
 	}
 
-	g.infof("generating missing provider content")
-	err := g.generateMissingProviderTemplate()
-	if err != nil {
-		return fmt.Errorf("unable to generate template for provider: %w", err)
-	}
-
 	return nil
 }
 
-func (g *generator) renderStaticWebsite(providerSchema *tfjson.ProviderSchema) error {
-	g.infof("cleaning rendered website dir")
-	dirEntry, err := os.ReadDir(g.ProviderDocsDir())
-	if err != nil && !os.IsNotExist(err) {
-		return fmt.Errorf("unable to read rendered website directory %q: %w", g.ProviderDocsDir(), err)
-	}
+// renderTemplate renders a single template, with a path relative to the
+// temporary templates directory, into out.
+func (g *generator) renderTemplate(out *bytes.Buffer, rel string, tmplData []byte, providerSchema *tfjson.ProviderSchema, tmplOpts templateOptions, l *bufferedLogger) error {
+	shortName := providerShortName(g.providerName)
 
-	for _, file := range dirEntry {
+	relDir, relFile := filepath.Split(rel)
+	relDir = filepath.ToSlash(relDir)
 
-		// Remove subdirectories managed by tfplugindocs
-		if file.IsDir() && slices.Contains(managedWebsiteSubDirectories, file.Name()) {
-			g.infof("removing directory: %q", file.Name())
-			err = os.RemoveAll(filepath.Join(g.ProviderDocsDir(), file.Name()))
-			if err != nil {
-				return fmt.Errorf("unable to remove directory %q from rendered website directory: %w", file.Name(), err)
-			}
-			continue
-		}
+	if dir, name, ok := templateItem(relDir, relFile, shortName, providerSchema, g.actionSchemas); ok {
+		tmplOpts.subcategory = g.itemSubcategory(dir, name)
+		tmplOpts.schemaOptions = g.itemSchemaOptions(tmplOpts.schemaOptions, dir, name)
+		tmplOpts.deprecationReplacement = g.deprecations[dir][name][""]
+		tmplOpts.relatedResources = g.relatedResources(dir, name)
+		tmplOpts.pageTitle = g.itemMetadata[dir][name].PageTitle
+		tmplOpts.frontmatter = g.itemMetadata[dir][name].Frontmatter
 
-		// Remove files managed by tfplugindocs
-		if !file.IsDir() && slices.Contains(managedWebsiteFiles, file.Name()) {
-			g.infof("removing file: %q", file.Name())
-			err = os.RemoveAll(filepath.Join(g.ProviderDocsDir(), file.Name()))
-			if err != nil {
-				return fmt.Errorf("unable to remove file %q from rendered website directory: %w", file.Name(), err)
+		for _, related := range tmplOpts.relatedResources {
+			if related.Link == "" {
+				l.warnf("related resource %q of %q is not generated, rendering it without a link", related.Name, name)
 			}
-			continue
 		}
 	}
 
-	shortName := providerShortName(g.providerName)
-
-	g.infof("rendering templated website to static markdown")
+	switch relDir {
+	case "data-sources/":
+		resSchema, resName := resourceSchema(providerSchema.DataSourceSchemas, shortName, relFile)
+		exampleFilePath := g.exampleFilePath("data-sources", resName, "data-source.tf")
 
-	err = filepath.WalkDir(g.websiteTmpDir, func(path string, d os.DirEntry, err error) error {
-		if err != nil {
-			return fmt.Errorf("unable to walk path %q: %w", path, err)
-		}
-		if d.IsDir() {
-			// skip directories
+		if resSchema != nil {
+			tmpl := resourceTemplate(tmplData)
+			render, err := tmpl.Render(tmplOpts, resName, g.providerName, g.renderedProviderName, "Data Source", exampleFilePath, "", "", resSchema, nil)
+			if err != nil {
+				return fmt.Errorf("unable to render data source template %q: %w", rel, err)
+			}
+			_, err = out.WriteString(render)
+			if err != nil {
+				return fmt.Errorf("unable to write rendered string: %w", err)
+			}
 			return nil
 		}
-
-		rel, err := filepath.Rel(filepath.Join(g.TempTemplatesDir()), path)
-		if err != nil {
-			return fmt.Errorf("unable to retrieve the relative path of basepath %q and targetpath %q: %w",
-				filepath.Join(g.TempTemplatesDir()), path, err)
-		}
-
-		relDir, relFile := filepath.Split(rel)
-		relDir = filepath.ToSlash(relDir)
-
-		// skip special top-level generic resource, data source, and function templates
-		if relDir == "" && (relFile == "resources.md.tmpl" || relFile == "data-sources.md.tmpl" || relFile == "functions.md.tmpl") {
+		l.warnf("data source entitled %q, or %q does not exist", shortName, resName)
+	case "resources/":
+		resSchema, resName := resourceSchema(providerSchema.ResourceSchemas, shortName, relFile)
+		exampleFilePath := g.exampleFilePath("resources", resName, "resource.tf")
+		importFilePath := g.exampleFilePath("resources", resName, "import.sh")
+		importBlockFilePath := g.exampleFilePath("resources", resName, "import.tf")
+
+		if resSchema != nil {
+			tmpl := resourceTemplate(tmplData)
+			render, err := tmpl.Render(tmplOpts, resName, g.providerName, g.renderedProviderName, "Resource", exampleFilePath, importFilePath, importBlockFilePath, resSchema, providerSchema.ResourceIdentitySchemas[resName])
+			if err != nil {
+				return fmt.Errorf("unable to render resource template %q: %w", rel, err)
+			}
+			_, err = out.WriteString(render)
+			if err != nil {
+				return fmt.Errorf("unable to write rendered string: %w", err)
+			}
 			return nil
 		}
-
-		renderedPath := filepath.Join(g.ProviderDocsDir(), rel)
-		err = os.MkdirAll(filepath.Dir(renderedPath), 0755)
-		if err != nil {
-			return fmt.Errorf("unable to create rendered website subdirectory %q: %w", renderedPath, err)
+		l.warnf("resource entitled %q, or %q does not exist", shortName, resName)
+	case "ephemeral-resources/":
+		resSchema, resName := resourceSchema(providerSchema.EphemeralResourceSchemas, shortName, relFile)
+		exampleFilePath := g.exampleFilePath("ephemeral-resources", resName, "ephemeral-resource.tf")
+
+		if resSchema != nil {
+			tmpl := resourceTemplate(tmplData)
+			render, err := tmpl.Render(tmplOpts, resName, g.providerName, g.renderedProviderName, "Ephemeral Resource", exampleFilePath, "", "", resSchema, nil)
+			if err != nil {
+				return fmt.Errorf("unable to render ephemeral resource template %q: %w", rel, err)
+			}
+			_, err = out.WriteString(render)
+			if err != nil {
+				return fmt.Errorf("unable to write rendered string: %w", err)
+			}
+			return nil
 		}
-
-		ext := filepath.Ext(path)
-		if ext != ".tmpl" {
-			g.infof("copying non-template file: %q", rel)
-			return cp(path, renderedPath)
+		l.warnf("ephemeral resource entitled %q, or %q does not exist", shortName, resName)
+	case "list-resources/":
+		resSchema, resName := resourceSchema(providerSchema.ListResourceSchemas, shortName, relFile)
+		exampleFilePath := g.exampleFilePath("list-resources", resName, "list.tf")
+
+		if resSchema != nil {
+			tmpl := resourceTemplate(tmplData)
+			render, err := tmpl.Render(tmplOpts, resName, g.providerName, g.renderedProviderName, "List Resource", exampleFilePath, "", "", resSchema, nil)
+			if err != nil {
+				return fmt.Errorf("unable to render list resource template %q: %w", rel, err)
+			}
+			_, err = out.WriteString(render)
+			if err != nil {
+				return fmt.Errorf("unable to write rendered string: %w", err)
+			}
+			return nil
 		}
-
-		renderedPath = strings.TrimSuffix(renderedPath, ext)
-
-		tmplData, err := os.ReadFile(path)
-		if err != nil {
-			return fmt.Errorf("unable to read file %q: %w", rel, err)
+		l.warnf("list resource entitled %q, or %q does not exist", shortName, resName)
+	case "actions/":
+		actionSchema, actionName := resourceSchema(g.actionSchemas, shortName, relFile)
+		exampleFilePath := g.exampleFilePath("actions", actionName, "action.tf")
+
+		if actionSchema != nil {
+			tmpl := resourceTemplate(tmplData)
+			render, err := tmpl.Render(tmplOpts, actionName, g.providerName, g.renderedProviderName, "Action", exampleFilePath, "", "", actionSchema, nil)
+			if err != nil {
+				return fmt.Errorf("unable to render action template %q: %w", rel, err)
+			}
+			_, err = out.WriteString(render)
+			if err != nil {
+				return fmt.Errorf("unable to write rendered string: %w", err)
+			}
+			return nil
 		}
-
-		out, err := os.Create(renderedPath)
-		if err != nil {
-			return fmt.Errorf("unable to create file %q: %w", renderedPath, err)
+		l.warnf("action entitled %q, or %q does not exist", shortName, actionName)
+	case "functions/":
+		funcName := removeAllExt(relFile)
+		if signature, ok := providerSchema.Functions[funcName]; ok {
+			exampleFilePath := g.exampleFilePath("functions", funcName, "function.tf")
+
+			tmpl := functionTemplate(tmplData)
+			render, err := tmpl.Render(tmplOpts, funcName, g.providerName, g.renderedProviderName, "function", exampleFilePath, signature)
+			if err != nil {
+				return fmt.Errorf("unable to render function template %q: %w", rel, err)
+			}
+			_, err = out.WriteString(render)
+			if err != nil {
+				return fmt.Errorf("unable to write rendered string: %w", err)
+			}
+			return nil
 		}
-		defer out.Close()
 
-		g.infof("rendering %q", rel)
-		switch relDir {
-		case "data-sources/":
-			resSchema, resName := resourceSchema(providerSchema.DataSourceSchemas, shortName, relFile)
-			exampleFilePath := filepath.Join(g.ProviderExamplesDir(), "data-sources", resName, "data-source.tf")
-
-			if resSchema != nil {
-				tmpl := resourceTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Data Source", exampleFilePath, "", resSchema)
-				if err != nil {
-					return fmt.Errorf("unable to render data source template %q: %w", rel, err)
-				}
-				_, err = out.WriteString(render)
-				if err != nil {
-					return fmt.Errorf("unable to write rendered string: %w", err)
-				}
-				return nil
+		l.warnf("function entitled %q does not exist", funcName)
+	case "guides/":
+		if g.deprecationsGuide && relFile == path.Base(websiteDeprecationsGuideFile) {
+			tmpl := deprecationsTemplate(tmplData)
+			render, err := tmpl.Render(tmplOpts, g.providerName, g.renderedProviderName, g.deprecationsMarkdown(providerSchema))
+			if err != nil {
+				return fmt.Errorf("unable to render deprecations guide template %q: %w", rel, err)
 			}
-			g.warnf("data source entitled %q, or %q does not exist", shortName, resName)
-		case "resources/":
-			resSchema, resName := resourceSchema(providerSchema.ResourceSchemas, shortName, relFile)
-			exampleFilePath := filepath.Join(g.ProviderExamplesDir(), "resources", resName, "resource.tf")
-			importFilePath := filepath.Join(g.ProviderExamplesDir(), "resources", resName, "import.sh")
-
-			if resSchema != nil {
-				tmpl := resourceTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, resName, g.providerName, g.renderedProviderName, "Resource", exampleFilePath, importFilePath, resSchema)
-				if err != nil {
-					return fmt.Errorf("unable to render resource template %q: %w", rel, err)
-				}
-				_, err = out.WriteString(render)
-				if err != nil {
-					return fmt.Errorf("unable to write rendered string: %w", err)
-				}
-				return nil
+			_, err = out.WriteString(render)
+			if err != nil {
+				return fmt.Errorf("unable to write rendered string: %w", err)
 			}
-			g.warnf("resource entitled %q, or %q does not exist", shortName, resName)
-		case "functions/":
-			funcName := removeAllExt(relFile)
-			if signature, ok := providerSchema.Functions[funcName]; ok {
-				exampleFilePath := filepath.Join(g.ProviderExamplesDir(), "functions", funcName, "function.tf")
-
-				tmpl := functionTemplate(tmplData)
-				render, err := tmpl.Render(g.providerDir, funcName, g.providerName, g.renderedProviderName, "function", exampleFilePath, signature)
-				if err != nil {
-					return fmt.Errorf("unable to render function template %q: %w", rel, err)
-				}
-				_, err = out.WriteString(render)
-				if err != nil {
-					return fmt.Errorf("unable to write rendered string: %w", err)
-				}
-				return nil
+			return nil
+		}
+	case "": // provider
+		if relFile == "index.md.tmpl" {
+			tmpl := providerTemplate(tmplData)
+			exampleFilePath := g.exampleFilePath("provider", "provider.tf")
+			tmplOpts.itemIndexMarkdown = g.itemIndexMarkdown(providerSchema)
+			tmplOpts.schemaOptions = g.itemSchemaOptions(tmplOpts.schemaOptions, "", "")
+			render, err := tmpl.Render(tmplOpts, g.providerName, g.renderedProviderName, exampleFilePath, providerSchema.ConfigSchema)
+			if err != nil {
+				return fmt.Errorf("unable to render provider template %q: %w", rel, err)
 			}
-
-			g.warnf("function entitled %q does not exist", funcName)
-		case "": // provider
-			if relFile == "index.md.tmpl" {
-				tmpl := providerTemplate(tmplData)
-				exampleFilePath := filepath.Join(g.ProviderExamplesDir(), "provider", "provider.tf")
-				render, err := tmpl.Render(g.providerDir, g.providerName, g.renderedProviderName, exampleFilePath, providerSchema.ConfigSchema)
-				if err != nil {
-					return fmt.Errorf("unable to render provider template %q: %w", rel, err)
-				}
-				_, err = out.WriteString(render)
-				if err != nil {
-					return fmt.Errorf("unable to write rendered string: %w", err)
-				}
-				return nil
+			_, err = out.WriteString(render)
+			if err != nil {
+				return fmt.Errorf("unable to write rendered string: %w", err)
 			}
+			return nil
 		}
+	}
 
-		tmpl := docTemplate(tmplData)
-		err = tmpl.Render(g.providerDir, out)
-		if err != nil {
-			return fmt.Errorf("unable to render template %q: %w", rel, err)
-		}
-		return nil
-	})
+	tmpl := docTemplate(tmplData)
+	err := tmpl.Render(tmplOpts, g.providerName, g.renderedProviderName, out)
 	if err != nil {
-		return fmt.Errorf("unable to render templated website to static markdown: %w", err)
+		return fmt.Errorf("unable to render template %q: %w", rel, err)
 	}
-
 	return nil
 }
 
@@ -620,15 +3040,23 @@ func (g *generator) terraformProviderSchemaFromTerraform(ctx context.Context) (*
 	}
 	defer os.RemoveAll(tmpDir)
 
+	cli, err := g.terraformCLI(ctx, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	// The plugin directory and providers schema JSON use the default
+	// registry hostname of the CLI, as the provider has no source address.
+	hostname := cli.registryHostname()
+
 	g.infof("compiling provider %q", shortName)
-	providerPath := fmt.Sprintf("plugins/registry.terraform.io/hashicorp/%s/0.0.1/%s_%s", shortName, runtime.GOOS, runtime.GOARCH)
+	providerPath := fmt.Sprintf("plugins/%s/hashicorp/%s/0.0.1/%s_%s", hostname, shortName, runtime.GOOS, runtime.GOARCH)
 	outFile := filepath.Join(tmpDir, providerPath, fmt.Sprintf("terraform-provider-%s", shortName))
 	switch runtime.GOOS {
 	case "windows":
 		outFile = outFile + ".exe"
 	}
-	buildCmd := exec.Command("go", "build", "-o", outFile)
-	buildCmd.Dir = g.providerDir
+	buildCmd := providerBuildCmd(ctx, g.providerDir, outFile, g.offline)
 	// TODO: constrain env here to make it a little safer?
 	_, err = runCmd(buildCmd)
 	if err != nil {
@@ -643,72 +3071,192 @@ provider %[1]q {
 		return nil, fmt.Errorf("unable to write provider.tf file: %w", err)
 	}
 
-	i := install.NewInstaller()
-	var sources []src.Source
-	if g.tfVersion != "" {
-		g.infof("downloading Terraform CLI binary version from releases.hashicorp.com: %s", g.tfVersion)
-		sources = []src.Source{
-			&releases.ExactVersion{
-				Product:    product.Terraform,
-				Version:    version.Must(version.NewVersion(g.tfVersion)),
-				InstallDir: tmpDir,
-			},
-		}
-	} else {
-		g.infof("using Terraform CLI binary from PATH if available, otherwise downloading latest Terraform CLI binary")
-		sources = []src.Source{
-			&fs.AnyVersion{
-				Product: &product.Terraform,
-			},
-			&checkpoint.LatestVersion{
-				InstallDir: tmpDir,
-				Product:    product.Terraform,
-			},
-		}
+	schemas, schemaJSON, err := g.terraformProvidersSchema(ctx, cli, tmpDir, tfexec.Get(false), tfexec.PluginDir("./plugins"))
+	if err != nil {
+		return nil, err
 	}
 
-	tfBin, err := i.Ensure(context.Background(), sources)
+	g.actionSchemas, err = extractActionSchemasByAddress(schemaJSON, shortName, hostname+"/hashicorp/"+shortName)
 	if err != nil {
-		return nil, fmt.Errorf("unable to download Terraform binary: %w", err)
+		return nil, fmt.Errorf("unable to retrieve action schemas from terraform exec: %w", err)
 	}
 
-	tf, err := tfexec.NewTerraform(tmpDir, tfBin)
+	g.attributeDefaults, err = extractAttributeDefaultsByAddress(schemaJSON, shortName, hostname+"/hashicorp/"+shortName)
 	if err != nil {
-		return nil, fmt.Errorf("unable to create new terraform exec instance: %w", err)
+		return nil, fmt.Errorf("unable to retrieve attribute defaults from terraform exec: %w", err)
 	}
 
-	g.infof("running terraform init")
-	err = tf.Init(ctx, tfexec.Get(false), tfexec.PluginDir("./plugins"))
+	g.attributeValidators, err = extractAttributeValidatorsByAddress(schemaJSON, shortName, hostname+"/hashicorp/"+shortName)
 	if err != nil {
-		return nil, fmt.Errorf("unable to run terraform init on provider: %w", err)
+		return nil, fmt.Errorf("unable to retrieve attribute validators from terraform exec: %w", err)
 	}
 
-	g.infof("getting provider schema")
-	schemas, err := tf.ProvidersSchema(ctx)
+	g.attributeReplacements, err = extractAttributeReplacementsByAddress(schemaJSON, shortName, hostname+"/hashicorp/"+shortName)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve provider schema from terraform exec: %w", err)
+		return nil, fmt.Errorf("unable to retrieve attribute replacements from terraform exec: %w", err)
 	}
 
 	if ps, ok := schemas.Schemas[shortName]; ok {
 		return ps, nil
 	}
 
-	if ps, ok := schemas.Schemas["registry.terraform.io/hashicorp/"+shortName]; ok {
+	if ps, ok := schemas.Schemas[hostname+"/hashicorp/"+shortName]; ok {
 		return ps, nil
 	}
 
 	return nil, fmt.Errorf("unable to find schema in JSON for provider %q", shortName)
 }
 
-func (g *generator) terraformProviderSchemaFromFile() (*tfjson.ProviderSchema, error) {
+// terraformProviderSchemaFromRegistry installs the registry provider with
+// Terraform in a temporary directory and returns its schema.
+func (g *generator) terraformProviderSchemaFromRegistry(ctx context.Context) (*tfjson.ProviderSchema, error) {
+	tmpDir, err := os.MkdirTemp("", "tfws")
+	if err != nil {
+		return nil, fmt.Errorf("unable to create temporary provider install directory %q: %w", tmpDir, err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	localName := g.registryProvider[strings.LastIndex(g.registryProvider, "/")+1:]
+
+	err = writeFile(filepath.Join(tmpDir, "provider.tf"), fmt.Sprintf("%s\n\nprovider %q {\n}\n", requiredProvidersBlock(g.registryProvider, g.registryVersion), localName))
+	if err != nil {
+		return nil, fmt.Errorf("unable to write provider.tf file: %w", err)
+	}
+
+	// Terraform installs the provider from the registry, using the plugin
+	// cache directory and any filesystem mirrors of the CLI configuration,
+	// unless plugin dirs are set, which are used exclusively.
+	pluginDirs := slices.Clone(g.pluginDirs)
+	if g.offline {
+		if cacheDir := os.Getenv("TF_PLUGIN_CACHE_DIR"); cacheDir != "" {
+			pluginDirs = append(pluginDirs, cacheDir)
+		}
+
+		if len(pluginDirs) == 0 {
+			return nil, fmt.Errorf("registry provider cannot be installed in offline mode without plugin dirs or the TF_PLUGIN_CACHE_DIR plugin cache directory")
+		}
+	}
+
+	var initOptions []tfexec.InitOption
+	for _, dir := range pluginDirs {
+		initOptions = append(initOptions, tfexec.PluginDir(dir))
+	}
+
+	cli, err := g.terraformCLI(ctx, tmpDir)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, schemaJSON, err := g.terraformProvidersSchema(ctx, cli, tmpDir, initOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	address := providerSourceAddress(g.registryProvider, cli.registryHostname())
+
+	g.actionSchemas, err = extractActionSchemasByAddress(schemaJSON, address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve action schemas from terraform exec: %w", err)
+	}
+
+	g.attributeDefaults, err = extractAttributeDefaultsByAddress(schemaJSON, address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve attribute defaults from terraform exec: %w", err)
+	}
+
+	g.attributeValidators, err = extractAttributeValidatorsByAddress(schemaJSON, address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve attribute validators from terraform exec: %w", err)
+	}
+
+	g.attributeReplacements, err = extractAttributeReplacementsByAddress(schemaJSON, address)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve attribute replacements from terraform exec: %w", err)
+	}
+
+	if ps, ok := schemas.Schemas[address]; ok {
+		return ps, nil
+	}
+
+	return nil, fmt.Errorf("unable to find schema in JSON for provider %q", address)
+}
+
+// terraformCLI returns the Terraform or OpenTofu CLI binary used to export
+// provider schemas, downloading Terraform into dir if needed.
+func (g *generator) terraformCLI(ctx context.Context, dir string) (*terraformCLI, error) {
+	return ensureTerraformCLI(ctx, terraformCLIOptions{
+		binary:      g.tfBinary,
+		useOpenTofu: g.useOpenTofu,
+		version:     g.tfVersion,
+		installDir:  g.tfInstallDir,
+		offline:     g.offline,
+	}, dir, g.infof)
+}
+
+// terraformProvidersSchema runs init with the given options in dir using the
+// CLI, and returns the providers schema and its JSON.
+func (g *generator) terraformProvidersSchema(ctx context.Context, cli *terraformCLI, dir string, initOptions ...tfexec.InitOption) (*tfjson.ProviderSchemas, []byte, error) {
+	tf, err := tfexec.NewTerraform(dir, cli.path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to create new terraform exec instance: %w", err)
+	}
+
+	g.infof("running terraform init")
+	err = tf.Init(ctx, initOptions...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to run terraform init on provider: %w", err)
+	}
+
+	g.infof("getting provider schema")
+	var schemaJSON bytes.Buffer
+	tf.SetStdout(&schemaJSON)
+	schemas, err := tf.ProvidersSchema(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unable to retrieve provider schema from terraform exec: %w", err)
+	}
+
+	return schemas, schemaJSON.Bytes(), nil
+}
+
+func (g *generator) terraformProviderSchemaFromFile(ctx context.Context) (*tfjson.ProviderSchema, error) {
 	var err error
 
 	shortName := providerShortName(g.providerName)
 
 	g.infof("getting provider schema")
-	schemas, err := extractSchemaFromFile(g.providersSchemaPath)
+	// The JSON is read once, as it may be fetched from a URL.
+	schemajson := g.providersSchemaJSON
+	if schemajson == nil {
+		schemajson, err = readProvidersSchemaFile(ctx, g.providersSchemaPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve provider schema from JSON file: %w", err)
+		}
+	}
+
+	schemas := &tfjson.ProviderSchemas{}
+	err = schemas.UnmarshalJSON(schemajson)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve provider schema from JSON file: %w", jsonErrorPosition(schemajson, err))
+	}
+
+	g.actionSchemas, err = extractActionSchemas(schemajson, g.providerName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve action schemas from JSON file: %w", err)
+	}
+
+	g.attributeDefaults, err = extractAttributeDefaultsByAddress(schemajson, shortName, "registry.terraform.io/hashicorp/"+shortName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve attribute defaults from JSON file: %w", err)
+	}
+
+	g.attributeValidators, err = extractAttributeValidatorsByAddress(schemajson, shortName, "registry.terraform.io/hashicorp/"+shortName)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve attribute validators from JSON file: %w", err)
+	}
+
+	g.attributeReplacements, err = extractAttributeReplacementsByAddress(schemajson, shortName, "registry.terraform.io/hashicorp/"+shortName)
 	if err != nil {
-		return nil, fmt.Errorf("unable to retrieve provider schema from JSON file: %w", err)
+		return nil, fmt.Errorf("unable to retrieve attribute replacements from JSON file: %w", err)
 	}
 
 	if ps, ok := schemas.Schemas[shortName]; ok {
@@ -4,8 +4,10 @@
 package provider
 
 import (
+	"context"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/cli"
 )
 
@@ -22,7 +24,7 @@ func TestGenerator_terraformProviderSchemaFromFile(t *testing.T) {
 		ui:                  cli.NewMockUi(),
 	}
 
-	providerSchema, err := g.terraformProviderSchemaFromFile()
+	providerSchema, err := g.terraformProviderSchemaFromFile(context.Background())
 	if err != nil {
 		t.Fatalf("error retrieving schema: %q", err)
 	}
@@ -43,3 +45,262 @@ func TestGenerator_terraformProviderSchemaFromFile(t *testing.T) {
 		t.Fatalf("null_data_source id attribute not found")
 	}
 }
+
+func TestParseProviderVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerVersion string
+		expected        string
+		expectedError   string
+	}{
+		"empty": {
+			providerVersion: "",
+			expected:        "",
+		},
+		"version": {
+			providerVersion: "1.2.0",
+			expected:        "1.2.0",
+		},
+		"tag": {
+			providerVersion: "v1.2.0-beta.1",
+			expected:        "1.2.0-beta.1",
+		},
+		"invalid": {
+			providerVersion: "latest",
+			expectedError:   `invalid provider version "latest": Malformed version: latest`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := parseProviderVersion(testCase.providerVersion)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateRegistryOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		registryProvider    string
+		registryVersion     string
+		providersSchemaPath string
+		expectedError       string
+	}{
+		"unset": {},
+		"latest": {
+			registryProvider: "hashicorp/aws",
+		},
+		"version": {
+			registryProvider: "registry.terraform.io/hashicorp/aws",
+			registryVersion:  "5.60.0",
+		},
+		"version without provider": {
+			registryVersion: "5.60.0",
+			expectedError:   "registry version requires a registry provider",
+		},
+		"providers schema": {
+			registryProvider:    "hashicorp/aws",
+			providersSchemaPath: "schema.json",
+			expectedError:       "registry provider and providers schema cannot be used together",
+		},
+		"invalid provider": {
+			registryProvider: "aws",
+			expectedError:    `invalid registry provider: invalid provider source "aws", expected [<hostname>/]<namespace>/<type>`,
+		},
+		"invalid version": {
+			registryProvider: "hashicorp/aws",
+			registryVersion:  "~> 5.0",
+			expectedError:    `invalid registry version "~> 5.0": Malformed version: ~> 5.0`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRegistryOptions(testCase.registryProvider, testCase.registryVersion, testCase.providersSchemaPath)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestProviderSourceAddress(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		providerSource  string
+		defaultHostname string
+		expected        string
+	}{
+		"default hostname": {
+			providerSource:  "hashicorp/aws",
+			defaultHostname: "registry.terraform.io",
+			expected:        "registry.terraform.io/hashicorp/aws",
+		},
+		"opentofu default hostname": {
+			providerSource:  "hashicorp/aws",
+			defaultHostname: "registry.opentofu.org",
+			expected:        "registry.opentofu.org/hashicorp/aws",
+		},
+		"hostname": {
+			providerSource:  "example.com/example/aws",
+			defaultHostname: "registry.terraform.io",
+			expected:        "example.com/example/aws",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := providerSourceAddress(testCase.providerSource, testCase.defaultHostname)
+
+			if actual != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, actual)
+			}
+		})
+	}
+}
+
+func TestParseHeadings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values        []string
+		expected      map[string]string
+		expectedError string
+	}{
+		"empty": {
+			expected: map[string]string{},
+		},
+		"headings": {
+			values: []string{"example-usage=Usage", " read-only = Exported Attributes "},
+			expected: map[string]string{
+				"example-usage": "Usage",
+				"read-only":     "Exported Attributes",
+			},
+		},
+		"missing text": {
+			values:        []string{"schema="},
+			expectedError: `expected text of heading "schema"`,
+		},
+		"missing separator": {
+			values:        []string{"schema"},
+			expectedError: `invalid heading "schema", expected <name>=<text>`,
+		},
+		"unknown": {
+			values:        []string{"arguments=Arguments"},
+			expectedError: `unknown heading "arguments", expected one of: argument-reference, attributes-reference, example-usage, import, nested-schema, optional, read-only, related-resources, required, schema, sensitive-attributes, timeouts, write-only`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := parseHeadings(testCase.values)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseExampleValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		values        []string
+		expected      map[string]string
+		expectedError string
+	}{
+		"empty": {
+			expected: map[string]string{},
+		},
+		"values": {
+			values: []string{"VERSION=1.2.0", " REGION = us-east-1 ", "EMPTY=", "URL=https://example.com/?a=b"},
+			expected: map[string]string{
+				"VERSION": "1.2.0",
+				"REGION":  "us-east-1",
+				"EMPTY":   "",
+				"URL":     "https://example.com/?a=b",
+			},
+		},
+		"missing separator": {
+			values:        []string{"VERSION"},
+			expectedError: `invalid example value "VERSION", expected <name>=<value>`,
+		},
+		"invalid name": {
+			values:        []string{"@@VERSION@@=1.2.0"},
+			expectedError: `invalid example value name "@@VERSION@@", expected letters, digits, and underscores`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := parseExampleValues(testCase.values)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// runHook runs the command of the pre-generate or post-generate hook, a
+// program and its arguments separated by spaces, in the provider directory,
+// with the environment variables of hookEnv. Its output is output as info
+// messages.
+func (g *generator) runHook(ctx context.Context, name, command string, generated bool) error {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return fmt.Errorf("%s hook command is empty", name)
+	}
+
+	g.infof("running %s hook %q", name, command)
+
+	cmd := exec.CommandContext(ctx, args[0], args[1:]...)
+	cmd.Dir = g.providerDir
+	cmd.Env = append(os.Environ(), g.hookEnv(generated)...)
+
+	output, err := cmd.CombinedOutput()
+	if message := strings.TrimRight(string(output), "\n"); message != "" {
+		g.infof("%s", message)
+	}
+	if err != nil {
+		return fmt.Errorf("error running %s hook %q: %w", name, command, err)
+	}
+
+	return nil
+}
+
+// hookEnv returns the environment variables of hooks, which describe the
+// provider and the directories of the generation, and, once the website is
+// generated, the number of pages rendered and unchanged.
+func (g *generator) hookEnv(generated bool) []string {
+	env := []string{
+		"TFPLUGINDOCS_PROVIDER_DIR=" + g.providerDir,
+		"TFPLUGINDOCS_PROVIDER_NAME=" + g.providerName,
+		"TFPLUGINDOCS_RENDERED_WEBSITE_DIR=" + g.ProviderDocsDir(),
+		"TFPLUGINDOCS_EXAMPLES_DIR=" + g.ProviderExamplesDir(),
+		"TFPLUGINDOCS_TEMPLATES_DIR=" + g.ProviderTemplatesDir(),
+	}
+
+	if generated && g.stats != nil {
+		g.stats.mu.Lock()
+		defer g.stats.mu.Unlock()
+
+		env = append(env,
+			"TFPLUGINDOCS_PAGES_RENDERED="+strconv.Itoa(g.stats.counts[renderedPages]),
+			"TFPLUGINDOCS_PAGES_UNCHANGED="+strconv.Itoa(g.stats.counts[unchangedPages]),
+		)
+	}
+
+	return env
+}
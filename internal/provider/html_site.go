@@ -0,0 +1,217 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+const (
+	OutputFormatHTML     = "html"
+	OutputFormatMarkdown = "markdown"
+)
+
+// OutputFormats are the supported output formats of generate:
+//
+//   - markdown: the rendered website directory is written.
+//   - html: the rendered website directory is additionally converted into a
+//     static HTML site in the HTML directory.
+var OutputFormats = []string{
+	OutputFormatMarkdown,
+	OutputFormatHTML,
+}
+
+// htmlSiteMarkdownLink matches the href attributes of relative links to
+// Markdown files, which are replaced with links to the converted HTML files.
+var htmlSiteMarkdownLink = regexp.MustCompile(`href="([^"#:?]+?)(\.html\.markdown|\.html\.md|\.markdown|\.md)(#[^"]*)?"`)
+
+// validateOutputFormat returns an error if the output format is not
+// supported.
+func validateOutputFormat(outputFormat string) error {
+	if outputFormat != "" && !slices.Contains(OutputFormats, outputFormat) {
+		return fmt.Errorf("unsupported output format %q, expected one of: %s", outputFormat, strings.Join(OutputFormats, ", "))
+	}
+
+	return nil
+}
+
+// ProviderHTMLDir returns the absolute path of the static HTML site directory.
+func (g *generator) ProviderHTMLDir() string {
+	if filepath.IsAbs(g.htmlDir) {
+		return g.htmlDir
+	}
+
+	return filepath.Join(g.providerDir, g.htmlDir)
+}
+
+// writeHTMLSite converts the Markdown files of the rendered website directory
+// into a static HTML site, with the navigation and theme of served pages and
+// relative links, replacing the HTML directory. Other files are copied.
+func (g *generator) writeHTMLSite() error {
+	htmlDir := g.ProviderHTMLDir()
+
+	// The HTML directory is removed before writing, so it must not overlap
+	// the provider source or rendered files.
+	if containsPath(htmlDir, g.providerDir) {
+		return fmt.Errorf("HTML directory %q must not contain the provider directory", g.htmlDir)
+	}
+
+	for _, dir := range []string{g.ProviderDocsDir(), g.ProviderTemplatesDir(), g.ProviderExamplesDir()} {
+		if containsPath(htmlDir, dir) || containsPath(dir, htmlDir) {
+			return fmt.Errorf("HTML directory %q must not overlap %q", g.htmlDir, dir)
+		}
+	}
+
+	g.infof("writing static HTML site to %q", g.htmlDir)
+
+	err := os.RemoveAll(htmlDir)
+	if err != nil {
+		return fmt.Errorf("unable to remove HTML directory %q: %w", g.htmlDir, err)
+	}
+
+	docsDir := g.ProviderDocsDir()
+
+	nav, err := servedNavigation(docsDir)
+	if err != nil {
+		return err
+	}
+
+	return filepath.WalkDir(docsDir, func(p string, d os.DirEntry, err error) error {
+		if err != nil {
+			return fmt.Errorf("unable to walk path %q: %w", p, err)
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(docsDir, p)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve the relative path of basepath %q and targetpath %q: %w", docsDir, p, err)
+		}
+
+		if !isMarkdownFile(p) {
+			return copyFile(p, filepath.Join(htmlDir, rel), 0644)
+		}
+
+		content, err := os.ReadFile(p)
+		if err != nil {
+			return fmt.Errorf("unable to read file %q: %w", rel, err)
+		}
+
+		urlPath := servedURLPath(rel)
+
+		page, err := renderHTMLSitePage(content, g.renderedProviderName, urlPath, nav)
+		if err != nil {
+			return fmt.Errorf("unable to convert %q to HTML: %w", rel, err)
+		}
+
+		return writeFile(filepath.Join(htmlDir, filepath.FromSlash(urlPath)+".html"), page)
+	})
+}
+
+// renderHTMLSitePage returns the static HTML page of a rendered Markdown page
+// with the given slash-separated URL path, without extension. Links of the
+// navigation and to other Markdown files are relative to the page.
+func renderHTMLSitePage(content []byte, providerName, urlPath string, nav []servedNavSection) (string, error) {
+	page, err := renderServedPage(content)
+	if err != nil {
+		return "", err
+	}
+
+	root := strings.Repeat("../", strings.Count(urlPath, "/"))
+
+	relativeNav := make([]servedNavSection, 0, len(nav))
+	for _, section := range nav {
+		links := make([]servedNavLink, 0, len(section.Links))
+		for _, link := range section.Links {
+			links = append(links, servedNavLink{
+				Name: link.Name,
+				Path: root + strings.TrimPrefix(link.Path, "/") + ".html",
+			})
+		}
+
+		relativeNav = append(relativeNav, servedNavSection{Title: section.Title, Links: links})
+	}
+
+	data := htmlSitePageData{
+		ProviderName: providerName,
+		Title:        page.title,
+		Subcategory:  page.subcategory,
+		Content:      template.HTML(htmlSiteMarkdownLink.ReplaceAllString(string(page.content), `href="$1.html$3"`)),
+		Navigation:   relativeNav,
+		Index:        root + "index.html",
+		Current:      root + urlPath + ".html",
+	}
+
+	var b bytes.Buffer
+
+	err = htmlSitePageTemplate.Execute(&b, data)
+	if err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+// containsPath returns true if path is equal to, or inside of, dir.
+func containsPath(dir, path string) bool {
+	rel, err := filepath.Rel(dir, path)
+	if err != nil {
+		return false
+	}
+
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+type htmlSitePageData struct {
+	ProviderName string
+	Title        string
+	Subcategory  string
+	Content      template.HTML
+	Navigation   []servedNavSection
+	Index        string
+	Current      string
+}
+
+var htmlSitePageTemplate = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{ if .Title }}{{ .Title }} | {{ end }}{{ .ProviderName }} documentation</title>
+<style>
+` + docsPageStyle + `</style>
+</head>
+<body>
+<header><a href="{{ .Index }}">{{ .ProviderName }}</a></header>
+<div class="container">
+<nav>
+<ul><li><a href="{{ .Index }}"{{ if eq .Current .Index }} class="current"{{ end }}>Overview</a></li></ul>
+{{- range .Navigation }}
+<h4>{{ .Title }}</h4>
+<ul>
+{{- range .Links }}
+<li><a href="{{ .Path }}"{{ if eq .Path $.Current }} class="current"{{ end }}>{{ .Name }}</a></li>
+{{- end }}
+</ul>
+{{- end }}
+</nav>
+<main>
+{{- if .Subcategory }}
+<div class="subcategory">{{ .Subcategory }}</div>
+{{- end }}
+{{ .Content }}
+</main>
+</div>
+</body>
+</html>
+`))
@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRenderHTMLSitePage(t *testing.T) {
+	t.Parallel()
+
+	content := []byte(`---
+page_title: "scaffolding_example Resource - terraform-provider-scaffolding"
+subcategory: "Storage"
+---
+
+# scaffolding_example (Resource)
+
+Refer to [the data source](../data-sources/example.md#schema), [the guide](../guides/getting-started.html.markdown), and [Terraform](https://developer.hashicorp.com/terraform/docs.md).
+
+- ` + "`setting`" + ` (Block List) (see [below for nested schema](#nestedblock--setting))
+
+<a id="nestedblock--setting"></a>
+### Nested Schema for ` + "`setting`" + `
+`)
+
+	nav := []servedNavSection{
+		{
+			Title: "Resources",
+			Links: []servedNavLink{
+				{Name: "example", Path: "/resources/example"},
+			},
+		},
+	}
+
+	actual, err := renderHTMLSitePage(content, "terraform-provider-scaffolding", "resources/example", nav)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, expected := range []string{
+		`<title>scaffolding_example Resource - terraform-provider-scaffolding | terraform-provider-scaffolding documentation</title>`,
+		`<header><a href="../index.html">terraform-provider-scaffolding</a></header>`,
+		`<li><a href="../resources/example.html" class="current">example</a></li>`,
+		`<div class="subcategory">Storage</div>`,
+		`<a href="../data-sources/example.html#schema">the data source</a>`,
+		`<a href="../guides/getting-started.html">the guide</a>`,
+		`<a href="https://developer.hashicorp.com/terraform/docs.md">Terraform</a>`,
+		`<a href="#nestedblock--setting">below for nested schema</a>`,
+		`<a id="nestedblock--setting"></a>`,
+	} {
+		if !strings.Contains(actual, expected) {
+			t.Errorf("expected page to contain %q, got:\n%s", expected, actual)
+		}
+	}
+}
+
+func TestContainsPath(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join("provider", "docs-html")
+
+	testCases := map[string]struct {
+		path     string
+		expected bool
+	}{
+		"equal": {
+			path:     dir,
+			expected: true,
+		},
+		"inside": {
+			path:     filepath.Join(dir, "resources"),
+			expected: true,
+		},
+		"sibling": {
+			path:     filepath.Join("provider", "docs"),
+			expected: false,
+		},
+		"parent": {
+			path:     "provider",
+			expected: false,
+		},
+		"prefix": {
+			path:     filepath.Join("provider", "docs-html-old"),
+			expected: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := containsPath(dir, testCase.path)
+
+			if actual != testCase.expected {
+				t.Errorf("expected %t, got %t", testCase.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// indexItem is a resource, data source, ephemeral resource, list resource,
+// action, or function listed in the item index of the provider index page,
+// and in the navigation file.
+type indexItem struct {
+	name string
+
+	// path is the slash-separated path of the rendered page of the item,
+	// relative to the rendered website directory.
+	path string
+
+	description string
+	subcategory string
+}
+
+// indexSection contains the generated items of an item type, sorted by
+// subcategory and name.
+type indexSection struct {
+	title string
+	items []indexItem
+}
+
+// itemIndexMarkdown returns a Markdown overview of the generated resources,
+// data sources, ephemeral resources, list resources, actions, and functions,
+// with a section for each item type. Each item is listed with a link to its
+// page and the first line of its description, grouped by the subcategory
+// assigned by the subcategory file. Items without a subcategory are listed
+// first.
+func (g *generator) itemIndexMarkdown(providerSchema *tfjson.ProviderSchema) string {
+	b := &strings.Builder{}
+
+	for _, section := range g.indexSections(providerSchema) {
+		writeIndexSection(b, section)
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// indexSections returns the sections of the item types with generated items.
+func (g *generator) indexSections(providerSchema *tfjson.ProviderSchema) []indexSection {
+	schemaSections := []struct {
+		title   string
+		dir     string
+		schemas map[string]*tfjson.Schema
+	}{
+		{"Resources", "resources", providerSchema.ResourceSchemas},
+		{"Data Sources", "data-sources", providerSchema.DataSourceSchemas},
+		{"Ephemeral Resources", "ephemeral-resources", providerSchema.EphemeralResourceSchemas},
+		{"List Resources", "list-resources", providerSchema.ListResourceSchemas},
+		{"Actions", "actions", g.actionSchemas},
+	}
+
+	var sections []indexSection
+
+	for _, schemaSection := range schemaSections {
+		section := indexSection{title: schemaSection.title}
+
+		for name, schema := range schemaSection.schemas {
+			if g.ignoreDeprecated && schema.Block.Deprecated {
+				continue
+			}
+
+			if g.skipItem(schemaSection.dir, name) {
+				continue
+			}
+
+			section.items = append(section.items, indexItem{
+				name:        name,
+				path:        g.renderedItemPath(schemaSection.dir, name),
+				description: schema.Block.Description,
+				subcategory: g.itemSubcategory(schemaSection.dir, name),
+			})
+		}
+
+		sections = appendIndexSection(sections, section)
+	}
+
+	functions := indexSection{title: "Functions"}
+
+	for name, signature := range providerSchema.Functions {
+		if g.ignoreDeprecated && signature.DeprecationMessage != "" {
+			continue
+		}
+
+		if g.skipItem("functions", name) {
+			continue
+		}
+
+		description := signature.Summary
+		if strings.TrimSpace(description) == "" {
+			description = signature.Description
+		}
+
+		functions.items = append(functions.items, indexItem{
+			name:        name,
+			path:        g.renderedItemPath("functions", name),
+			description: description,
+			subcategory: g.itemSubcategory("functions", name),
+		})
+	}
+
+	return appendIndexSection(sections, functions)
+}
+
+// appendIndexSection sorts the items of the section and appends it to
+// sections, unless there are no items.
+func appendIndexSection(sections []indexSection, section indexSection) []indexSection {
+	if len(section.items) == 0 {
+		return sections
+	}
+
+	sort.Slice(section.items, func(i, j int) bool {
+		if section.items[i].subcategory != section.items[j].subcategory {
+			return section.items[i].subcategory < section.items[j].subcategory
+		}
+
+		return section.items[i].name < section.items[j].name
+	})
+
+	return append(sections, section)
+}
+
+// renderedItemPath returns the slash-separated path of the rendered page of
+// the named item, in the given rendered website subdirectory, relative to the
+// rendered website directory.
+func (g *generator) renderedItemPath(dir, name string) string {
+	ext := g.outputExtension
+	if ext == "" {
+		ext = FileExtensionMd
+	}
+
+	rel := path.Join(dir, resourceShortName(name, g.providerName)+ext)
+
+	return filepath.ToSlash(renderedSubDirectory(filepath.FromSlash(rel), g.outputExtension))
+}
+
+// writeIndexSection writes the section of an item type.
+func writeIndexSection(b *strings.Builder, section indexSection) {
+	if b.Len() > 0 {
+		b.WriteString("\n")
+	}
+
+	fmt.Fprintf(b, "## %s\n\n", section.title)
+
+	items := section.items
+
+	for i, item := range items {
+		if item.subcategory != "" && (i == 0 || items[i-1].subcategory != item.subcategory) {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+
+			fmt.Fprintf(b, "### %s\n\n", item.subcategory)
+		}
+
+		fmt.Fprintf(b, "- [%s](%s)", item.name, item.path)
+
+		if description := firstLine(item.description); description != "" {
+			fmt.Fprintf(b, " - %s", description)
+		}
+
+		b.WriteString("\n")
+	}
+}
+
+// firstLine returns the first non-empty line of s, without surrounding
+// whitespace.
+func firstLine(s string) string {
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
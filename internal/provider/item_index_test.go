@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestGenerator_itemIndexMarkdown(t *testing.T) {
+	t.Parallel()
+
+	subcategories, err := parseSubcategoryMapping([]byte("resources/scaffolding_s3_*: Storage\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	ignore, err := newItemFilter([]string{"scaffolding_internal"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	g := &generator{
+		ignoreDeprecated: true,
+		ignore:           ignore,
+		subcategories:    subcategories,
+		providerName:     "terraform-provider-scaffolding",
+		outputExtension:  ".md",
+	}
+
+	schema := func(description string, deprecated bool) *tfjson.Schema {
+		return &tfjson.Schema{
+			Block: &tfjson.SchemaBlock{
+				Description: description,
+				Deprecated:  deprecated,
+			},
+		}
+	}
+
+	providerSchema := &tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example":   schema("Example resource\n\nMore details.", false),
+			"scaffolding_internal":  schema("Internal resource", false),
+			"scaffolding_legacy":    schema("Legacy resource", true),
+			"scaffolding_s3_bucket": schema("S3 bucket resource", false),
+			"scaffolding_s3_object": schema("", false),
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": schema("Example data source", false),
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"echo": {
+				Summary:     "Echo a value",
+				Description: "Given a value, returns the same value.",
+			},
+		},
+	}
+
+	expected := `## Resources
+
+- [scaffolding_example](resources/example.md) - Example resource
+
+### Storage
+
+- [scaffolding_s3_bucket](resources/s3_bucket.md) - S3 bucket resource
+- [scaffolding_s3_object](resources/s3_object.md)
+
+## Data Sources
+
+- [scaffolding_example](data-sources/example.md) - Example data source
+
+## Functions
+
+- [echo](functions/echo.md) - Echo a value`
+
+	actual := g.itemIndexMarkdown(providerSchema)
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,218 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"gopkg.in/yaml.v3"
+)
+
+// localeMessagesFile is the name of the message catalog file in the
+// templates subdirectory of a locale.
+const localeMessagesFile = "messages.yml"
+
+// localeName matches locale names, which are language tags such as "ja" or
+// "pt-BR".
+var localeName = regexp.MustCompile(`^[a-z]{2,3}([-_][A-Za-z0-9]+)*$`)
+
+// localeCatalog is the message catalog of a locale, for example:
+//
+//	headings:
+//	  example-usage: 使用例
+//	  import: インポート
+type localeCatalog struct {
+	// Headings are the texts of the headings of the default templates and
+	// schemas in the locale, by name, which take precedence over the
+	// headings of the default documentation.
+	Headings map[string]string `yaml:"headings"`
+}
+
+// validateLocales returns an error if any of the locales is not a valid
+// locale name or is duplicated.
+func validateLocales(locales []string) error {
+	seen := make(map[string]bool, len(locales))
+
+	for _, locale := range locales {
+		if !localeName.MatchString(locale) {
+			return fmt.Errorf("invalid locale %q, expected a language tag such as \"ja\" or \"pt-BR\"", locale)
+		}
+
+		if seen[locale] {
+			return fmt.Errorf("duplicate locale %q", locale)
+		}
+		seen[locale] = true
+	}
+
+	return nil
+}
+
+// localeRenderedWebsiteDir returns the rendered website directory of the
+// locale, which is a sibling of the rendered website directory with the
+// locale appended to its name, such as "docs-ja".
+func localeRenderedWebsiteDir(renderedWebsiteDir, locale string) string {
+	return filepath.Clean(renderedWebsiteDir) + "-" + locale
+}
+
+// localeTemplatesDir returns the absolute path to the templates subdirectory
+// of the locale.
+func (g *generator) localeTemplatesDir(locale string) string {
+	return filepath.Join(g.ProviderTemplatesDir(), locale)
+}
+
+// localeHeadings returns the headings of the locale, which are the headings
+// of the locale message catalog, if it exists, over the headings of the
+// default documentation.
+func (g *generator) localeHeadings(locale string) (map[string]string, error) {
+	path := filepath.Join(g.localeTemplatesDir(locale), localeMessagesFile)
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return g.headings, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read message catalog %q: %w", path, err)
+	}
+
+	var catalog localeCatalog
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err = decoder.Decode(&catalog)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("unable to parse message catalog %q: %w", path, err)
+	}
+
+	headings := make(map[string]string, len(g.headings)+len(catalog.Headings))
+	for name, text := range g.headings {
+		headings[name] = text
+	}
+
+	for _, name := range sortedKeys(catalog.Headings) {
+		err = validateHeading(name, catalog.Headings[name])
+		if err != nil {
+			return nil, fmt.Errorf("invalid message catalog %q: %w", path, err)
+		}
+
+		headings[name] = catalog.Headings[name]
+	}
+
+	return headings, nil
+}
+
+// generateLocales generates the documentation of each locale, after the
+// default documentation, in the same mode.
+func (g *generator) generateLocales(ctx context.Context, providerSchema *tfjson.ProviderSchema) error {
+	for _, locale := range g.locales {
+		err := g.generateLocale(ctx, locale, providerSchema)
+		if err != nil {
+			return fmt.Errorf("error generating locale %q: %w", locale, err)
+		}
+	}
+
+	return nil
+}
+
+// generateLocale renders, checks, or dry runs the documentation of the locale
+// into the rendered website directory of the locale. The templates of the
+// locale override the templates of the same path in the templates directory,
+// and its examples override the examples of the same path in the examples
+// directory.
+func (g *generator) generateLocale(ctx context.Context, locale string, providerSchema *tfjson.ProviderSchema) error {
+	headings, err := g.localeHeadings(locale)
+	if err != nil {
+		return err
+	}
+
+	lg := *g
+	lg.locale = locale
+	lg.renderedWebsiteDir = localeRenderedWebsiteDir(g.renderedWebsiteDir, locale)
+	lg.headings = headings
+	lg.templateSources = nil
+	lg.customRegions = nil
+
+	// The render cache only contains the pages of the default documentation.
+	lg.cache = nil
+
+	g.infof("generating locale %q documentation into %q", locale, lg.renderedWebsiteDir)
+
+	lg.websiteTmpDir, err = os.MkdirTemp("", "tfws-"+locale)
+	if err != nil {
+		return fmt.Errorf("error creating temporary website directory: %w", err)
+	}
+	defer os.RemoveAll(lg.websiteTmpDir)
+
+	err = lg.copyTemplates()
+	if err != nil {
+		return err
+	}
+
+	err = lg.copyLocaleTemplates()
+	if err != nil {
+		return err
+	}
+
+	err = lg.generateMissingTemplates(providerSchema)
+	if err != nil {
+		return fmt.Errorf("error generating missing templates: %w", err)
+	}
+
+	lg.customRegions, err = loadCustomRegions(lg.ProviderDocsDir())
+	if err != nil {
+		return fmt.Errorf("error loading custom regions: %w", err)
+	}
+
+	switch {
+	case lg.check:
+		err = lg.checkStaticWebsite(ctx, providerSchema)
+		if err != nil {
+			return fmt.Errorf("error checking static website: %w", err)
+		}
+	case lg.dryRun:
+		err = lg.dryRunStaticWebsite(ctx, providerSchema)
+		if err != nil {
+			return fmt.Errorf("error in dry run of static website: %w", err)
+		}
+	default:
+		err = lg.renderStaticWebsite(ctx, providerSchema)
+		if err != nil {
+			return fmt.Errorf("error rendering static website: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// copyLocaleTemplates copies the contents of the templates subdirectory of
+// the locale being generated, if it exists, except for its message catalog,
+// over the temporary templates directory.
+func (g *generator) copyLocaleTemplates() error {
+	localeTemplatesDir := g.localeTemplatesDir(g.locale)
+
+	if !dirExists(localeTemplatesDir) {
+		return nil
+	}
+
+	g.infof("copying locale %q templates to tmp dir", g.locale)
+	err := cp(localeTemplatesDir, g.TempTemplatesDir())
+	if err != nil {
+		return fmt.Errorf("error copying locale %q templates to temporary directory %q: %w", g.locale, g.TempTemplatesDir(), err)
+	}
+
+	err = os.Remove(filepath.Join(g.TempTemplatesDir(), localeMessagesFile))
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("error removing message catalog from temporary directory %q: %w", g.TempTemplatesDir(), err)
+	}
+
+	return nil
+}
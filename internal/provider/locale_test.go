@@ -0,0 +1,66 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"path/filepath"
+	"testing"
+)
+
+func TestValidateLocales(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		locales       []string
+		expectedError string
+	}{
+		"none": {},
+		"valid": {
+			locales: []string{"ja", "pt-BR", "zh_Hant"},
+		},
+		"path": {
+			locales:       []string{"../ja"},
+			expectedError: `invalid locale "../ja", expected a language tag such as "ja" or "pt-BR"`,
+		},
+		"template directory": {
+			locales:       []string{"resources"},
+			expectedError: `invalid locale "resources", expected a language tag such as "ja" or "pt-BR"`,
+		},
+		"duplicate": {
+			locales:       []string{"ja", "ja"},
+			expectedError: `duplicate locale "ja"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateLocales(testCase.locales)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestLocaleRenderedWebsiteDir(t *testing.T) {
+	t.Parallel()
+
+	actual := localeRenderedWebsiteDir(filepath.Join("website", "docs")+string(filepath.Separator), "ja")
+	expected := filepath.Join("website", "docs-ja")
+
+	if actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
@@ -21,7 +21,66 @@ func (l *Logger) infof(format string, args ...interface{}) {
 	l.ui.Info(fmt.Sprintf(format, args...))
 }
 
-//nolint:unused
 func (l *Logger) warnf(format string, args ...interface{}) {
 	l.ui.Warn(fmt.Sprintf(format, args...))
 }
+
+// PageUi is implemented by a cli.Ui which outputs the messages about a page
+// with the path of the page, such as structured log events, instead of only
+// the message.
+type PageUi interface {
+	cli.Ui
+
+	PageInfo(page, message string)
+	PageWarn(page, message string)
+}
+
+// bufferedLogger collects log messages so they can be output later, such as
+// when rendering files concurrently while keeping output deterministic.
+type bufferedLogger struct {
+	// page is the slash-separated path of the page which the messages are
+	// about, relative to the rendered website directory.
+	page string
+
+	entries []bufferedLogEntry
+}
+
+type bufferedLogEntry struct {
+	warn    bool
+	message string
+}
+
+func (l *bufferedLogger) infof(format string, args ...interface{}) {
+	l.entries = append(l.entries, bufferedLogEntry{message: fmt.Sprintf(format, args...)})
+}
+
+func (l *bufferedLogger) warnf(format string, args ...interface{}) {
+	l.entries = append(l.entries, bufferedLogEntry{warn: true, message: fmt.Sprintf(format, args...)})
+}
+
+// flush outputs all collected messages to the given cli.Ui, with the page if
+// it is a PageUi, and resets the logger.
+func (l *bufferedLogger) flush(ui cli.Ui) {
+	pageUi, isPageUi := ui.(PageUi)
+
+	for _, entry := range l.entries {
+		if isPageUi && l.page != "" {
+			if entry.warn {
+				pageUi.PageWarn(l.page, entry.message)
+				continue
+			}
+
+			pageUi.PageInfo(l.page, entry.message)
+			continue
+		}
+
+		if entry.warn {
+			ui.Warn(entry.message)
+			continue
+		}
+
+		ui.Info(entry.message)
+	}
+
+	l.entries = nil
+}
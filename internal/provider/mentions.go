@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// dataSourceMentionPrefix is the prefix of mentions of data sources which
+// share the name of a resource, as in Terraform references, e.g.
+// "data.scaffolding_example".
+const dataSourceMentionPrefix = "data."
+
+// codeSpanRegexp matches a single backtick code span.
+var codeSpanRegexp = regexp.MustCompile("`([^`]+)`")
+
+// mentionTargets are the slash-separated paths of the rendered pages of the
+// generated resources and data sources by name, relative to the rendered
+// website directory, which mentions are linked to.
+type mentionTargets struct {
+	resources   map[string]string
+	dataSources map[string]string
+}
+
+// itemMentionTargets returns the rendered page paths of the generated
+// resources and data sources.
+func (g *generator) itemMentionTargets(providerSchema *tfjson.ProviderSchema) *mentionTargets {
+	targets := func(dir string, schemas map[string]*tfjson.Schema) map[string]string {
+		paths := make(map[string]string, len(schemas))
+		for name, schema := range schemas {
+			if g.ignoreDeprecated && schema.Block.Deprecated {
+				continue
+			}
+
+			if g.skipItem(dir, name) {
+				continue
+			}
+
+			paths[name] = g.renderedItemPath(dir, name)
+		}
+		return paths
+	}
+
+	return &mentionTargets{
+		resources:   targets("resources", providerSchema.ResourceSchemas),
+		dataSources: targets("data-sources", providerSchema.DataSourceSchemas),
+	}
+}
+
+// names returns the sorted names of the targets, with data sources prefixed
+// with "data.".
+func (t *mentionTargets) names() []string {
+	names := sortedKeys(t.resources)
+	for _, name := range sortedKeys(t.dataSources) {
+		names = append(names, dataSourceMentionPrefix+name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// target returns the path of the rendered page of the mentioned resource or
+// data source, preferring resources for names which are both, unless the
+// mention is prefixed with "data.".
+func (t *mentionTargets) target(mention string) (string, bool) {
+	if t == nil {
+		return "", false
+	}
+
+	if name, ok := strings.CutPrefix(mention, dataSourceMentionPrefix); ok {
+		target, ok := t.dataSources[name]
+		return target, ok
+	}
+
+	if target, ok := t.resources[mention]; ok {
+		return target, true
+	}
+
+	target, ok := t.dataSources[mention]
+	return target, ok
+}
+
+// linkItemMentions converts code spans of the rendered page at renderedRel
+// which only contain the name of a generated resource or data source, e.g.
+// `scaffolding_example`, into relative links to its page. Code spans in
+// frontmatter, headings, code blocks, and existing links, and mentions of
+// the page itself, are not linked.
+func linkItemMentions(content, renderedRel string, targets *mentionTargets) string {
+	lines := strings.Split(content, "\n")
+	inFrontmatter := len(lines) > 0 && lines[0] == "---"
+	var fence string
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case inFrontmatter:
+			if i > 0 && line == "---" {
+				inFrontmatter = false
+			}
+			continue
+		case fence != "":
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		case strings.HasPrefix(trimmed, "```"):
+			fence = "```"
+			continue
+		case strings.HasPrefix(trimmed, "~~~"):
+			fence = "~~~"
+			continue
+		case strings.HasPrefix(trimmed, "#"):
+			continue
+		}
+
+		lines[i] = linkLineMentions(line, renderedRel, targets)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// linkLineMentions converts the code spans of a line which mention a
+// generated resource or data source into relative links to its page.
+func linkLineMentions(line, renderedRel string, targets *mentionTargets) string {
+	b := &strings.Builder{}
+	last := 0
+
+	for _, match := range codeSpanRegexp.FindAllStringSubmatchIndex(line, -1) {
+		start, end := match[0], match[1]
+
+		// code spans which are the text of a link are already linked
+		if start > 0 && line[start-1] == '[' && end < len(line) && line[end] == ']' {
+			continue
+		}
+
+		target, ok := targets.target(line[match[2]:match[3]])
+		if !ok || target == renderedRel {
+			continue
+		}
+
+		b.WriteString(line[last:start])
+		b.WriteString("[" + line[start:end] + "](" + relativeLink(renderedRel, target) + ")")
+		last = end
+	}
+
+	b.WriteString(line[last:])
+
+	return b.String()
+}
+
+// relativeLink returns the link to the slash-separated target path from the
+// page at the slash-separated path from, both relative to the rendered
+// website directory.
+func relativeLink(from, target string) string {
+	fromDirs := strings.Split(path.Dir(from), "/")
+	if fromDirs[0] == "." {
+		fromDirs = nil
+	}
+
+	targetParts := strings.Split(target, "/")
+
+	common := 0
+	for common < len(fromDirs) && common < len(targetParts)-1 && fromDirs[common] == targetParts[common] {
+		common++
+	}
+
+	return strings.Repeat("../", len(fromDirs)-common) + strings.Join(targetParts[common:], "/")
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestGenerator_itemMentionTargets(t *testing.T) {
+	t.Parallel()
+
+	g := &generator{
+		ignoreDeprecated: true,
+		providerName:     "terraform-provider-scaffolding",
+		outputExtension:  ".html.markdown",
+	}
+
+	targets := g.itemMentionTargets(&tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {Block: &tfjson.SchemaBlock{}},
+			"scaffolding_legacy":  {Block: &tfjson.SchemaBlock{Deprecated: true}},
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {Block: &tfjson.SchemaBlock{}},
+		},
+	})
+
+	expected := &mentionTargets{
+		resources:   map[string]string{"scaffolding_example": "r/example.html.markdown"},
+		dataSources: map[string]string{"scaffolding_example": "d/example.html.markdown"},
+	}
+
+	if diff := cmp.Diff(expected, targets, cmp.AllowUnexported(mentionTargets{})); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if diff := cmp.Diff([]string{"data.scaffolding_example", "scaffolding_example"}, targets.names()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestLinkItemMentions(t *testing.T) {
+	t.Parallel()
+
+	targets := &mentionTargets{
+		resources: map[string]string{
+			"scaffolding_example": "resources/example.md",
+			"scaffolding_policy":  "resources/policy.md",
+		},
+		dataSources: map[string]string{
+			"scaffolding_example": "data-sources/example.md",
+			"scaffolding_account": "data-sources/account.md",
+		},
+	}
+
+	testCases := map[string]struct {
+		content     string
+		renderedRel string
+		expected    string
+	}{
+		"resource": {
+			content:     "Attach a `scaffolding_policy` to it.",
+			renderedRel: "resources/example.md",
+			expected:    "Attach a [`scaffolding_policy`](policy.md) to it.",
+		},
+		"data source": {
+			content:     "See `scaffolding_account` and `data.scaffolding_example`.",
+			renderedRel: "resources/example.md",
+			expected:    "See [`scaffolding_account`](../data-sources/account.md) and [`data.scaffolding_example`](../data-sources/example.md).",
+		},
+		"resource preferred": {
+			content:     "Manage it with `scaffolding_example`.",
+			renderedRel: "data-sources/example.md",
+			expected:    "Manage it with [`scaffolding_example`](../resources/example.md).",
+		},
+		"index page": {
+			content:     "- `scaffolding_example`",
+			renderedRel: "index.md",
+			expected:    "- [`scaffolding_example`](resources/example.md)",
+		},
+		"same page": {
+			content:     "The `scaffolding_example` resource.",
+			renderedRel: "resources/example.md",
+			expected:    "The `scaffolding_example` resource.",
+		},
+		"unknown names": {
+			content:     "Set `name` to `scaffolding_unknown`.",
+			renderedRel: "resources/example.md",
+			expected:    "Set `name` to `scaffolding_unknown`.",
+		},
+		"existing link": {
+			content:     "See [`scaffolding_policy`](https://example.com).",
+			renderedRel: "resources/example.md",
+			expected:    "See [`scaffolding_policy`](https://example.com).",
+		},
+		"frontmatter, headings, and code blocks": {
+			content:     "---\ndescription: |-\n  Uses `scaffolding_policy`.\n---\n\n# `scaffolding_policy`\n\n```terraform\n# `scaffolding_policy`\n```\n\nUses `scaffolding_policy`.\n",
+			renderedRel: "resources/example.md",
+			expected:    "---\ndescription: |-\n  Uses `scaffolding_policy`.\n---\n\n# `scaffolding_policy`\n\n```terraform\n# `scaffolding_policy`\n```\n\nUses [`scaffolding_policy`](policy.md).\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := linkItemMentions(testCase.content, testCase.renderedRel, targets)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
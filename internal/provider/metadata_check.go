@@ -0,0 +1,112 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+// metadataSection is the schemas of a rendered website subdirectory, as the
+// sections of metadata files.
+type metadataSection struct {
+	kind    string
+	schemas map[string]*tfjson.Schema
+}
+
+// metadataSections returns the schemas of the provider, and of the items of
+// each rendered website subdirectory, by subdirectory. The provider schema
+// has an empty subdirectory and item name, as in attribute metadata.
+func (g *generator) metadataSections(providerSchema *tfjson.ProviderSchema) map[string]metadataSection {
+	return map[string]metadataSection{
+		"":                    {"provider", map[string]*tfjson.Schema{"": providerSchema.ConfigSchema}},
+		"resources":           {"resource", providerSchema.ResourceSchemas},
+		"data-sources":        {"data source", providerSchema.DataSourceSchemas},
+		"ephemeral-resources": {"ephemeral resource", providerSchema.EphemeralResourceSchemas},
+		"list-resources":      {"list resource", providerSchema.ListResourceSchemas},
+		"actions":             {"action", g.actionSchemas},
+	}
+}
+
+// checkMetadataFiles returns an error listing the item names, and attribute
+// paths, of the attribute metadata files and of the item metadata file, which
+// do not exist in the provider schema, such as misspelled names, or the
+// attributes of removed items. Only the files set in the options are checked.
+func (g *generator) checkMetadataFiles(providerSchema *tfjson.ProviderSchema) error {
+	sections := g.metadataSections(providerSchema)
+
+	var errs []error
+
+	for _, file := range []struct {
+		path        string
+		description string
+	}{
+		{g.attributeDefaultsFile, "attribute defaults"},
+		{g.attributeValidatorsFile, "attribute validators"},
+		{g.requiresReplaceFile, "requires replace"},
+		{g.deprecationsFile, "deprecations"},
+		{g.attributeTypesFile, "attribute types"},
+	} {
+		if file.path == "" {
+			continue
+		}
+
+		// the values are not checked, so the file is decoded into raw
+		// values regardless of its type of values
+		values, err := readAttributeMetadataFile[json.RawMessage](g.metadataFilePath(file.path), file.description)
+		if err != nil {
+			return err
+		}
+
+		for _, dir := range sortedKeys(values) {
+			section := sections[dir]
+
+			for _, name := range sortedKeys(values[dir]) {
+				schema, ok := section.schemas[name]
+				if !ok {
+					errs = append(errs, fmt.Errorf("%s file %q: unknown %s %q", file.description, file.path, section.kind, name))
+					continue
+				}
+
+				item := fmt.Sprintf("%s %q", section.kind, name)
+				if dir == "" {
+					item = "the provider"
+				}
+
+				paths := schemamd.AttributePaths(schema)
+
+				for _, attPath := range sortedKeys(values[dir][name]) {
+					// the empty path is the replacement of a deprecated item
+					if attPath == "" && file.description == "deprecations" {
+						continue
+					}
+
+					if !slices.Contains(paths, attPath) {
+						errs = append(errs, fmt.Errorf("%s file %q: unknown attribute %q of %s", file.description, file.path, attPath, item))
+					}
+				}
+			}
+		}
+	}
+
+	if g.itemMetadataFile != "" {
+		for _, dir := range sortedKeys(g.itemMetadata) {
+			section := sections[dir]
+
+			for _, name := range sortedKeys(g.itemMetadata[dir]) {
+				if _, ok := section.schemas[name]; !ok {
+					errs = append(errs, fmt.Errorf("item metadata file %q: unknown %s %q", g.itemMetadataFile, section.kind, name))
+				}
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
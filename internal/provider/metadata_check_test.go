@@ -0,0 +1,114 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestGenerator_checkMetadataFiles(t *testing.T) {
+	t.Parallel()
+
+	providerSchema := &tfjson.ProviderSchema{
+		ConfigSchema: &tfjson.Schema{
+			Block: &tfjson.SchemaBlock{
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"region": {AttributeType: cty.String, Optional: true},
+				},
+			},
+		},
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"scaffolding_example": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"name": {AttributeType: cty.String, Required: true},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"settings": {
+							NestingMode: tfjson.SchemaNestingModeSingle,
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"enabled": {AttributeType: cty.Bool, Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		defaults      string
+		deprecations  string
+		itemMetadata  map[string]map[string]itemMetadata
+		expectedError string
+	}{
+		"known": {
+			defaults:     `{"provider": {"region": "us-east-1"}, "resources": {"scaffolding_example": {"name": "example", "settings.enabled": true}}}`,
+			deprecations: `{"resources": {"scaffolding_example": {"": "scaffolding_widget", "settings": "options"}}}`,
+			itemMetadata: map[string]map[string]itemMetadata{
+				"resources": {"scaffolding_example": {Related: []string{"scaffolding_unknown"}}},
+			},
+		},
+		"unknown": {
+			defaults:     `{"provider": {"zone": "a"}, "resources": {"scaffolding_example": {"": "x", "settings.disabled": true}, "scaffolding_removed": {"name": "x"}}}`,
+			deprecations: `{"data-sources": {"scaffolding_example": {"": "scaffolding_widget"}}}`,
+			itemMetadata: map[string]map[string]itemMetadata{
+				"actions": {"scaffolding_invoke": {}},
+			},
+			expectedError: `attribute defaults file "defaults.json": unknown attribute "zone" of the provider
+attribute defaults file "defaults.json": unknown attribute "" of resource "scaffolding_example"
+attribute defaults file "defaults.json": unknown attribute "settings.disabled" of resource "scaffolding_example"
+attribute defaults file "defaults.json": unknown resource "scaffolding_removed"
+deprecations file "deprecations.json": unknown data source "scaffolding_example"
+item metadata file "items.json": unknown action "scaffolding_invoke"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			providerDir := t.TempDir()
+
+			err := os.WriteFile(filepath.Join(providerDir, "defaults.json"), []byte(testCase.defaults), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = os.WriteFile(filepath.Join(providerDir, "deprecations.json"), []byte(testCase.deprecations), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			g := &generator{
+				providerDir:           providerDir,
+				attributeDefaultsFile: "defaults.json",
+				deprecationsFile:      "deprecations.json",
+				itemMetadataFile:      "items.json",
+				itemMetadata:          testCase.itemMetadata,
+			}
+
+			err = g.checkMetadataFiles(providerSchema)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Fatalf("expected error:\n%s\ngot:\n%v", testCase.expectedError, err)
+			}
+		})
+	}
+}
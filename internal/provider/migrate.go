@@ -118,6 +118,27 @@ func (m *migrator) Migrate() error {
 					return err
 				}
 				return filepath.SkipDir
+			case "ephemeral-resources":
+				m.infof("migrating ephemeral resources directory: %s", d.Name())
+				err := filepath.WalkDir(path, m.MigrateTemplate("ephemeral-resources"))
+				if err != nil {
+					return err
+				}
+				return filepath.SkipDir
+			case "list-resources":
+				m.infof("migrating list resources directory: %s", d.Name())
+				err := filepath.WalkDir(path, m.MigrateTemplate("list-resources"))
+				if err != nil {
+					return err
+				}
+				return filepath.SkipDir
+			case "actions":
+				m.infof("migrating actions directory: %s", d.Name())
+				err := filepath.WalkDir(path, m.MigrateTemplate("actions"))
+				if err != nil {
+					return err
+				}
+				return filepath.SkipDir
 			case "functions":
 				m.infof("migrating functons directory: %s", d.Name())
 				err := filepath.WalkDir(path, m.MigrateTemplate("functions"))
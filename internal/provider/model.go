@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/docsmodel"
+	"github.com/hashicorp/terraform-plugin-docs/internal/tmplfuncs"
+)
+
+// JSONModelPath returns the absolute path of the JSON documentation model file.
+func (g *generator) JSONModelPath() string {
+	if filepath.IsAbs(g.emitJSONModel) {
+		return g.emitJSONModel
+	}
+
+	return filepath.Join(g.providerDir, g.emitJSONModel)
+}
+
+// writeJSONModel writes the machine-readable documentation model of the
+// provider, including the content of example files, to the JSON model path.
+func (g *generator) writeJSONModel(providerSchema *tfjson.ProviderSchema) error {
+	g.infof("writing JSON docs model to %q", g.emitJSONModel)
+
+	model, err := g.jsonModel(providerSchema)
+	if err != nil {
+		return err
+	}
+
+	b := &bytes.Buffer{}
+	err = docsmodel.RenderJSON(b, model)
+	if err != nil {
+		return fmt.Errorf("unable to render JSON docs model: %w", err)
+	}
+
+	return writeFile(g.JSONModelPath(), b.String())
+}
+
+// jsonModel returns the documentation model of the provider schema, with the
+// example files of the examples directory.
+func (g *generator) jsonModel(providerSchema *tfjson.ProviderSchema) (*docsmodel.Model, error) {
+	model, err := docsmodel.Build(g.providerName, providerSchema, g.actionSchemas, g.ignoreDeprecated)
+	if err != nil {
+		return nil, fmt.Errorf("unable to build JSON docs model: %w", err)
+	}
+
+	model.Provider.RenderedName = g.renderedProviderName
+
+	model.Resources = g.withoutIgnored(model.Resources, "resources")
+	model.DataSources = g.withoutIgnored(model.DataSources, "data-sources")
+	model.EphemeralResources = g.withoutIgnored(model.EphemeralResources, "ephemeral-resources")
+	model.ListResources = g.withoutIgnored(model.ListResources, "list-resources")
+	model.Actions = g.withoutIgnored(model.Actions, "actions")
+
+	var functions []docsmodel.Function
+	for _, fn := range model.Functions {
+		if !g.ignore.Match("functions", fn.Name) {
+			functions = append(functions, fn)
+		}
+	}
+	model.Functions = functions
+
+	model.Provider.Example, err = g.exampleContent(filepath.Join("provider", "provider.tf"))
+	if err != nil {
+		return nil, err
+	}
+
+	sections := []struct {
+		resources   []docsmodel.Resource
+		dir         string
+		exampleFile string
+	}{
+		{model.Resources, "resources", "resource.tf"},
+		{model.DataSources, "data-sources", "data-source.tf"},
+		{model.EphemeralResources, "ephemeral-resources", "ephemeral-resource.tf"},
+		{model.ListResources, "list-resources", "list.tf"},
+		{model.Actions, "actions", "action.tf"},
+	}
+
+	for _, section := range sections {
+		for i := range section.resources {
+			res := &section.resources[i]
+
+			res.Example, err = g.exampleContent(filepath.Join(section.dir, res.Name, section.exampleFile))
+			if err != nil {
+				return nil, err
+			}
+
+			if section.dir != "resources" {
+				continue
+			}
+
+			res.Import, err = g.exampleContent(filepath.Join(section.dir, res.Name, "import.sh"))
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	for i := range model.Functions {
+		fn := &model.Functions[i]
+
+		fn.Example, err = g.exampleContent(filepath.Join("functions", fn.Name, "function.tf"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return model, nil
+}
+
+// withoutIgnored returns the resources, in the given rendered website
+// subdirectory, which are not matched by the ignore patterns.
+func (g *generator) withoutIgnored(resources []docsmodel.Resource, dir string) []docsmodel.Resource {
+	var result []docsmodel.Resource
+	for _, res := range resources {
+		if !g.ignore.Match(dir, res.Name) {
+			result = append(result, res)
+		}
+	}
+
+	return result
+}
+
+// exampleContent returns the trimmed content of an example file, relative to
+// the examples directory, or an empty string if the file does not exist.
+func (g *generator) exampleContent(rel string) (string, error) {
+	path := filepath.Join(g.ProviderExamplesDir(), rel)
+
+	if !fileExists(path) {
+		return "", nil
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read example file %q: %w", rel, err)
+	}
+
+	content = []byte(tmplfuncs.ReplacePlaceholders(string(content), g.exampleValues))
+
+	if filepath.Ext(rel) == ".tf" {
+		err = checkExampleSyntax(g.exampleSyntax, g.warnf, filepath.ToSlash(filepath.Join(g.examplesDir, rel)), content)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	example := string(content)
+	if g.stripExampleHeaders {
+		example = tmplfuncs.StripHeaders(example)
+	}
+
+	if g.formatExamples && filepath.Ext(rel) == ".tf" {
+		example = tmplfuncs.FormatTerraform(example)
+	}
+
+	return strings.TrimSpace(example), nil
+}
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	NavFormatDocusaurus = "docusaurus"
+	NavFormatJSON       = "json"
+	NavFormatMkDocs     = "mkdocs"
+)
+
+// NavFormats are the supported formats of the navigation file:
+//
+//   - json: a generic tree of labels, paths, and children.
+//   - mkdocs: the nav section of a MkDocs configuration file.
+//   - docusaurus: a Docusaurus sidebars.js file with a "docs" sidebar.
+var NavFormats = []string{
+	NavFormatJSON,
+	NavFormatMkDocs,
+	NavFormatDocusaurus,
+}
+
+// navNode is an entry of the navigation tree, either a page with a path or a
+// category with children.
+type navNode struct {
+	Label string `json:"label"`
+
+	// Path is the slash-separated path of the page, relative to the rendered
+	// website directory.
+	Path string `json:"path,omitempty"`
+
+	Children []*navNode `json:"children,omitempty"`
+}
+
+// validateNavFormat returns an error if the navigation format is not
+// supported.
+func validateNavFormat(navFormat string) error {
+	if !slices.Contains(NavFormats, navFormat) {
+		return fmt.Errorf("unsupported navigation format %q, expected one of: %s", navFormat, strings.Join(NavFormats, ", "))
+	}
+
+	return nil
+}
+
+// NavPath returns the absolute path of the navigation file.
+func (g *generator) NavPath() string {
+	if filepath.IsAbs(g.emitNav) {
+		return g.emitNav
+	}
+
+	return filepath.Join(g.providerDir, g.emitNav)
+}
+
+// writeNav writes the navigation of the rendered website, in the navigation
+// format, to the navigation path.
+func (g *generator) writeNav(providerSchema *tfjson.ProviderSchema) error {
+	g.infof("writing %s navigation to %q", g.navFormat, g.emitNav)
+
+	nodes, err := g.navTree(providerSchema)
+	if err != nil {
+		return err
+	}
+
+	var content string
+
+	switch g.navFormat {
+	case NavFormatDocusaurus:
+		content, err = renderNavDocusaurus(nodes)
+	case NavFormatMkDocs:
+		content, err = renderNavMkDocs(nodes)
+	default:
+		content, err = renderNavJSON(nodes)
+	}
+
+	if err != nil {
+		return fmt.Errorf("unable to render navigation: %w", err)
+	}
+
+	return writeFile(g.NavPath(), content)
+}
+
+// navTree returns the navigation of the rendered website: the provider index
+// page, the guides, and a category for each item type with generated items.
+// Items with a subcategory are grouped in a nested category.
+func (g *generator) navTree(providerSchema *tfjson.ProviderSchema) ([]*navNode, error) {
+	var nodes []*navNode
+
+	indexFile := renderedFilePath("index.md", g.outputExtension)
+	if fileExists(filepath.Join(g.ProviderDocsDir(), indexFile)) {
+		nodes = append(nodes, &navNode{Label: "Overview", Path: indexFile})
+	}
+
+	guides, err := g.navGuides()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(guides) > 0 {
+		nodes = append(nodes, &navNode{Label: "Guides", Children: guides})
+	}
+
+	for _, section := range g.indexSections(providerSchema) {
+		category := &navNode{Label: section.title}
+
+		var subcategory *navNode
+
+		for _, item := range section.items {
+			page := &navNode{Label: item.name, Path: item.path}
+
+			if item.subcategory == "" {
+				category.Children = append(category.Children, page)
+				continue
+			}
+
+			if subcategory == nil || subcategory.Label != item.subcategory {
+				subcategory = &navNode{Label: item.subcategory}
+				category.Children = append(category.Children, subcategory)
+			}
+
+			subcategory.Children = append(subcategory.Children, page)
+		}
+
+		nodes = append(nodes, category)
+	}
+
+	return nodes, nil
+}
+
+// navGuides returns the pages of the rendered guides directory, labeled by
+// their page title and sorted by label.
+func (g *generator) navGuides() ([]*navNode, error) {
+	guidesDir := filepath.Join(g.ProviderDocsDir(), "guides")
+
+	entries, err := os.ReadDir(guidesDir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read rendered guides directory %q: %w", guidesDir, err)
+	}
+
+	var guides []*navNode
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		content, err := os.ReadFile(filepath.Join(guidesDir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("unable to read rendered guide %q: %w", entry.Name(), err)
+		}
+
+		label := frontmatterPageTitle(string(content))
+		if label == "" {
+			label = trimOutputExtension(entry.Name())
+		}
+
+		guides = append(guides, &navNode{Label: label, Path: path.Join("guides", entry.Name())})
+	}
+
+	sort.SliceStable(guides, func(i, j int) bool {
+		return guides[i].Label < guides[j].Label
+	})
+
+	return guides, nil
+}
+
+// frontmatterPageTitle returns the page_title of the YAML frontmatter of
+// content, or an empty string if there is none.
+func frontmatterPageTitle(content string) string {
+	frontmatter, _, ok := splitFrontmatter(content)
+	if !ok {
+		return ""
+	}
+
+	var keys struct {
+		PageTitle string `yaml:"page_title"`
+	}
+
+	err := yaml.Unmarshal([]byte(frontmatter), &keys)
+	if err != nil {
+		return ""
+	}
+
+	return strings.TrimSpace(keys.PageTitle)
+}
+
+// trimOutputExtension returns the file name without its Markdown or MDX
+// extension, including compound extensions such as .html.markdown.
+func trimOutputExtension(name string) string {
+	for _, ext := range []string{FileExtensionHtmlMarkdown, FileExtensionHtmlMd, FileExtensionMarkdown, FileExtensionMdx, FileExtensionMd} {
+		if strings.HasSuffix(name, ext) {
+			return strings.TrimSuffix(name, ext)
+		}
+	}
+
+	return name
+}
+
+func renderNavJSON(nodes []*navNode) (string, error) {
+	if nodes == nil {
+		nodes = []*navNode{}
+	}
+
+	b, err := json.MarshalIndent(nodes, "", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return string(b) + "\n", nil
+}
+
+// renderNavMkDocs renders the nodes as the nav section of a MkDocs
+// configuration file, where pages are mappings of labels to paths and
+// categories are mappings of labels to sequences.
+func renderNavMkDocs(nodes []*navNode) (string, error) {
+	root := &yaml.Node{
+		Kind: yaml.MappingNode,
+		Content: []*yaml.Node{
+			{Kind: yaml.ScalarNode, Value: "nav"},
+			mkDocsNavSequence(nodes),
+		},
+	}
+
+	b := &bytes.Buffer{}
+
+	enc := yaml.NewEncoder(b)
+	enc.SetIndent(2)
+
+	err := enc.Encode(root)
+	if err != nil {
+		return "", err
+	}
+
+	err = enc.Close()
+	if err != nil {
+		return "", err
+	}
+
+	return b.String(), nil
+}
+
+func mkDocsNavSequence(nodes []*navNode) *yaml.Node {
+	seq := &yaml.Node{Kind: yaml.SequenceNode}
+
+	for _, node := range nodes {
+		value := &yaml.Node{Kind: yaml.ScalarNode, Value: node.Path}
+		if node.Path == "" {
+			value = mkDocsNavSequence(node.Children)
+		}
+
+		seq.Content = append(seq.Content, &yaml.Node{
+			Kind: yaml.MappingNode,
+			Content: []*yaml.Node{
+				{Kind: yaml.ScalarNode, Value: node.Label},
+				value,
+			},
+		})
+	}
+
+	return seq
+}
+
+// docusaurusSidebarItem is a doc or category item of a Docusaurus sidebar.
+type docusaurusSidebarItem struct {
+	Type  string                   `json:"type"`
+	ID    string                   `json:"id,omitempty"`
+	Label string                   `json:"label"`
+	Items []*docusaurusSidebarItem `json:"items,omitempty"`
+}
+
+// renderNavDocusaurus renders the nodes as a Docusaurus sidebars.js file,
+// where the document IDs are the paths of pages without extension.
+func renderNavDocusaurus(nodes []*navNode) (string, error) {
+	items := docusaurusSidebarItems(nodes)
+	if items == nil {
+		items = []*docusaurusSidebarItem{}
+	}
+
+	b, err := json.MarshalIndent(items, "  ", "  ")
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("// Code generated by tfplugindocs. DO NOT EDIT.\n\nmodule.exports = {\n  docs: %s,\n};\n", b), nil
+}
+
+func docusaurusSidebarItems(nodes []*navNode) []*docusaurusSidebarItem {
+	var items []*docusaurusSidebarItem
+
+	for _, node := range nodes {
+		if node.Path == "" {
+			items = append(items, &docusaurusSidebarItem{
+				Type:  "category",
+				Label: node.Label,
+				Items: docusaurusSidebarItems(node.Children),
+			})
+			continue
+		}
+
+		items = append(items, &docusaurusSidebarItem{
+			Type:  "doc",
+			ID:    trimOutputExtension(node.Path),
+			Label: node.Label,
+		})
+	}
+
+	return items
+}
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderNav(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*navNode{
+		{Label: "Overview", Path: "index.md"},
+		{
+			Label: "Resources",
+			Children: []*navNode{
+				{Label: "scaffolding_example", Path: "resources/example.md"},
+				{
+					Label: "Storage",
+					Children: []*navNode{
+						{Label: "scaffolding_s3_bucket", Path: "resources/s3_bucket.md"},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		render   func([]*navNode) (string, error)
+		expected string
+	}{
+		"json": {
+			render: renderNavJSON,
+			expected: `[
+  {
+    "label": "Overview",
+    "path": "index.md"
+  },
+  {
+    "label": "Resources",
+    "children": [
+      {
+        "label": "scaffolding_example",
+        "path": "resources/example.md"
+      },
+      {
+        "label": "Storage",
+        "children": [
+          {
+            "label": "scaffolding_s3_bucket",
+            "path": "resources/s3_bucket.md"
+          }
+        ]
+      }
+    ]
+  }
+]
+`,
+		},
+		"mkdocs": {
+			render: renderNavMkDocs,
+			expected: `nav:
+  - Overview: index.md
+  - Resources:
+      - scaffolding_example: resources/example.md
+      - Storage:
+          - scaffolding_s3_bucket: resources/s3_bucket.md
+`,
+		},
+		"docusaurus": {
+			render: renderNavDocusaurus,
+			expected: `// Code generated by tfplugindocs. DO NOT EDIT.
+
+module.exports = {
+  docs: [
+    {
+      "type": "doc",
+      "id": "index",
+      "label": "Overview"
+    },
+    {
+      "type": "category",
+      "label": "Resources",
+      "items": [
+        {
+          "type": "doc",
+          "id": "resources/example",
+          "label": "scaffolding_example"
+        },
+        {
+          "type": "category",
+          "label": "Storage",
+          "items": [
+            {
+              "type": "doc",
+              "id": "resources/s3_bucket",
+              "label": "scaffolding_s3_bucket"
+            }
+          ]
+        }
+      ]
+    }
+  ],
+};
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := testCase.render(nodes)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestFrontmatterPageTitle(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content  string
+		expected string
+	}{
+		"page title": {
+			content:  "---\npage_title: \"Getting Started\"\nsubcategory: \"\"\n---\n\n# Getting Started\n",
+			expected: "Getting Started",
+		},
+		"no page title": {
+			content:  "---\nsubcategory: \"\"\n---\n\n# Getting Started\n",
+			expected: "",
+		},
+		"no frontmatter": {
+			content:  "# Getting Started\n",
+			expected: "",
+		},
+		"unterminated frontmatter": {
+			content:  "---\npage_title: \"Getting Started\"\n",
+			expected: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := frontmatterPageTitle(testCase.content)
+
+			if actual != testCase.expected {
+				t.Errorf("expected %q, got %q", testCase.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// PostProcessor rewrites the content of a rendered page before it is written,
+// such as to inject snippets or convert admonition syntax. The page is the
+// slash separated path of the page relative to the rendered website
+// directory, such as "resources/example.md".
+type PostProcessor func(page string, content []byte) ([]byte, error)
+
+// postProcess returns the content of the page rewritten by the post
+// processors, in order.
+func (g *generator) postProcess(page, content string) (string, error) {
+	if len(g.postProcessors) == 0 {
+		return content, nil
+	}
+
+	data := []byte(content)
+
+	for _, postProcessor := range g.postProcessors {
+		var err error
+
+		data, err = postProcessor(page, data)
+		if err != nil {
+			return "", fmt.Errorf("unable to post-process %q: %w", page, err)
+		}
+	}
+
+	return string(data), nil
+}
+
+// postProcessCommand returns a PostProcessor which runs the command, a program
+// and its arguments separated by spaces, for each page, with the path of the
+// page as an additional argument and the content of the page on stdin. The
+// page is rewritten with the stdout of the command, unless the command exits
+// with a non-zero status.
+func postProcessCommand(ctx context.Context, command string) (PostProcessor, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, errors.New("post-process command is empty")
+	}
+
+	path, err := exec.LookPath(args[0])
+	if err != nil {
+		return nil, fmt.Errorf("unable to find post-process command %q: %w", args[0], err)
+	}
+
+	return func(page string, content []byte) ([]byte, error) {
+		var stdout, stderr bytes.Buffer
+
+		// pages are post-processed concurrently, so the arguments are copied
+		cmdArgs := append(append([]string{}, args[1:]...), page)
+
+		cmd := exec.CommandContext(ctx, path, cmdArgs...)
+		cmd.Stdin = bytes.NewReader(content)
+		cmd.Stdout = &stdout
+		cmd.Stderr = &stderr
+
+		err := cmd.Run()
+		if err != nil {
+			if message := strings.TrimSpace(stderr.String()); message != "" {
+				return nil, fmt.Errorf("post-process command: %w: %s", err, message)
+			}
+
+			return nil, fmt.Errorf("post-process command: %w", err)
+		}
+
+		return stdout.Bytes(), nil
+	}, nil
+}
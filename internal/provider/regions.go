@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// customRegionMarker matches the begin and end marker comments of custom
+// regions in rendered files, such as:
+//
+//	<!-- tfplugindocs:begin:custom -->
+//	Hand-written content
+//	<!-- tfplugindocs:end:custom -->
+//
+// The MDX comment syntax, which HTML comments are converted to in .mdx
+// output, is also matched.
+var customRegionMarker = regexp.MustCompile(`(?:<!--|\{/\*)\s*tfplugindocs:(begin|end):([\w.-]+)\s*(?:-->|\*/\})`)
+
+// customRegion is a named region of a rendered file, which is preserved when
+// the file is rendered again.
+type customRegion struct {
+	name string
+
+	// begin and end are the marker comments of the region.
+	begin string
+	end   string
+
+	// content is the hand-written content between the markers.
+	content string
+}
+
+// String returns the region with its markers.
+func (r customRegion) String() string {
+	return r.begin + r.content + r.end
+}
+
+// parseCustomRegions returns the custom regions of the content, in order of
+// appearance. Begin markers without a matching end marker are ignored.
+func parseCustomRegions(content string) []customRegion {
+	var regions []customRegion
+
+	markers := customRegionMarker.FindAllStringSubmatchIndex(content, -1)
+
+	for i := 0; i < len(markers); i++ {
+		begin := markers[i]
+		if content[begin[2]:begin[3]] != "begin" {
+			continue
+		}
+
+		name := content[begin[4]:begin[5]]
+
+		for j := i + 1; j < len(markers); j++ {
+			end := markers[j]
+			if content[end[2]:end[3]] != "end" || content[end[4]:end[5]] != name {
+				continue
+			}
+
+			regions = append(regions, customRegion{
+				name:    name,
+				begin:   content[begin[0]:begin[1]],
+				end:     content[end[0]:end[1]],
+				content: content[begin[1]:end[0]],
+			})
+
+			i = j
+			break
+		}
+	}
+
+	return regions
+}
+
+// mergeCustomRegions replaces the content of each custom region in the newly
+// rendered content with the content of the region of the same name in the
+// previously rendered file. Previous regions which are not in the newly
+// rendered content, such as regions added to pages of the default templates,
+// are appended to the end of the content, in order of appearance, and their
+// names are returned.
+func mergeCustomRegions(content string, previous []customRegion) (string, []string) {
+	if len(previous) == 0 {
+		return content, nil
+	}
+
+	byName := make(map[string]customRegion, len(previous))
+	for _, r := range previous {
+		byName[r.name] = r
+	}
+
+	merged := make(map[string]bool, len(previous))
+
+	var b strings.Builder
+	last := 0
+
+	for _, r := range parseCustomRegions(content) {
+		p, ok := byName[r.name]
+		if !ok || merged[r.name] {
+			continue
+		}
+
+		start := strings.Index(content[last:], r.begin+r.content+r.end) + last
+		b.WriteString(content[last : start+len(r.begin)])
+		b.WriteString(p.content)
+		b.WriteString(r.end)
+		last = start + len(r.begin) + len(r.content) + len(r.end)
+
+		merged[r.name] = true
+	}
+
+	b.WriteString(content[last:])
+
+	var appended []string
+	for _, p := range previous {
+		if merged[p.name] {
+			continue
+		}
+
+		if !strings.HasSuffix(b.String(), "\n") {
+			b.WriteString("\n")
+		}
+		b.WriteString("\n")
+		b.WriteString(p.String())
+		b.WriteString("\n")
+
+		appended = append(appended, p.name)
+		merged[p.name] = true
+	}
+
+	return b.String(), appended
+}
+
+// loadCustomRegions returns the custom regions of each file in the rendered
+// website directory, by slash-separated path relative to the directory.
+func loadCustomRegions(renderedDir string) (map[string][]customRegion, error) {
+	result := make(map[string][]customRegion)
+
+	err := filepath.WalkDir(renderedDir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			if path == renderedDir && os.IsNotExist(err) {
+				return filepath.SkipDir
+			}
+			return fmt.Errorf("unable to walk path %q: %w", path, err)
+		}
+		if d.IsDir() {
+			return nil
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("unable to read file %q: %w", path, err)
+		}
+
+		regions := parseCustomRegions(string(content))
+		if len(regions) == 0 {
+			return nil
+		}
+
+		rel, err := filepath.Rel(renderedDir, path)
+		if err != nil {
+			return fmt.Errorf("unable to retrieve the relative path of basepath %q and targetpath %q: %w",
+				renderedDir, path, err)
+		}
+
+		result[filepath.ToSlash(rel)] = regions
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
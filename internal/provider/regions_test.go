@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_mergeCustomRegions(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		previous string
+		content  string
+		expected string
+		appended []string
+	}{
+		"no previous regions": {
+			previous: "# Example\n",
+			content:  "# Example\n\n<!-- tfplugindocs:begin:custom -->\n<!-- tfplugindocs:end:custom -->\n",
+			expected: "# Example\n\n<!-- tfplugindocs:begin:custom -->\n<!-- tfplugindocs:end:custom -->\n",
+		},
+		"matching region": {
+			previous: "# Old\n\n<!-- tfplugindocs:begin:custom -->\nHand-written\n<!-- tfplugindocs:end:custom -->\n",
+			content:  "# New\n\n<!-- tfplugindocs:begin:custom -->\n<!-- tfplugindocs:end:custom -->\n\n## Schema\n",
+			expected: "# New\n\n<!-- tfplugindocs:begin:custom -->\nHand-written\n<!-- tfplugindocs:end:custom -->\n\n## Schema\n",
+		},
+		"multiple regions": {
+			previous: "<!-- tfplugindocs:begin:notes -->Notes<!-- tfplugindocs:end:notes -->\n<!-- tfplugindocs:begin:intro -->Intro<!-- tfplugindocs:end:intro -->\n",
+			content:  "<!-- tfplugindocs:begin:intro --><!-- tfplugindocs:end:intro -->\n# Example\n<!-- tfplugindocs:begin:notes --><!-- tfplugindocs:end:notes -->\n",
+			expected: "<!-- tfplugindocs:begin:intro -->Intro<!-- tfplugindocs:end:intro -->\n# Example\n<!-- tfplugindocs:begin:notes -->Notes<!-- tfplugindocs:end:notes -->\n",
+		},
+		"missing region appended": {
+			previous: "# Old\n\n<!-- tfplugindocs:begin:custom -->\nHand-written\n<!-- tfplugindocs:end:custom -->\n",
+			content:  "# New",
+			expected: "# New\n\n<!-- tfplugindocs:begin:custom -->\nHand-written\n<!-- tfplugindocs:end:custom -->\n",
+			appended: []string{"custom"},
+		},
+		"missing regions appended in order": {
+			previous: "<!-- tfplugindocs:begin:b -->B<!-- tfplugindocs:end:b -->\n<!-- tfplugindocs:begin:a -->A<!-- tfplugindocs:end:a -->\n<!-- tfplugindocs:begin:c -->C<!-- tfplugindocs:end:c -->\n",
+			content:  "# New\n<!-- tfplugindocs:begin:a --><!-- tfplugindocs:end:a -->\n",
+			expected: "# New\n<!-- tfplugindocs:begin:a -->A<!-- tfplugindocs:end:a -->\n\n<!-- tfplugindocs:begin:b -->B<!-- tfplugindocs:end:b -->\n\n<!-- tfplugindocs:begin:c -->C<!-- tfplugindocs:end:c -->\n",
+			appended: []string{"b", "c"},
+		},
+		"unterminated region": {
+			previous: "<!-- tfplugindocs:begin:custom -->\nHand-written\n",
+			content:  "# New\n",
+			expected: "# New\n",
+		},
+		"mdx comments": {
+			previous: "{/* tfplugindocs:begin:custom */}\nHand-written\n{/* tfplugindocs:end:custom */}\n",
+			content:  "# New\n\n{/* tfplugindocs:begin:custom */}\n{/* tfplugindocs:end:custom */}\n",
+			expected: "# New\n\n{/* tfplugindocs:begin:custom */}\nHand-written\n{/* tfplugindocs:end:custom */}\n",
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, appended := mergeCustomRegions(c.content, parseCustomRegions(c.previous))
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			if diff := cmp.Diff(c.appended, appended); diff != "" {
+				t.Errorf("unexpected appended regions difference: %s", diff)
+			}
+		})
+	}
+}
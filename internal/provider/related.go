@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+	"gopkg.in/yaml.v3"
+)
+
+// itemMetadata is the metadata of a resource, data source, or other item,
+// which is not part of the schema.
+type itemMetadata struct {
+	// Related are the names of resources and data sources which are
+	// rendered in the related resources section of the item, with data
+	// sources which share the name of a resource prefixed with "data.".
+	Related []string `json:"related,omitempty" yaml:"related,omitempty"`
+
+	// Subcategory is the subcategory of the item, which takes precedence
+	// over the subcategory file and the deprecated subcategory.
+	Subcategory string `json:"subcategory,omitempty" yaml:"subcategory,omitempty"`
+
+	// PageTitle is the page title of the item, which the default templates
+	// render instead of the generated page title.
+	PageTitle string `json:"page_title,omitempty" yaml:"page_title,omitempty"`
+
+	// Frontmatter are additional frontmatter entries of the item, by key,
+	// which the default templates render after the generated entries.
+	Frontmatter map[string]string `json:"frontmatter,omitempty" yaml:"frontmatter,omitempty"`
+}
+
+// frontmatterKeyPattern matches the keys of the frontmatter entries of the
+// item metadata file, which are rendered as plain YAML keys.
+var frontmatterKeyPattern = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// generatedFrontmatterKeys are the frontmatter keys of the default templates,
+// which cannot be additional frontmatter entries. The page title and
+// subcategory are set with their own fields of the item metadata file.
+var generatedFrontmatterKeys = []string{"description", "page_title", "subcategory"}
+
+// itemMetadataFile is the format of the item metadata file, which contains
+// the metadata of the items of each rendered website subdirectory by name, as
+// JSON, for example:
+//
+//	{
+//	  "resources": {
+//	    "scaffolding_example": {"related": ["scaffolding_policy", "data.scaffolding_example"]}
+//	  }
+//	}
+//
+// or, if the file has a .yaml or .yml extension, as YAML, which can contain
+// comments, for example:
+//
+//	resources:
+//	  scaffolding_example:
+//	    # The policy attached to the example
+//	    related: [scaffolding_policy, data.scaffolding_example]
+type itemMetadataFile struct {
+	Resources          map[string]itemMetadata `json:"resources,omitempty" yaml:"resources,omitempty"`
+	DataSources        map[string]itemMetadata `json:"data-sources,omitempty" yaml:"data-sources,omitempty"`
+	EphemeralResources map[string]itemMetadata `json:"ephemeral-resources,omitempty" yaml:"ephemeral-resources,omitempty"`
+	ListResources      map[string]itemMetadata `json:"list-resources,omitempty" yaml:"list-resources,omitempty"`
+	Actions            map[string]itemMetadata `json:"actions,omitempty" yaml:"actions,omitempty"`
+}
+
+// loadItemMetadata returns the metadata of the item metadata file at path, by
+// rendered website subdirectory and item name. The file is YAML if it has a
+// .yaml or .yml extension, and JSON otherwise.
+func loadItemMetadata(path string) (map[string]map[string]itemMetadata, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read item metadata file %q: %w", path, err)
+	}
+
+	var file itemMetadataFile
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		decoder := yaml.NewDecoder(bytes.NewReader(data))
+		decoder.KnownFields(true)
+
+		err = decoder.Decode(&file)
+		if err != nil && !errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("unable to parse item metadata file %q: %w", path, err)
+		}
+	default:
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+
+		err = decoder.Decode(&file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse item metadata file %q: %w", path, jsonErrorPosition(data, err))
+		}
+	}
+
+	metadata := map[string]map[string]itemMetadata{
+		"resources":           file.Resources,
+		"data-sources":        file.DataSources,
+		"ephemeral-resources": file.EphemeralResources,
+		"list-resources":      file.ListResources,
+		"actions":             file.Actions,
+	}
+
+	for _, dir := range sortedKeys(metadata) {
+		for _, name := range sortedKeys(metadata[dir]) {
+			for _, key := range sortedKeys(metadata[dir][name].Frontmatter) {
+				switch {
+				case !frontmatterKeyPattern.MatchString(key):
+					return nil, fmt.Errorf("invalid frontmatter key %q of %q in item metadata file %q, expected letters, digits, underscores, and hyphens", key, name, path)
+				case slices.Contains(generatedFrontmatterKeys, key):
+					return nil, fmt.Errorf("frontmatter key %q of %q in item metadata file %q is generated by the default templates, and cannot be set as an additional entry", key, name, path)
+				}
+			}
+		}
+	}
+
+	return metadata, nil
+}
+
+// relatedResource is a related resource or data source of an item, which is
+// listed in the related resources section of the default templates.
+type relatedResource struct {
+	// Name is the name of the resource or data source, as in the item
+	// metadata file.
+	Name string
+
+	// Link is the relative link to the page of the resource or data source,
+	// or empty if it is not generated.
+	Link string
+}
+
+// relatedResources returns the related resources of the named item, in the
+// rendered website subdirectory dir, from the item metadata file, with links
+// relative to the page of the item. Related resources which are not
+// generated have no link.
+func (g *generator) relatedResources(dir, name string) []relatedResource {
+	names := g.itemMetadata[dir][name].Related
+	if len(names) == 0 {
+		return nil
+	}
+
+	renderedRel := g.renderedItemPath(dir, name)
+
+	related := make([]relatedResource, 0, len(names))
+	for _, relatedName := range names {
+		r := relatedResource{Name: relatedName}
+
+		if target, ok := g.mentionTargets.target(relatedName); ok {
+			r.Link = relativeLink(renderedRel, target)
+		}
+
+		related = append(related, r)
+	}
+
+	return related
+}
@@ -0,0 +1,191 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadItemMetadata(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		fileName      string
+		file          string
+		expected      map[string]map[string]itemMetadata
+		expectedError string
+	}{
+		"related": {
+			file: `{
+  "resources": {"scaffolding_example": {"related": ["scaffolding_policy", "data.scaffolding_example"]}},
+  "data-sources": {"scaffolding_example": {"related": ["scaffolding_example"]}}
+}`,
+			expected: map[string]map[string]itemMetadata{
+				"resources": {
+					"scaffolding_example": {Related: []string{"scaffolding_policy", "data.scaffolding_example"}},
+				},
+				"data-sources": {
+					"scaffolding_example": {Related: []string{"scaffolding_example"}},
+				},
+				"ephemeral-resources": nil,
+				"list-resources":      nil,
+				"actions":             nil,
+			},
+		},
+		"yaml": {
+			fileName: "items.yml",
+			file: `# Related resources of the examples
+resources:
+  scaffolding_example:
+    related:
+      - scaffolding_policy
+      - data.scaffolding_example # the data source of the same name
+`,
+			expected: map[string]map[string]itemMetadata{
+				"resources": {
+					"scaffolding_example": {Related: []string{"scaffolding_policy", "data.scaffolding_example"}},
+				},
+				"data-sources":        nil,
+				"ephemeral-resources": nil,
+				"list-resources":      nil,
+				"actions":             nil,
+			},
+		},
+		"empty yaml": {
+			fileName: "items.yaml",
+			file:     "# No metadata yet\n",
+			expected: map[string]map[string]itemMetadata{
+				"resources":           nil,
+				"data-sources":        nil,
+				"ephemeral-resources": nil,
+				"list-resources":      nil,
+				"actions":             nil,
+			},
+		},
+		"page title, subcategory, and frontmatter": {
+			fileName: "items.yaml",
+			file: `resources:
+  scaffolding_example:
+    page_title: Example resource
+    subcategory: Examples
+    frontmatter:
+      layout: example
+      sidebar_current: docs-scaffolding-example
+`,
+			expected: map[string]map[string]itemMetadata{
+				"resources": {
+					"scaffolding_example": {
+						Subcategory: "Examples",
+						PageTitle:   "Example resource",
+						Frontmatter: map[string]string{
+							"layout":          "example",
+							"sidebar_current": "docs-scaffolding-example",
+						},
+					},
+				},
+				"data-sources":        nil,
+				"ephemeral-resources": nil,
+				"list-resources":      nil,
+				"actions":             nil,
+			},
+		},
+		"invalid frontmatter key": {
+			file:          `{"resources": {"scaffolding_example": {"frontmatter": {"sidebar current": "example"}}}}`,
+			expectedError: `invalid frontmatter key "sidebar current" of "scaffolding_example"`,
+		},
+		"generated frontmatter key": {
+			file:          `{"resources": {"scaffolding_example": {"frontmatter": {"description": "Example"}}}}`,
+			expectedError: `frontmatter key "description" of "scaffolding_example" in item metadata file`,
+		},
+		"yaml unknown key": {
+			fileName:      "items.yaml",
+			file:          "resources:\n  scaffolding_example:\n    see_also: [scaffolding_policy]\n",
+			expectedError: `line 3: field see_also not found in type provider.itemMetadata`,
+		},
+		"unknown key": {
+			file:          `{"resources": {"scaffolding_example": {"see_also": ["scaffolding_policy"]}}}`,
+			expectedError: `unable to parse item metadata file`,
+		},
+		"invalid type": {
+			file: `{
+  "resources": {"scaffolding_example": {"related": "scaffolding_policy"}}
+}`,
+			expectedError: `line 2, column 71: json: cannot unmarshal string`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			fileName := testCase.fileName
+			if fileName == "" {
+				fileName = "items.json"
+			}
+
+			path := filepath.Join(t.TempDir(), fileName)
+			err := os.WriteFile(path, []byte(testCase.file), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual, err := loadItemMetadata(path)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.expectedError)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenerator_relatedResources(t *testing.T) {
+	t.Parallel()
+
+	g := &generator{
+		providerName:    "terraform-provider-scaffolding",
+		outputExtension: ".md",
+		itemMetadata: map[string]map[string]itemMetadata{
+			"data-sources": {
+				"scaffolding_example": {Related: []string{"scaffolding_example", "scaffolding_unknown"}},
+			},
+		},
+		mentionTargets: &mentionTargets{
+			resources: map[string]string{"scaffolding_example": "resources/example.md"},
+		},
+	}
+
+	expected := []relatedResource{
+		{Name: "scaffolding_example", Link: "../resources/example.md"},
+		{Name: "scaffolding_unknown"},
+	}
+
+	if diff := cmp.Diff(expected, g.relatedResources("data-sources", "scaffolding_example")); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if related := g.relatedResources("resources", "scaffolding_example"); related != nil {
+		t.Errorf("expected no related resources, got: %v", related)
+	}
+}
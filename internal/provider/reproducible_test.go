@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+)
+
+func TestGenerate_Reproducible(t *testing.T) {
+	t.Setenv(sourceDateEpochEnvVar, "1704207845")
+
+	providersSchemaPath, err := filepath.Abs("testdata/schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	providerDir := t.TempDir()
+
+	templatePath := filepath.Join(providerDir, "templates", "resources", "resource.md.tmpl")
+	err = os.MkdirAll(filepath.Dir(templatePath), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = os.WriteFile(templatePath, []byte("# {{ .Name }}\n\nGenerated on {{ (buildinfo).Timestamp.Format \"2006-01-02\" }}.\n\n{{ .SchemaMarkdown | trimspace }}\n"), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := assertReproducibleOutput(t, providerDir, GenerateOptions{
+		ProviderName:        "terraform-provider-null",
+		ProvidersSchemaPath: providersSchemaPath,
+		RenderedWebsiteDir:  "docs",
+		ExamplesDir:         "examples",
+		TemplatesDir:        "templates",
+		Parallel:            4,
+		EmitJSONModel:       "docs.json",
+		EmitNav:             "nav.json",
+		NavFormat:           "json",
+		EmitSinglePage:      "docs.md",
+		DeprecationsGuide:   true,
+		Reproducible:        true,
+	})
+
+	if page := files["docs/resources/resource.md"]; !strings.Contains(page, "Generated on 2024-01-02.") {
+		t.Errorf("expected the timestamp of %s in the rendered page, got:\n%s", sourceDateEpochEnvVar, page)
+	}
+}
+
+func TestParseBuildTimestamp(t *testing.T) {
+	testCases := map[string]struct {
+		value           string
+		reproducible    bool
+		sourceDateEpoch string
+		expected        string
+		expectedFixed   bool
+		expectedError   string
+	}{
+		"value": {
+			value:         "2024-01-02T16:04:05+01:00",
+			expected:      "2024-01-02T15:04:05Z",
+			expectedFixed: true,
+		},
+		"value over source date epoch": {
+			value:           "2024-01-02T15:04:05Z",
+			reproducible:    true,
+			sourceDateEpoch: "0",
+			expected:        "2024-01-02T15:04:05Z",
+			expectedFixed:   true,
+		},
+		"reproducible source date epoch": {
+			reproducible:    true,
+			sourceDateEpoch: "1704207845",
+			expected:        "2024-01-02T15:04:05Z",
+			expectedFixed:   true,
+		},
+		"reproducible unix epoch": {
+			reproducible:  true,
+			expected:      "1970-01-01T00:00:00Z",
+			expectedFixed: true,
+		},
+		"invalid value": {
+			value:         "yesterday",
+			expectedError: `invalid build timestamp "yesterday"`,
+		},
+		"invalid source date epoch": {
+			reproducible:    true,
+			sourceDateEpoch: "yesterday",
+			expectedError:   `invalid SOURCE_DATE_EPOCH "yesterday"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Setenv(sourceDateEpochEnvVar, testCase.sourceDateEpoch)
+
+			actual, fixed, err := parseBuildTimestamp(testCase.value, testCase.reproducible)
+
+			if testCase.expectedError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual.Format("2006-01-02T15:04:05Z07:00") != testCase.expected {
+				t.Errorf("expected %s, got %s", testCase.expected, actual)
+			}
+
+			if fixed != testCase.expectedFixed {
+				t.Errorf("expected fixed to be %t, got %t", testCase.expectedFixed, fixed)
+			}
+		})
+	}
+}
+
+// assertReproducibleOutput generates the documentation of a copy of the
+// provider directory twice with the options, and fails the test unless both
+// runs write byte-identical files. The files of the first run are returned
+// by slash-separated path relative to the provider directory.
+func assertReproducibleOutput(t *testing.T, providerDir string, opts GenerateOptions) map[string]string {
+	t.Helper()
+
+	var runs [2]map[string]string
+
+	for i := range runs {
+		dir := t.TempDir()
+
+		err := cp(providerDir, dir)
+		if err != nil {
+			t.Fatalf("unable to copy provider directory: %s", err)
+		}
+
+		opts.ProviderDir = dir
+
+		err = Generate(cli.NewMockUi(), opts)
+		if err != nil {
+			t.Fatalf("unable to generate documentation: %s", err)
+		}
+
+		runs[i] = readDirFiles(t, dir)
+	}
+
+	if diff := cmp.Diff(runs[0], runs[1]); diff != "" {
+		t.Errorf("expected byte-identical output of both runs, got difference: %s", diff)
+	}
+
+	return runs[0]
+}
+
+// readDirFiles returns the content of the files of the directory by
+// slash-separated path relative to it.
+func readDirFiles(t *testing.T, dir string) map[string]string {
+	t.Helper()
+
+	files := make(map[string]string)
+
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			return err
+		}
+
+		files[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("unable to read directory %q: %s", dir, err)
+	}
+
+	return files
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+)
+
+// attributeReplacements are the attributes which force the replacement of
+// their resource when changed.
+type attributeReplacements = attributeMetadata[bool]
+
+// extractAttributeReplacementsByAddress returns the attributes which force
+// replacement of the first of the given provider addresses in the providers
+// schema JSON. No error is returned if the schema has no such attributes.
+func extractAttributeReplacementsByAddress(schemajson []byte, addresses ...string) (attributeReplacements, error) {
+	replacements := make(attributeReplacements)
+
+	err := walkSchemaExtensions(schemajson, func(dir, name string, path []string, att *schemaExtensionsAttribute) error {
+		if att.RequiresReplace {
+			replacements.set(dir, name, strings.Join(path, "."), true)
+		}
+
+		return nil
+	}, addresses...)
+	if err != nil {
+		return nil, err
+	}
+
+	return replacements, nil
+}
+
+// loadAttributeReplacements sets the attributes of the requires replace file
+// at path into replacements, over the attributes of the providers schema
+// JSON. The file is an attribute metadata file of booleans, where false
+// unmarks an attribute of the providers schema JSON, for example:
+//
+//	{
+//	  "resources": {
+//	    "scaffolding_example": {"name": true, "settings.region": true}
+//	  }
+//	}
+func loadAttributeReplacements(path string, replacements attributeReplacements) error {
+	values, err := readAttributeMetadataFile[bool](path, "requires replace")
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range sortedKeys(values) {
+		for _, name := range sortedKeys(values[dir]) {
+			for _, attPath := range sortedKeys(values[dir][name]) {
+				replacements.set(dir, name, attPath, values[dir][name][attPath])
+			}
+		}
+	}
+
+	return nil
+}
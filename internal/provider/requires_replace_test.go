@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestAttributeReplacements(t *testing.T) {
+	t.Parallel()
+
+	schemajson := []byte(`{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/scaffolding": {
+      "resource_schemas": {
+        "scaffolding_example": {
+          "version": 0,
+          "block": {
+            "attributes": {
+              "id": {"type": "string", "computed": true},
+              "name": {"type": "string", "required": true, "requires_replace": true},
+              "settings": {
+                "nested_type": {
+                  "nesting_mode": "single",
+                  "attributes": {"region": {"type": "string", "optional": true, "requires_replace": true}}
+                },
+                "optional": true
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+	replacements, err := extractAttributeReplacementsByAddress(schemajson, "scaffolding", "registry.terraform.io/hashicorp/scaffolding")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	path := filepath.Join(t.TempDir(), "requires-replace.json")
+	err = os.WriteFile(path, []byte(`{"resources": {"scaffolding_example": {"settings.region": false, "zone": true}}}`), 0644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = loadAttributeReplacements(path, replacements)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := attributeReplacements{
+		"resources": {
+			"scaffolding_example": {
+				"name":            true,
+				"settings.region": false,
+				"zone":            true,
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, replacements); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -7,22 +7,14 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"os/exec"
 	"path/filepath"
 	"runtime"
 
-	"github.com/hashicorp/go-version"
-	install "github.com/hashicorp/hc-install"
-	"github.com/hashicorp/hc-install/checkpoint"
-	"github.com/hashicorp/hc-install/fs"
-	"github.com/hashicorp/hc-install/product"
-	"github.com/hashicorp/hc-install/releases"
-	"github.com/hashicorp/hc-install/src"
 	"github.com/hashicorp/terraform-exec/tfexec"
 	tfjson "github.com/hashicorp/terraform-json"
 )
 
-func TerraformProviderSchemaFromTerraform(ctx context.Context, providerName, providerDir, tfVersion string, l *Logger) (*tfjson.ProviderSchema, error) {
+func TerraformProviderSchemaFromTerraform(ctx context.Context, providerName, providerDir string, cliOpts terraformCLIOptions, l *Logger) (*tfjson.ProviderSchema, error) {
 	var err error
 
 	shortName := providerShortName(providerName)
@@ -33,15 +25,21 @@ func TerraformProviderSchemaFromTerraform(ctx context.Context, providerName, pro
 	}
 	defer os.RemoveAll(tmpDir)
 
+	cli, err := ensureTerraformCLI(ctx, cliOpts, tmpDir, l.infof)
+	if err != nil {
+		return nil, err
+	}
+
+	hostname := cli.registryHostname()
+
 	l.infof("compiling provider %q", shortName)
-	providerPath := fmt.Sprintf("plugins/registry.terraform.io/hashicorp/%s/0.0.1/%s_%s", shortName, runtime.GOOS, runtime.GOARCH)
+	providerPath := fmt.Sprintf("plugins/%s/hashicorp/%s/0.0.1/%s_%s", hostname, shortName, runtime.GOOS, runtime.GOARCH)
 	outFile := filepath.Join(tmpDir, providerPath, fmt.Sprintf("terraform-provider-%s", shortName))
 	switch runtime.GOOS {
 	case "windows":
 		outFile = outFile + ".exe"
 	}
-	buildCmd := exec.Command("go", "build", "-o", outFile)
-	buildCmd.Dir = providerDir
+	buildCmd := providerBuildCmd(ctx, providerDir, outFile, cliOpts.offline)
 	// TODO: constrain env here to make it a little safer?
 	_, err = runCmd(buildCmd)
 	if err != nil {
@@ -56,36 +54,7 @@ provider %[1]q {
 		return nil, fmt.Errorf("unable to write provider.tf file: %w", err)
 	}
 
-	i := install.NewInstaller()
-	var sources []src.Source
-	if tfVersion != "" {
-		l.infof("downloading Terraform CLI binary version from releases.hashicorp.com: %s", tfVersion)
-		sources = []src.Source{
-			&releases.ExactVersion{
-				Product:    product.Terraform,
-				Version:    version.Must(version.NewVersion(tfVersion)),
-				InstallDir: tmpDir,
-			},
-		}
-	} else {
-		l.infof("using Terraform CLI binary from PATH if available, otherwise downloading latest Terraform CLI binary")
-		sources = []src.Source{
-			&fs.AnyVersion{
-				Product: &product.Terraform,
-			},
-			&checkpoint.LatestVersion{
-				InstallDir: tmpDir,
-				Product:    product.Terraform,
-			},
-		}
-	}
-
-	tfBin, err := i.Ensure(context.Background(), sources)
-	if err != nil {
-		return nil, fmt.Errorf("unable to download Terraform binary: %w", err)
-	}
-
-	tf, err := tfexec.NewTerraform(tmpDir, tfBin)
+	tf, err := tfexec.NewTerraform(tmpDir, cli.path)
 	if err != nil {
 		return nil, fmt.Errorf("unable to create new terraform exec instance: %w", err)
 	}
@@ -106,7 +75,7 @@ provider %[1]q {
 		return ps, nil
 	}
 
-	if ps, ok := schemas.Schemas["registry.terraform.io/hashicorp/"+shortName]; ok {
+	if ps, ok := schemas.Schemas[hostname+"/hashicorp/"+shortName]; ok {
 		return ps, nil
 	}
 
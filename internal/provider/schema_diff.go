@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/cli"
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemadiff"
+)
+
+const (
+	SchemaDiffFormatMarkdown = "markdown"
+	SchemaDiffFormatJSON     = "json"
+)
+
+// SchemaDiff compares two providers schema JSON files, which contain the
+// output of the terraform providers schema -json command, and outputs a
+// report of the differences in the given format.
+func SchemaDiff(ui cli.Ui, oldSchemaPath, newSchemaPath, providerName, format string) error {
+	if oldSchemaPath == "" || newSchemaPath == "" {
+		return fmt.Errorf("expected paths to the old and new providers schema JSON files")
+	}
+
+	switch format {
+	case SchemaDiffFormatMarkdown, SchemaDiffFormatJSON:
+	default:
+		return fmt.Errorf("unsupported format %q, expected %q or %q", format, SchemaDiffFormatMarkdown, SchemaDiffFormatJSON)
+	}
+
+	oldSchema, err := providerSchemaFromFile(oldSchemaPath, providerName)
+	if err != nil {
+		return err
+	}
+
+	newSchema, err := providerSchemaFromFile(newSchemaPath, providerName)
+	if err != nil {
+		return err
+	}
+
+	report, err := schemadiff.Diff(oldSchema, newSchema)
+	if err != nil {
+		return fmt.Errorf("unable to compare provider schemas: %w", err)
+	}
+
+	b := &strings.Builder{}
+
+	switch format {
+	case SchemaDiffFormatJSON:
+		err = schemadiff.RenderJSON(b, report)
+	default:
+		err = schemadiff.RenderMarkdown(b, report)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to render schema diff: %w", err)
+	}
+
+	ui.Output(strings.TrimSuffix(b.String(), "\n"))
+
+	return nil
+}
+
+// providerSchemaFromFile returns the schema for the named provider from a
+// providers schema JSON file. If providerName is empty, the file must contain
+// exactly one provider schema.
+func providerSchemaFromFile(path, providerName string) (*tfjson.ProviderSchema, error) {
+	schemas, err := extractSchemaFromFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to retrieve provider schema from JSON file: %w", err)
+	}
+
+	if providerName == "" {
+		if len(schemas.Schemas) == 1 {
+			for _, ps := range schemas.Schemas {
+				return ps, nil
+			}
+		}
+
+		names := make([]string, 0, len(schemas.Schemas))
+		for name := range schemas.Schemas {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("expected exactly one provider schema in JSON file %q, found %d (%s); set the provider name to select one", path, len(names), strings.Join(names, ", "))
+	}
+
+	shortName := providerShortName(providerName)
+
+	if ps, ok := schemas.Schemas[shortName]; ok {
+		return ps, nil
+	}
+
+	if ps, ok := schemas.Schemas["registry.terraform.io/hashicorp/"+shortName]; ok {
+		return ps, nil
+	}
+
+	return nil, fmt.Errorf("unable to find schema in JSON file %q for provider %q", path, shortName)
+}
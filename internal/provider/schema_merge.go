@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// providersSchemaPaths returns the paths of a comma separated list of
+// providers schema JSON paths, which are file paths, "-" to read from stdin,
+// or HTTP(S) URLs.
+func providersSchemaPaths(path string) []string {
+	var paths []string
+
+	for _, p := range strings.Split(path, ",") {
+		p = strings.TrimSpace(p)
+		if p != "" {
+			paths = append(paths, p)
+		}
+	}
+
+	return paths
+}
+
+// mergeProvidersSchemas merges the providers schema JSONs read from the given
+// paths, such as the schemas of the servers of a muxed provider which are
+// exported separately, into a single providers schema JSON. The provider
+// schema, and every other value of a provider which is not a mapping of
+// names, such as resource_schemas, must be equal in every JSON it is in.
+// Resources, data sources, functions, and other named items are merged, and
+// an item with the same name in multiple JSONs must be equal in each.
+func mergeProvidersSchemas(paths []string, schemajsons [][]byte) ([]byte, error) {
+	merged := make(map[string]interface{})
+	mergedProviders := make(map[string]interface{})
+
+	// origins are the paths which each merged value was first read from, by
+	// description, for conflict errors.
+	origins := make(map[string]string)
+
+	for i, schemajson := range schemajsons {
+		path := paths[i]
+
+		var schemas map[string]interface{}
+
+		decoder := json.NewDecoder(bytes.NewReader(schemajson))
+		// Numbers are kept as is, as they are written back into the merged
+		// JSON.
+		decoder.UseNumber()
+
+		err := decoder.Decode(&schemas)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse providers schema %q: %w", path, err)
+		}
+
+		for _, key := range sortedKeys(schemas) {
+			if key == "provider_schemas" {
+				continue
+			}
+
+			err = mergeProvidersSchemaValue(merged, key, schemas[key], origins, fmt.Sprintf("%q", key), path)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		providers, ok := schemas["provider_schemas"].(map[string]interface{})
+		if !ok && schemas["provider_schemas"] != nil {
+			return nil, fmt.Errorf("unable to parse providers schema %q: expected \"provider_schemas\" to be an object", path)
+		}
+
+		for _, address := range sortedKeys(providers) {
+			provider, ok := providers[address].(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("unable to parse providers schema %q: expected schema of provider %q to be an object", path, address)
+			}
+
+			mergedProvider, ok := mergedProviders[address].(map[string]interface{})
+			if !ok {
+				mergedProvider = make(map[string]interface{})
+				mergedProviders[address] = mergedProvider
+			}
+
+			for _, key := range sortedKeys(provider) {
+				items, ok := provider[key].(map[string]interface{})
+				if key == "provider" || !ok {
+					err = mergeProvidersSchemaValue(mergedProvider, key, provider[key], origins, fmt.Sprintf("%q of provider %q", key, address), path)
+					if err != nil {
+						return nil, err
+					}
+
+					continue
+				}
+
+				mergedItems, ok := mergedProvider[key].(map[string]interface{})
+				if !ok {
+					mergedItems = make(map[string]interface{})
+					mergedProvider[key] = mergedItems
+				}
+
+				for _, name := range sortedKeys(items) {
+					err = mergeProvidersSchemaValue(mergedItems, name, items[name], origins, fmt.Sprintf("%q in %q of provider %q", name, key, address), path)
+					if err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+	}
+
+	merged["provider_schemas"] = mergedProviders
+
+	return json.Marshal(merged)
+}
+
+// mergeProvidersSchemaValue sets the key of target to value, read from path,
+// or returns an error if it is already set to a different value.
+func mergeProvidersSchemaValue(target map[string]interface{}, key string, value interface{}, origins map[string]string, description, path string) error {
+	existing, ok := target[key]
+	if !ok {
+		target[key] = value
+		origins[description] = path
+
+		return nil
+	}
+
+	if !reflect.DeepEqual(existing, value) {
+		return fmt.Errorf("conflicting %s in providers schemas %q and %q", description, origins[description], path)
+	}
+
+	return nil
+}
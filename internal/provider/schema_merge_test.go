@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestMergeProvidersSchemas(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemajsons   []string
+		expected      string
+		expectedError string
+	}{
+		"muxed servers": {
+			schemajsons: []string{
+				`{"format_version":"1.0","provider_schemas":{"registry.terraform.io/hashicorp/scaffolding":{"provider":{"version":0},"resource_schemas":{"scaffolding_one":{"version":0}},"functions":{"one":{"return_type":"string"}}}}}`,
+				`{"format_version":"1.0","provider_schemas":{"registry.terraform.io/hashicorp/scaffolding":{"provider":{"version":0},"resource_schemas":{"scaffolding_two":{"version":1}},"data_source_schemas":{"scaffolding_two":{"version":0}}}}}`,
+			},
+			expected: `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/hashicorp/scaffolding":{"provider":{"version":0},"resource_schemas":{"scaffolding_one":{"version":0},"scaffolding_two":{"version":1}},"data_source_schemas":{"scaffolding_two":{"version":0}},"functions":{"one":{"return_type":"string"}}}}}`,
+		},
+		"multiple providers": {
+			schemajsons: []string{
+				`{"format_version":"1.0","provider_schemas":{"registry.terraform.io/hashicorp/one":{"provider":{"version":0}}}}`,
+				`{"format_version":"1.0","provider_schemas":{"registry.terraform.io/hashicorp/two":{"provider":{"version":0}}}}`,
+			},
+			expected: `{"format_version":"1.0","provider_schemas":{"registry.terraform.io/hashicorp/one":{"provider":{"version":0}},"registry.terraform.io/hashicorp/two":{"provider":{"version":0}}}}`,
+		},
+		"equal item": {
+			schemajsons: []string{
+				`{"format_version":"1.0","provider_schemas":{"scaffolding":{"resource_schemas":{"scaffolding_one":{"version":0}}}}}`,
+				`{"format_version":"1.0","provider_schemas":{"scaffolding":{"resource_schemas":{"scaffolding_one":{"version":0}}}}}`,
+			},
+			expected: `{"format_version":"1.0","provider_schemas":{"scaffolding":{"resource_schemas":{"scaffolding_one":{"version":0}}}}}`,
+		},
+		"conflicting item": {
+			schemajsons: []string{
+				`{"format_version":"1.0","provider_schemas":{"scaffolding":{"resource_schemas":{"scaffolding_one":{"version":0}}}}}`,
+				`{"format_version":"1.0","provider_schemas":{"scaffolding":{"resource_schemas":{"scaffolding_one":{"version":1}}}}}`,
+			},
+			expectedError: `conflicting "scaffolding_one" in "resource_schemas" of provider "scaffolding" in providers schemas "0.json" and "1.json"`,
+		},
+		"conflicting provider schema": {
+			schemajsons: []string{
+				`{"format_version":"1.0","provider_schemas":{"scaffolding":{"provider":{"version":0}}}}`,
+				`{"format_version":"1.0","provider_schemas":{"scaffolding":{"provider":{"version":1}}}}`,
+			},
+			expectedError: `conflicting "provider" of provider "scaffolding" in providers schemas "0.json" and "1.json"`,
+		},
+		"conflicting format version": {
+			schemajsons: []string{
+				`{"format_version":"1.0","provider_schemas":{}}`,
+				`{"format_version":"0.2","provider_schemas":{}}`,
+			},
+			expectedError: `conflicting "format_version" in providers schemas "0.json" and "1.json"`,
+		},
+		"invalid JSON": {
+			schemajsons: []string{
+				`{"format_version":"1.0","provider_schemas":{}}`,
+				`{`,
+			},
+			expectedError: `unable to parse providers schema "1.json": unexpected EOF`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			paths := make([]string, 0, len(testCase.schemajsons))
+			schemajsons := make([][]byte, 0, len(testCase.schemajsons))
+			for i, schemajson := range testCase.schemajsons {
+				paths = append(paths, fmt.Sprintf("%d.json", i))
+				schemajsons = append(schemajsons, []byte(schemajson))
+			}
+
+			actual, err := mergeProvidersSchemas(paths, schemajsons)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			var expected, actualJSON interface{}
+			if err := json.Unmarshal([]byte(testCase.expected), &expected); err != nil {
+				t.Fatalf("unable to parse expected JSON: %s", err)
+			}
+			if err := json.Unmarshal(actual, &actualJSON); err != nil {
+				t.Fatalf("unable to parse merged JSON: %s", err)
+			}
+
+			if diff := cmp.Diff(expected, actualJSON); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestProvidersSchemaPaths(t *testing.T) {
+	t.Parallel()
+
+	actual := providersSchemaPaths(" one.json, -,,https://example.com/two.json ")
+	expected := []string{"one.json", "-", "https://example.com/two.json"}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
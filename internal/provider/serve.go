@@ -0,0 +1,602 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"hash/fnv"
+	"html/template"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/cli"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/yuin/goldmark"
+	meta "github.com/yuin/goldmark-meta"
+	"github.com/yuin/goldmark/extension"
+	"github.com/yuin/goldmark/parser"
+	"github.com/yuin/goldmark/renderer/html"
+)
+
+const (
+	// serveVersionPath is polled by served pages to detect when the website
+	// has been re-rendered and the page should be reloaded.
+	serveVersionPath = "/_tfplugindocs/version"
+
+	// serveWatchInterval is how often the templates and examples directories
+	// are checked for changes.
+	serveWatchInterval = time.Second
+)
+
+// serveSections are the navigation sections of the served website, in order.
+var serveSections = []struct {
+	dir   string
+	title string
+}{
+	{"guides", "Guides"},
+	{"resources", "Resources"},
+	{"data-sources", "Data Sources"},
+	{"ephemeral-resources", "Ephemeral Resources"},
+	{"list-resources", "List Resources"},
+	{"actions", "Actions"},
+	{"functions", "Functions"},
+}
+
+// Serve renders the website with the options, as Generate, and serves a live
+// reloading preview of it on the address until it is interrupted, such as
+// with Ctrl-C. The rendered website directory is not written, so the options
+// of writing and checking it, such as Check, Only, and EmitNav, are not
+// supported or ignored.
+func Serve(ui cli.Ui, opts GenerateOptions, address string) error {
+	switch {
+	case opts.Check:
+		return fmt.Errorf("check cannot be used with serve")
+	case opts.DryRun:
+		return fmt.Errorf("dry run cannot be used with serve")
+	case len(opts.Only) > 0:
+		return fmt.Errorf("only patterns cannot be used with serve, as the served website is fully rendered")
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	g, err := newGenerator(ctx, ui, opts)
+	if err != nil {
+		return err
+	}
+
+	err = g.setProviderDefaults()
+	if err != nil {
+		return err
+	}
+
+	return g.Serve(ctx, address)
+}
+
+// Serve renders the website into a temporary directory and serves it over
+// HTTP until the context is cancelled. The website is re-rendered whenever
+// the templates directory, examples directory, or providers schema file
+// change and served pages are reloaded automatically.
+func (g *generator) Serve(ctx context.Context, address string) error {
+	serveDir, err := os.MkdirTemp("", "tfws-serve")
+	if err != nil {
+		return fmt.Errorf("error creating temporary serve directory: %w", err)
+	}
+	defer os.RemoveAll(serveDir)
+
+	g.websiteTmpDir = filepath.Join(serveDir, "tmp")
+
+	providerSchema, err := g.providerSchema(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = g.loadMetadataFiles(providerSchema)
+	if err != nil {
+		return err
+	}
+
+	s := &docsServer{
+		providerName: g.renderedProviderName,
+	}
+
+	build := 0
+	rebuild := func() error {
+		build++
+		renderedDir := filepath.Join(serveDir, "build-"+strconv.Itoa(build))
+
+		err := g.renderServedWebsite(ctx, renderedDir, providerSchema)
+		if err != nil {
+			_ = os.RemoveAll(renderedDir)
+			return err
+		}
+
+		previousDir := s.update(renderedDir)
+		if previousDir != "" {
+			_ = os.RemoveAll(previousDir)
+		}
+
+		return nil
+	}
+
+	err = rebuild()
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Addr:              address,
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		serveErr <- server.ListenAndServe()
+	}()
+
+	g.infof("serving website for provider %q at http://%s (press Ctrl+C to stop)", g.providerName, address)
+
+	fingerprint := g.serveWatchFingerprint()
+	ticker := time.NewTicker(serveWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			g.infof("stopping server")
+
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			return server.Shutdown(shutdownCtx)
+		case err := <-serveErr:
+			return fmt.Errorf("error serving website: %w", err)
+		case <-ticker.C:
+			current := g.serveWatchFingerprint()
+			if current == fingerprint {
+				continue
+			}
+			fingerprint = current
+
+			g.infof("change detected, re-rendering website")
+
+			if g.providersSchemaPath != "" {
+				updatedSchema, err := g.providerSchema(ctx)
+				if err != nil {
+					g.ui.Error(fmt.Sprintf("Error re-rendering website: %s", err))
+					s.setError(err)
+					continue
+				}
+				providerSchema = updatedSchema
+			}
+
+			err := rebuild()
+			if err != nil {
+				g.ui.Error(fmt.Sprintf("Error re-rendering website: %s", err))
+				s.setError(err)
+			}
+		}
+	}
+}
+
+// renderServedWebsite copies the templates, generates any missing templates,
+// and renders the website into renderedDir without modifying the provider's
+// rendered website directory.
+func (g *generator) renderServedWebsite(ctx context.Context, renderedDir string, providerSchema *tfjson.ProviderSchema) error {
+	err := os.RemoveAll(g.websiteTmpDir)
+	if err != nil {
+		return fmt.Errorf("error removing temporary website directory %q: %w", g.websiteTmpDir, err)
+	}
+
+	err = os.MkdirAll(g.websiteTmpDir, 0755)
+	if err != nil {
+		return fmt.Errorf("error creating temporary website directory %q: %w", g.websiteTmpDir, err)
+	}
+
+	err = g.copyTemplates()
+	if err != nil {
+		return err
+	}
+
+	err = g.generateMissingTemplates(providerSchema)
+	if err != nil {
+		return fmt.Errorf("error generating missing templates: %w", err)
+	}
+
+	err = g.renderWebsite(ctx, renderedDir, providerSchema)
+	if err != nil {
+		return fmt.Errorf("error rendering website: %w", err)
+	}
+
+	return nil
+}
+
+// serveWatchFingerprint returns a hash of the paths, sizes, and modification
+// times of all files which are inputs to the rendered website.
+func (g *generator) serveWatchFingerprint() uint64 {
+	h := fnv.New64a()
+
+	paths := []string{g.ProviderTemplatesDir(), g.ProviderExamplesDir()}
+	// The providers schema JSONs are only watched if they are files, as stdin
+	// cannot be re-read and URLs are not polled.
+	for _, path := range providersSchemaPaths(g.providersSchemaPath) {
+		if path != providersSchemaStdin && !isProvidersSchemaURL(path) {
+			paths = append(paths, path)
+		}
+	}
+
+	for _, root := range paths {
+		_ = filepath.WalkDir(root, func(p string, d os.DirEntry, err error) error {
+			if err != nil || d.IsDir() {
+				return nil //nolint:nilerr // missing inputs are not an error
+			}
+
+			info, err := d.Info()
+			if err != nil {
+				return nil //nolint:nilerr // files removed while walking are not an error
+			}
+
+			fmt.Fprintf(h, "%s\x00%d\x00%d\x00", p, info.Size(), info.ModTime().UnixNano())
+			return nil
+		})
+	}
+
+	return h.Sum64()
+}
+
+// docsServer is an http.Handler which renders the Markdown files of a
+// rendered website directory as HTML.
+type docsServer struct {
+	providerName string
+
+	mu      sync.RWMutex
+	dir     string
+	version int
+	err     error
+}
+
+// update sets the directory being served, increments the version so served
+// pages reload, and returns the previously served directory.
+func (s *docsServer) update(dir string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	previous := s.dir
+	s.dir = dir
+	s.version++
+	s.err = nil
+
+	return previous
+}
+
+// setError records a rendering error, which is displayed on served pages
+// until the next successful render.
+func (s *docsServer) setError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.err = err
+	s.version++
+}
+
+func (s *docsServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.RLock()
+	dir, version, renderErr := s.dir, s.version, s.err
+	s.mu.RUnlock()
+
+	if r.URL.Path == serveVersionPath {
+		w.Header().Set("Cache-Control", "no-store")
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		_, _ = fmt.Fprint(w, version)
+		return
+	}
+
+	urlPath := strings.Trim(path.Clean("/"+r.URL.Path), "/")
+
+	filePath, ok := servedFilePath(dir, urlPath)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+
+	if !isMarkdownFile(filePath) {
+		http.ServeFile(w, r, filePath)
+		return
+	}
+
+	content, err := os.ReadFile(filePath)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	page, err := renderServedPage(content)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("unable to render %q: %s", urlPath, err), http.StatusInternalServerError)
+		return
+	}
+
+	nav, err := servedNavigation(dir)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data := servedPageData{
+		ProviderName: s.providerName,
+		Title:        page.title,
+		Subcategory:  page.subcategory,
+		Content:      page.content,
+		Navigation:   nav,
+		Current:      "/" + servedURLPath(strings.TrimPrefix(filePath, dir+string(filepath.Separator))),
+		Version:      version,
+		VersionPath:  serveVersionPath,
+	}
+
+	if renderErr != nil {
+		data.Error = renderErr.Error()
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+
+	err = servedPageTemplate.Execute(w, data)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// servedFilePath returns the file in dir for the given slash separated URL
+// path, trying Markdown file extensions if there is no exact match.
+func servedFilePath(dir, urlPath string) (string, bool) {
+	if urlPath == "" {
+		urlPath = "index"
+	}
+
+	candidates := []string{urlPath}
+	for _, ext := range ValidLegacyFileExtensions {
+		candidates = append(candidates, urlPath+ext)
+	}
+
+	for _, candidate := range candidates {
+		p := filepath.Join(dir, filepath.FromSlash(candidate))
+		if fileExists(p) {
+			return p, true
+		}
+	}
+
+	return "", false
+}
+
+// servedURLPath returns the URL path, without leading slash, for a file path
+// relative to the served directory.
+func servedURLPath(rel string) string {
+	rel = filepath.ToSlash(rel)
+	if !isMarkdownFile(rel) {
+		return rel
+	}
+
+	return path.Join(path.Dir(rel), removeAllExt(path.Base(rel)))
+}
+
+func isMarkdownFile(p string) bool {
+	for _, ext := range ValidLegacyFileExtensions {
+		if strings.HasSuffix(p, ext) {
+			return true
+		}
+	}
+
+	return false
+}
+
+type servedPage struct {
+	title       string
+	subcategory string
+	content     template.HTML
+}
+
+// renderServedPage converts a rendered Markdown page, including its YAML
+// frontmatter, into HTML.
+func renderServedPage(src []byte) (*servedPage, error) {
+	md := goldmark.New(
+		goldmark.WithExtensions(
+			extension.GFM,
+			meta.Meta,
+		),
+		goldmark.WithParserOptions(
+			parser.WithAttribute(),
+			parser.WithAutoHeadingID(),
+		),
+		goldmark.WithRendererOptions(
+			// Generated schema Markdown contains raw HTML anchors.
+			html.WithUnsafe(),
+		),
+	)
+
+	var buf bytes.Buffer
+	ctx := parser.NewContext()
+
+	err := md.Convert(src, &buf, parser.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	page := &servedPage{
+		content: template.HTML(buf.String()),
+	}
+
+	frontMatter, err := meta.TryGet(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing YAML frontmatter: %w", err)
+	}
+
+	if title, ok := frontMatter["page_title"].(string); ok {
+		page.title = title
+	}
+
+	if subcategory, ok := frontMatter["subcategory"].(string); ok {
+		page.subcategory = subcategory
+	}
+
+	return page, nil
+}
+
+type servedNavSection struct {
+	Title string
+	Links []servedNavLink
+}
+
+type servedNavLink struct {
+	Name string
+	Path string
+}
+
+// servedNavigation returns the navigation sections for all Markdown files in
+// the managed subdirectories of dir.
+func servedNavigation(dir string) ([]servedNavSection, error) {
+	var sections []servedNavSection
+
+	for _, section := range serveSections {
+		sectionDir := filepath.Join(dir, section.dir)
+		if !dirExists(sectionDir) {
+			continue
+		}
+
+		var links []servedNavLink
+
+		err := filepath.WalkDir(sectionDir, func(p string, d os.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !isMarkdownFile(p) {
+				return nil
+			}
+
+			rel, err := filepath.Rel(dir, p)
+			if err != nil {
+				return err
+			}
+
+			links = append(links, servedNavLink{
+				Name: removeAllExt(d.Name()),
+				Path: "/" + servedURLPath(rel),
+			})
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to walk directory %q: %w", sectionDir, err)
+		}
+
+		if len(links) == 0 {
+			continue
+		}
+
+		sort.Slice(links, func(i, j int) bool {
+			return links[i].Name < links[j].Name
+		})
+
+		sections = append(sections, servedNavSection{
+			Title: section.title,
+			Links: links,
+		})
+	}
+
+	return sections, nil
+}
+
+type servedPageData struct {
+	ProviderName string
+	Title        string
+	Subcategory  string
+	Content      template.HTML
+	Navigation   []servedNavSection
+	Current      string
+	Error        string
+	Version      int
+	VersionPath  string
+}
+
+// docsPageStyle is the stylesheet of served pages and of the static HTML
+// site.
+const docsPageStyle = `body { margin: 0; font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; color: #0c0c0e; background: #fff; line-height: 1.6; }
+header { background: #000; color: #fff; padding: 12px 24px; font-weight: 600; }
+header a { color: #fff; text-decoration: none; }
+header .badge { margin-left: 8px; padding: 2px 8px; border-radius: 4px; background: #7b42bc; font-size: 12px; font-weight: 500; }
+.error { background: #fcf0f2; border-bottom: 1px solid #e52228; color: #9e0000; padding: 12px 24px; white-space: pre-wrap; font-family: monospace; }
+.container { display: flex; max-width: 1280px; margin: 0 auto; }
+nav { flex: 0 0 280px; padding: 24px; border-right: 1px solid #dedee3; font-size: 14px; }
+nav h4 { margin: 16px 0 4px; text-transform: uppercase; font-size: 12px; color: #656a76; }
+nav ul { list-style: none; margin: 0; padding: 0; }
+nav a { display: block; padding: 2px 8px; border-radius: 4px; color: #3b3d45; text-decoration: none; word-break: break-all; }
+nav a:hover { background: #f1f2f3; }
+nav a.current { background: #f4ecff; color: #7b42bc; font-weight: 600; }
+main { flex: 1; min-width: 0; padding: 24px 48px; }
+main .subcategory { color: #656a76; font-size: 14px; }
+main h1 { font-size: 32px; border-bottom: 1px solid #dedee3; padding-bottom: 8px; }
+main a { color: #1060ff; }
+main code { background: #f1f2f3; border-radius: 4px; padding: 1px 4px; font-size: 90%; }
+main pre { background: #0d0e12; color: #efeff1; border-radius: 6px; padding: 16px; overflow-x: auto; }
+main pre code { background: none; padding: 0; color: inherit; }
+main table { border-collapse: collapse; }
+main th, main td { border: 1px solid #dedee3; padding: 6px 12px; }
+main blockquote { margin: 0; padding: 8px 16px; border-left: 4px solid #7b42bc; background: #f4ecff; }
+`
+
+var servedPageTemplate = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{ if .Title }}{{ .Title }} | {{ end }}{{ .ProviderName }} documentation preview</title>
+<style>
+` + docsPageStyle + `</style>
+</head>
+<body>
+<header><a href="/">{{ .ProviderName }}</a><span class="badge">Preview</span></header>
+{{- if .Error }}
+<div class="error">{{ .Error }}</div>
+{{- end }}
+<div class="container">
+<nav>
+<ul><li><a href="/"{{ if eq .Current "/index" }} class="current"{{ end }}>Overview</a></li></ul>
+{{- range .Navigation }}
+<h4>{{ .Title }}</h4>
+<ul>
+{{- range .Links }}
+<li><a href="{{ .Path }}"{{ if eq .Path $.Current }} class="current"{{ end }}>{{ .Name }}</a></li>
+{{- end }}
+</ul>
+{{- end }}
+</nav>
+<main>
+{{- if .Subcategory }}
+<div class="subcategory">{{ .Subcategory }}</div>
+{{- end }}
+{{ .Content }}
+</main>
+</div>
+<script>
+(function () {
+  var version = {{ .Version }};
+  setInterval(function () {
+    fetch({{ .VersionPath }}, { cache: "no-store" })
+      .then(function (response) { return response.text(); })
+      .then(function (text) { if (parseInt(text, 10) !== version) { window.location.reload(); } })
+      .catch(function () {});
+  }, 1000);
+})();
+</script>
+</body>
+</html>
+`))
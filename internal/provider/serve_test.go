@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/cli"
+)
+
+func TestDocsServer_ServeHTTP(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	writeTestFiles(t, dir, map[string]string{
+		"index.md": `---
+page_title: "scaffolding Provider"
+---
+
+# scaffolding Provider
+`,
+		"resources/example.md": `---
+page_title: "scaffolding_example Resource - terraform-provider-scaffolding"
+subcategory: "Examples"
+---
+
+# scaffolding_example (Resource)
+
+<a id="nestedblock--timeouts"></a>
+### Nested Schema for ` + "`timeouts`",
+		"images/logo.svg": `<svg></svg>`,
+	})
+
+	s := &docsServer{
+		providerName: "terraform-provider-scaffolding",
+	}
+	s.update(dir)
+
+	cases := map[string]struct {
+		path             string
+		expectedStatus   int
+		expectedContains []string
+	}{
+		"index": {
+			path:           "/",
+			expectedStatus: http.StatusOK,
+			expectedContains: []string{
+				"<title>scaffolding Provider | terraform-provider-scaffolding documentation preview</title>",
+				`<a href="/" class="current">Overview</a>`,
+				`<li><a href="/resources/example">example</a></li>`,
+				`<h1 id="scaffolding-provider">scaffolding Provider</h1>`,
+			},
+		},
+		"resource": {
+			path:           "/resources/example",
+			expectedStatus: http.StatusOK,
+			expectedContains: []string{
+				`<li><a href="/resources/example" class="current">example</a></li>`,
+				`<div class="subcategory">Examples</div>`,
+				`<a id="nestedblock--timeouts"></a>`,
+			},
+		},
+		"resource with extension": {
+			path:           "/resources/example.md",
+			expectedStatus: http.StatusOK,
+			expectedContains: []string{
+				`<h1 id="scaffolding-example-resource">scaffolding_example (Resource)</h1>`,
+			},
+		},
+		"static file": {
+			path:           "/images/logo.svg",
+			expectedStatus: http.StatusOK,
+			expectedContains: []string{
+				`<svg></svg>`,
+			},
+		},
+		"version": {
+			path:           serveVersionPath,
+			expectedStatus: http.StatusOK,
+			expectedContains: []string{
+				"1",
+			},
+		},
+		"not found": {
+			path:           "/resources/missing",
+			expectedStatus: http.StatusNotFound,
+		},
+		"outside directory": {
+			path:           "/../../etc/passwd",
+			expectedStatus: http.StatusNotFound,
+		},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := httptest.NewRecorder()
+			s.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "http://localhost"+c.path, nil))
+
+			if recorder.Code != c.expectedStatus {
+				t.Fatalf("expected status %d, got %d: %s", c.expectedStatus, recorder.Code, recorder.Body.String())
+			}
+
+			for _, expected := range c.expectedContains {
+				if !strings.Contains(recorder.Body.String(), expected) {
+					t.Errorf("expected body to contain %q, got: %s", expected, recorder.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestServe_UnsupportedOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts          GenerateOptions
+		expectedError string
+	}{
+		"check": {
+			opts:          GenerateOptions{Check: true},
+			expectedError: "check cannot be used with serve",
+		},
+		"dry run": {
+			opts:          GenerateOptions{DryRun: true},
+			expectedError: "dry run cannot be used with serve",
+		},
+		"only": {
+			opts:          GenerateOptions{Only: []string{"resources/*"}},
+			expectedError: "only patterns cannot be used with serve, as the served website is fully rendered",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Serve(cli.NewMockUi(), testCase.opts, "localhost:0")
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
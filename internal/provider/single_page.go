@@ -0,0 +1,274 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+	goldmarkhtml "github.com/yuin/goldmark/renderer/html"
+)
+
+var (
+	// singlePageHeading matches ATX headings, which are demoted when pages
+	// are combined.
+	singlePageHeading = regexp.MustCompile(`^(#{1,6})(\s)`)
+
+	// singlePageAnchor and singlePageAnchorLink match the anchors, and links
+	// to anchors, of a page, such as those of nested schemas, which are
+	// prefixed with the anchor of the page when pages are combined.
+	singlePageAnchor     = regexp.MustCompile(`<a id="([^"]+)">`)
+	singlePageAnchorLink = regexp.MustCompile(`\]\(#([^)]+)\)`)
+)
+
+// singlePage is a rendered page of the single page documentation.
+type singlePage struct {
+	// title is the label of the page in the table of contents.
+	title string
+
+	// anchor is the unique anchor of the page, derived from its path.
+	anchor string
+
+	content string
+}
+
+// singlePageSection is a titled group of pages of the single page
+// documentation, such as the resources.
+type singlePageSection struct {
+	title string
+	pages []singlePage
+}
+
+// SinglePagePath returns the absolute path of the single page documentation
+// file.
+func (g *generator) SinglePagePath() string {
+	if filepath.IsAbs(g.emitSinglePage) {
+		return g.emitSinglePage
+	}
+
+	return filepath.Join(g.providerDir, g.emitSinglePage)
+}
+
+// writeSinglePage writes the rendered provider index page, guides, and item
+// pages as a single document with a table of contents to the single page
+// path. The document is written as HTML if the path has an .html or .htm
+// extension, otherwise as Markdown.
+func (g *generator) writeSinglePage(providerSchema *tfjson.ProviderSchema) error {
+	g.infof("writing single page documentation to %q", g.emitSinglePage)
+
+	provider, sections, err := g.singlePageSections(providerSchema)
+	if err != nil {
+		return err
+	}
+
+	title := g.renderedProviderName + " Documentation"
+	content := renderSinglePageMarkdown(title, provider, sections)
+
+	switch strings.ToLower(filepath.Ext(g.emitSinglePage)) {
+	case ".html", ".htm":
+		content, err = renderSinglePageHTML(title, content)
+		if err != nil {
+			return fmt.Errorf("unable to render single page documentation as HTML: %w", err)
+		}
+	}
+
+	return writeFile(g.SinglePagePath(), content)
+}
+
+// singlePageSections returns the rendered provider index page, if any, and a
+// section for the guides and each item type with rendered pages. Pages are
+// sorted by title.
+func (g *generator) singlePageSections(providerSchema *tfjson.ProviderSchema) (*singlePage, []singlePageSection, error) {
+	var provider *singlePage
+
+	indexFile := renderedFilePath("index.md", g.outputExtension)
+	if fileExists(filepath.Join(g.ProviderDocsDir(), indexFile)) {
+		page, err := g.readSinglePage("Provider", indexFile)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		provider = &page
+	}
+
+	var sections []singlePageSection
+
+	guides, err := g.navGuides()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	section := singlePageSection{title: "Guides"}
+	for _, guide := range guides {
+		page, err := g.readSinglePage(guide.Label, guide.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		section.pages = append(section.pages, page)
+	}
+
+	if len(section.pages) > 0 {
+		sections = append(sections, section)
+	}
+
+	for _, indexSection := range g.indexSections(providerSchema) {
+		section := singlePageSection{title: indexSection.title}
+
+		for _, item := range indexSection.items {
+			// Pages which are not rendered, such as those of items without
+			// templates when partially generating, are omitted.
+			if !fileExists(filepath.Join(g.ProviderDocsDir(), filepath.FromSlash(item.path))) {
+				continue
+			}
+
+			page, err := g.readSinglePage(item.name, item.path)
+			if err != nil {
+				return nil, nil, err
+			}
+
+			section.pages = append(section.pages, page)
+		}
+
+		if len(section.pages) == 0 {
+			continue
+		}
+
+		sort.SliceStable(section.pages, func(i, j int) bool {
+			return section.pages[i].title < section.pages[j].title
+		})
+
+		sections = append(sections, section)
+	}
+
+	return provider, sections, nil
+}
+
+// readSinglePage reads the rendered page with the slash-separated path,
+// relative to the rendered website directory, without its frontmatter.
+func (g *generator) readSinglePage(title, rel string) (singlePage, error) {
+	content, err := os.ReadFile(filepath.Join(g.ProviderDocsDir(), filepath.FromSlash(rel)))
+	if err != nil {
+		return singlePage{}, fmt.Errorf("unable to read rendered file %q: %w", rel, err)
+	}
+
+	body := string(content)
+	for _, delimiter := range []string{"---", "+++"} {
+		if _, rest, ok := splitDelimitedFrontmatter(body, delimiter); ok {
+			body = rest
+			break
+		}
+	}
+
+	return singlePage{
+		title:   title,
+		anchor:  strings.ReplaceAll(trimOutputExtension(path.Clean(rel)), "/", "-"),
+		content: strings.TrimSpace(body),
+	}, nil
+}
+
+// renderSinglePageMarkdown returns the Markdown document of the provider page
+// and sections, starting with a table of contents. The headings of the
+// provider page are demoted by one level, and the headings of the pages of
+// sections by two levels, below the section headings.
+func renderSinglePageMarkdown(title string, provider *singlePage, sections []singlePageSection) string {
+	b := &strings.Builder{}
+
+	fmt.Fprintf(b, "# %s\n\n", title)
+	b.WriteString("## Contents\n\n")
+
+	if provider != nil {
+		fmt.Fprintf(b, "- [%s](#%s)\n", provider.title, provider.anchor)
+	}
+
+	for _, section := range sections {
+		fmt.Fprintf(b, "- %s\n", section.title)
+
+		for _, page := range section.pages {
+			fmt.Fprintf(b, "  - [%s](#%s)\n", page.title, page.anchor)
+		}
+	}
+
+	if provider != nil {
+		writeSinglePage(b, *provider, 1)
+	}
+
+	for _, section := range sections {
+		fmt.Fprintf(b, "\n## %s\n", section.title)
+
+		for _, page := range section.pages {
+			writeSinglePage(b, page, 2)
+		}
+	}
+
+	return b.String()
+}
+
+// writeSinglePage writes the anchor and content of the page, with headings
+// demoted by the given number of levels, up to level six, and the anchors of
+// the page prefixed with the page anchor. Fenced code blocks are not
+// modified.
+func writeSinglePage(b *strings.Builder, page singlePage, demote int) {
+	fmt.Fprintf(b, "\n<a id=%q></a>\n\n", page.anchor)
+
+	var fence string
+
+	for _, line := range strings.Split(page.content, "\n") {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case fence != "":
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+		case strings.HasPrefix(trimmed, "```"):
+			fence = "```"
+		case strings.HasPrefix(trimmed, "~~~"):
+			fence = "~~~"
+		default:
+			if m := singlePageHeading.FindStringSubmatch(line); m != nil {
+				level := len(m[1]) + demote
+				if level > 6 {
+					level = 6
+				}
+
+				line = strings.Repeat("#", level) + line[len(m[1]):]
+			}
+
+			line = singlePageAnchor.ReplaceAllString(line, fmt.Sprintf(`<a id="%s--$1">`, page.anchor))
+			line = singlePageAnchorLink.ReplaceAllString(line, fmt.Sprintf(`](#%s--$1)`, page.anchor))
+		}
+
+		b.WriteString(line)
+		b.WriteString("\n")
+	}
+}
+
+// renderSinglePageHTML converts the Markdown document into a standalone HTML
+// document. Raw HTML, such as anchors, is passed through.
+func renderSinglePageHTML(title, markdown string) (string, error) {
+	md := goldmark.New(
+		goldmark.WithExtensions(extension.GFM),
+		goldmark.WithRendererOptions(goldmarkhtml.WithUnsafe()),
+	)
+
+	var body bytes.Buffer
+
+	err := md.Convert([]byte(markdown), &body)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("<!DOCTYPE html>\n<html>\n<head>\n<meta charset=\"utf-8\">\n<title>%s</title>\n</head>\n<body>\n%s</body>\n</html>\n", html.EscapeString(title), body.String()), nil
+}
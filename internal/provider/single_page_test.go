@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRenderSinglePageMarkdown(t *testing.T) {
+	t.Parallel()
+
+	provider := &singlePage{
+		title:   "Provider",
+		anchor:  "index",
+		content: "# scaffolding Provider\n\n## Schema",
+	}
+
+	sections := []singlePageSection{
+		{
+			title: "Resources",
+			pages: []singlePage{
+				{
+					title:  "scaffolding_example",
+					anchor: "resources-example",
+					content: "# scaffolding_example (Resource)\n\n" +
+						"```terraform\n# comment\n```\n\n" +
+						"## Schema\n\n" +
+						"- `setting` (Block List) (see [below for nested schema](#nestedblock--setting))\n\n" +
+						"<a id=\"nestedblock--setting\"></a>\n" +
+						"### Nested Schema for `setting`\n\n" +
+						"##### Deep\n\n" +
+						"#not a heading",
+				},
+			},
+		},
+	}
+
+	expected := "# terraform-provider-scaffolding Documentation\n\n" +
+		"## Contents\n\n" +
+		"- [Provider](#index)\n" +
+		"- Resources\n" +
+		"  - [scaffolding_example](#resources-example)\n\n" +
+		"<a id=\"index\"></a>\n\n" +
+		"## scaffolding Provider\n\n" +
+		"### Schema\n\n" +
+		"## Resources\n\n" +
+		"<a id=\"resources-example\"></a>\n\n" +
+		"### scaffolding_example (Resource)\n\n" +
+		"```terraform\n# comment\n```\n\n" +
+		"#### Schema\n\n" +
+		"- `setting` (Block List) (see [below for nested schema](#resources-example--nestedblock--setting))\n\n" +
+		"<a id=\"resources-example--nestedblock--setting\"></a>\n" +
+		"##### Nested Schema for `setting`\n\n" +
+		"###### Deep\n\n" +
+		"#not a heading\n"
+
+	actual := renderSinglePageMarkdown("terraform-provider-scaffolding Documentation", provider, sections)
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,299 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// nestedSchemaAnchorRegexp matches the anchor line of a nested schema
+// section, capturing the path of the nested type, separated by --.
+var nestedSchemaAnchorRegexp = regexp.MustCompile(`^<a id="nested(?:block|att|objatt)--([^"]+)"></a>$`)
+
+// anchorIDRegexp matches the HTML anchors of a page, capturing their ID.
+var anchorIDRegexp = regexp.MustCompile(`<a id="([^"]+)"></a>`)
+
+// anchorLinkRegexp matches the Markdown links to anchors of the same page,
+// capturing the anchor ID.
+var anchorLinkRegexp = regexp.MustCompile(`\]\(#([^)\s]+)\)`)
+
+// splitPage is a page of the nested schema sections moved out of an
+// oversized rendered page.
+type splitPage struct {
+	// rel is the slash-separated path of the page, relative to the rendered
+	// website directory.
+	rel string
+
+	content string
+}
+
+// schemaSection is the line range of a top-level nested schema section of a
+// rendered page, followed by the sections of its own nested types.
+type schemaSection struct {
+	name  string
+	start int
+	end   int
+	size  int
+}
+
+// splitOversizedPage moves the top-level nested schema sections of the
+// rendered page at renderedRel, each with the sections of its own nested
+// types, into sibling pages, largest first, until the page is at most maxSize
+// bytes. Links to the anchors of moved sections are updated in every page.
+// The sibling pages are named after the page and the nested type, e.g.
+// resources/example-versioning.md, with frontmatter derived from the page.
+// The returned bool is false if the page still exceeds maxSize.
+func splitOversizedPage(content, renderedRel, label string, maxSize int) (string, []splitPage, bool) {
+	if maxSize <= 0 || len(content) <= maxSize {
+		return content, nil, true
+	}
+
+	lines := strings.SplitAfter(content, "\n")
+	sections := nestedSchemaSections(lines)
+
+	// the largest sections are moved first, keeping the order of equally
+	// sized sections
+	order := make([]int, len(sections))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		return sections[order[a]].size > sections[order[b]].size
+	})
+
+	size := len(content)
+	moved := make([]bool, len(sections))
+	for _, i := range order {
+		if size <= maxSize {
+			break
+		}
+
+		moved[i] = true
+		size -= sections[i].size
+	}
+
+	base := trimOutputExtension(renderedRel)
+	ext := strings.TrimPrefix(renderedRel, base)
+	frontmatter, _, _ := splitFrontmatter(content)
+
+	var pages []splitPage
+	var remaining strings.Builder
+	last := 0
+
+	for i, section := range sections {
+		if !moved[i] {
+			continue
+		}
+
+		remaining.WriteString(strings.Join(lines[last:section.start], ""))
+		last = section.end
+
+		pages = append(pages, splitPage{
+			rel:     base + "-" + section.name + ext,
+			content: splitPageHeader(frontmatter, renderedRel, label, section.name) + strings.TrimRight(strings.Join(lines[section.start:section.end], ""), "\n") + "\n",
+		})
+	}
+
+	remaining.WriteString(strings.Join(lines[last:], ""))
+	content = remaining.String()
+
+	// anchors are moved with their sections, so links to them are updated
+	// in the page and in each of the split pages
+	anchors := pageAnchors(renderedRel, content, nil)
+	for _, page := range pages {
+		anchors = pageAnchors(page.rel, page.content, anchors)
+	}
+
+	content = linkMovedAnchors(content, renderedRel, anchors)
+	for i := range pages {
+		pages[i].content = linkMovedAnchors(pages[i].content, pages[i].rel, anchors)
+	}
+
+	return content, pages, len(content) <= maxSize
+}
+
+// nestedSchemaSections returns the top-level nested schema sections of the
+// lines of a rendered page. A section ends at the next top-level section, or
+// at the next heading which is not a nested schema heading.
+func nestedSchemaSections(lines []string) []schemaSection {
+	var sections []schemaSection
+	var current *schemaSection
+	var fence string
+
+	end := func(i int) {
+		if current == nil {
+			return
+		}
+
+		current.end = i
+		for _, line := range lines[current.start:i] {
+			current.size += len(line)
+		}
+
+		sections = append(sections, *current)
+		current = nil
+	}
+
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case fence != "":
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		case strings.HasPrefix(trimmed, "```"):
+			fence = "```"
+			continue
+		case strings.HasPrefix(trimmed, "~~~"):
+			fence = "~~~"
+			continue
+		}
+
+		if match := nestedSchemaAnchorRegexp.FindStringSubmatch(trimmed); match != nil {
+			if strings.Contains(match[1], "--") {
+				continue
+			}
+
+			end(i)
+			current = &schemaSection{
+				name:  match[1],
+				start: i,
+			}
+			continue
+		}
+
+		// nested schema headings are level 3, so a higher level heading
+		// starts the next part of the page
+		if strings.HasPrefix(trimmed, "# ") || strings.HasPrefix(trimmed, "## ") {
+			end(i)
+		}
+	}
+
+	end(len(lines))
+
+	return sections
+}
+
+// splitPageHeader returns the frontmatter and title of the split page of
+// the nested type with the given name of the page at renderedRel. The
+// subcategory of the frontmatter of the page is kept.
+func splitPageHeader(frontmatter, renderedRel, label, name string) string {
+	title := name
+	if label != "" {
+		title = label + ": " + name
+	}
+
+	var b strings.Builder
+
+	b.WriteString("---\n")
+	b.WriteString(frontmatterComment + "\n")
+	b.WriteString(fmt.Sprintf("page_title: %q\n", title))
+
+	var keys map[string]interface{}
+	if yaml.Unmarshal([]byte(frontmatter), &keys) == nil {
+		if subcategory, ok := keys["subcategory"].(string); ok {
+			b.WriteString(fmt.Sprintf("subcategory: %q\n", subcategory))
+		}
+	}
+
+	b.WriteString(fmt.Sprintf("description: %q\n", "Nested schema for "+name+" of "+pageLabel(renderedRel, label)+"."))
+	b.WriteString("---\n\n")
+	b.WriteString("# " + title + "\n\n")
+	b.WriteString(fmt.Sprintf("Nested schema for `%s` of [%s](%s).\n\n", name, pageLabel(renderedRel, label), path.Base(renderedRel)))
+
+	return b.String()
+}
+
+// pageLabel returns the label of the page, or its file name without the
+// extension if it is not an item page.
+func pageLabel(renderedRel, label string) string {
+	if label != "" {
+		return label
+	}
+
+	return trimOutputExtension(path.Base(renderedRel))
+}
+
+// pageAnchors adds the IDs of the anchors of the page at rel to anchors, which
+// maps the IDs to the path of their page.
+func pageAnchors(rel, content string, anchors map[string]string) map[string]string {
+	if anchors == nil {
+		anchors = make(map[string]string)
+	}
+
+	for _, match := range anchorIDRegexp.FindAllStringSubmatch(content, -1) {
+		anchors[match[1]] = rel
+	}
+
+	return anchors
+}
+
+// linkMovedAnchors converts the links of the page at rel to anchors which are
+// in another page into relative links to that page.
+func linkMovedAnchors(content, rel string, anchors map[string]string) string {
+	return anchorLinkRegexp.ReplaceAllStringFunc(content, func(link string) string {
+		id := anchorLinkRegexp.FindStringSubmatch(link)[1]
+
+		target, ok := anchors[id]
+		if !ok || target == rel {
+			return link
+		}
+
+		return "](" + relativeLink(rel, target) + "#" + id + ")"
+	})
+}
+
+// writeSplitPages writes the pages split from a rendered page into
+// renderedDir, converted into the output and frontmatter dialects, and
+// returns their paths relative to renderedDir.
+func (g *generator) writeSplitPages(renderedDir string, pages []splitPage, l *bufferedLogger) ([]string, error) {
+	var rels []string
+
+	for _, page := range pages {
+		l.infof("writing split page %q", page.rel)
+
+		content := convertOutputDialect(page.content, g.outputExtension, providerShortName(g.providerName))
+
+		content, err := convertFrontmatterDialect(content, g.frontmatterDialect, page.rel, "")
+		if err != nil {
+			return nil, fmt.Errorf("unable to convert frontmatter of %q: %w", page.rel, err)
+		}
+
+		content, err = g.postProcess(page.rel, content)
+		if err != nil {
+			return nil, err
+		}
+
+		err = os.WriteFile(filepath.Join(renderedDir, filepath.FromSlash(page.rel)), []byte(content), 0644)
+		if err != nil {
+			return nil, fmt.Errorf("unable to write file %q: %w", page.rel, err)
+		}
+
+		rels = append(rels, page.rel)
+	}
+
+	return rels, nil
+}
+
+// splitPagesExist returns true if all of the pages, with paths relative to
+// renderedDir, exist.
+func (g *generator) splitPagesExist(renderedDir string, pages []string) bool {
+	for _, page := range pages {
+		if !fileExists(filepath.Join(renderedDir, filepath.FromSlash(page))) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,146 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSplitOversizedPage(t *testing.T) {
+	t.Parallel()
+
+	content := `---
+page_title: "scaffolding_example Resource - scaffolding"
+subcategory: "Storage"
+description: |-
+  Example resource
+---
+
+# scaffolding_example (Resource)
+
+## Schema
+
+### Optional
+
+- ` + "`rule`" + ` (Block List) (see [below for nested schema](#nestedblock--rule))
+- ` + "`tag`" + ` (Block List) (see [below for nested schema](#nestedblock--tag))
+
+<a id="nestedblock--rule"></a>
+### Nested Schema for ` + "`rule`" + `
+
+Optional:
+
+- ` + "`filter`" + ` (Block List) (see [below for nested schema](#nestedblock--rule--filter))
+- ` + "`enabled`" + ` (Boolean) Whether the rule is enabled, which is a long description to make the section the largest.
+
+<a id="nestedblock--rule--filter"></a>
+### Nested Schema for ` + "`rule.filter`" + `
+
+Optional:
+
+- ` + "`prefix`" + ` (String)
+
+<a id="nestedblock--tag"></a>
+### Nested Schema for ` + "`tag`" + `
+
+Optional:
+
+- ` + "`key`" + ` (String)
+
+## Import
+
+Import is supported.
+`
+
+	expectedContent := `---
+page_title: "scaffolding_example Resource - scaffolding"
+subcategory: "Storage"
+description: |-
+  Example resource
+---
+
+# scaffolding_example (Resource)
+
+## Schema
+
+### Optional
+
+- ` + "`rule`" + ` (Block List) (see [below for nested schema](example-rule.md#nestedblock--rule))
+- ` + "`tag`" + ` (Block List) (see [below for nested schema](#nestedblock--tag))
+
+<a id="nestedblock--tag"></a>
+### Nested Schema for ` + "`tag`" + `
+
+Optional:
+
+- ` + "`key`" + ` (String)
+
+## Import
+
+Import is supported.
+`
+
+	expectedPages := []splitPage{
+		{
+			rel: "resources/example-rule.md",
+			content: `---
+# generated by https://github.com/hashicorp/terraform-plugin-docs
+page_title: "scaffolding_example: rule"
+subcategory: "Storage"
+description: "Nested schema for rule of scaffolding_example."
+---
+
+# scaffolding_example: rule
+
+Nested schema for ` + "`rule`" + ` of [scaffolding_example](example.md).
+
+<a id="nestedblock--rule"></a>
+### Nested Schema for ` + "`rule`" + `
+
+Optional:
+
+- ` + "`filter`" + ` (Block List) (see [below for nested schema](#nestedblock--rule--filter))
+- ` + "`enabled`" + ` (Boolean) Whether the rule is enabled, which is a long description to make the section the largest.
+
+<a id="nestedblock--rule--filter"></a>
+### Nested Schema for ` + "`rule.filter`" + `
+
+Optional:
+
+- ` + "`prefix`" + ` (String)
+`,
+		},
+	}
+
+	actualContent, actualPages, fits := splitOversizedPage(content, "resources/example.md", "scaffolding_example", len(content)-200)
+
+	if !fits {
+		t.Error("expected page to fit after splitting")
+	}
+
+	if diff := cmp.Diff(expectedContent, actualContent); diff != "" {
+		t.Errorf("unexpected content difference: %s", diff)
+	}
+
+	if diff := cmp.Diff(expectedPages, actualPages, cmp.AllowUnexported(splitPage{})); diff != "" {
+		t.Errorf("unexpected pages difference: %s", diff)
+	}
+
+	actualContent, actualPages, fits = splitOversizedPage(content, "resources/example.md", "scaffolding_example", len(content))
+	if !fits || actualContent != content || actualPages != nil {
+		t.Error("expected page within the split page size to be unmodified")
+	}
+
+	_, actualPages, fits = splitOversizedPage(content, "resources/example.md", "scaffolding_example", 10)
+	if fits {
+		t.Error("expected page to exceed a split page size smaller than its other content")
+	}
+
+	if len(actualPages) != 2 || !strings.HasSuffix(actualPages[1].rel, "example-tag.md") {
+		t.Errorf("expected all nested schema sections to be split, got: %v", actualPages)
+	}
+}
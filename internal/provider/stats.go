@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// generateStats are the counts of files, and the durations of the phases, of
+// a generation, which are output at the end of the generation with the
+// --stats flag. They are shared by the generators of locales, so the counts
+// and durations include all locales. Nothing is recorded in nil stats, such
+// as of the generator of the serve command.
+type generateStats struct {
+	mu sync.Mutex
+
+	start  time.Time
+	phases []phaseDuration
+	counts [len(fileCountNames)]int
+}
+
+// fileCount is a kind of file counted by the stats, an index of
+// fileCountNames.
+type fileCount int
+
+const (
+	renderedPages fileCount = iota
+	unchangedPages
+	copiedFiles
+	splitPages
+)
+
+// fileCountNames are the names of the kinds of files counted by the stats,
+// as output in the summary.
+var fileCountNames = [...]string{
+	renderedPages:  "pages rendered",
+	unchangedPages: "pages unchanged",
+	copiedFiles:    "static files copied",
+	splitPages:     "split pages written",
+}
+
+// phaseDuration is the total duration of a phase of the generation.
+type phaseDuration struct {
+	name     string
+	duration time.Duration
+}
+
+func newGenerateStats() *generateStats {
+	return &generateStats{
+		start: time.Now(),
+	}
+}
+
+// add increments the count of the kind of files by n.
+func (s *generateStats) add(count fileCount, n int) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.counts[count] += n
+}
+
+// addPhase adds the duration to the phase with the given name, in the order
+// in which phases were first added.
+func (s *generateStats) addPhase(name string, duration time.Duration) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i := range s.phases {
+		if s.phases[i].name == name {
+			s.phases[i].duration += duration
+			return
+		}
+	}
+
+	s.phases = append(s.phases, phaseDuration{name: name, duration: duration})
+}
+
+// String returns the summary of the stats, with a line for each count and
+// phase, and the total duration of the generation.
+func (s *generateStats) String() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var b strings.Builder
+
+	b.WriteString("generation stats:\n")
+	for count, name := range fileCountNames {
+		b.WriteString(fmt.Sprintf("  %s: %d\n", name, s.counts[count]))
+	}
+
+	for _, phase := range s.phases {
+		b.WriteString(fmt.Sprintf("  %s: %s\n", phase.name, roundDuration(phase.duration)))
+	}
+
+	b.WriteString(fmt.Sprintf("  total: %s", roundDuration(time.Since(s.start))))
+
+	return b.String()
+}
+
+// phase starts timing the phase of the generation with the given name, such
+// as schema, and returns a function which stops timing it. With the
+// --progress flag, the duration of the phase is output when it is stopped.
+func (g *generator) phase(name string) func() {
+	start := time.Now()
+
+	return func() {
+		duration := time.Since(start)
+		g.stats.addPhase(name, duration)
+
+		if g.progress {
+			g.infof("%s phase finished in %s", name, roundDuration(duration))
+		}
+	}
+}
+
+// renderProgress outputs the number of files processed by renderFiles, out
+// of total, with the --progress flag, each time another tenth of the files
+// is processed.
+func (g *generator) renderProgress(processed, total int) {
+	if !g.progress || total == 0 {
+		return
+	}
+
+	if processed*10/total == (processed-1)*10/total {
+		return
+	}
+
+	g.infof("processed %d of %d files (%d%%)", processed, total, processed*100/total)
+}
+
+// roundDuration rounds the duration for output, to milliseconds, or to
+// microseconds below a millisecond.
+func roundDuration(d time.Duration) time.Duration {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond)
+	}
+
+	return d.Round(time.Millisecond)
+}
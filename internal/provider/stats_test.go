@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+)
+
+func TestGenerateStats(t *testing.T) {
+	t.Parallel()
+
+	s := newGenerateStats()
+	s.add(renderedPages, 1)
+	s.add(renderedPages, 2)
+	s.add(copiedFiles, 1)
+	s.add(splitPages, 4)
+	s.addPhase("schema", 2*time.Second)
+	s.addPhase("render", 1500*time.Microsecond)
+	s.addPhase("schema", 250*time.Millisecond)
+
+	expected := `generation stats:
+  pages rendered: 3
+  pages unchanged: 0
+  static files copied: 1
+  split pages written: 4
+  schema: 2.25s
+  render: 2ms
+  total: `
+
+	actual := s.String()
+	if !strings.HasPrefix(actual, expected) {
+		t.Errorf("unexpected difference: %s", cmp.Diff(expected, actual))
+	}
+
+	// nothing is recorded in nil stats
+	var nilStats *generateStats
+	nilStats.add(renderedPages, 1)
+	nilStats.addPhase("schema", time.Second)
+}
+
+func TestGenerator_renderProgress(t *testing.T) {
+	t.Parallel()
+
+	ui := cli.NewMockUi()
+	g := &generator{
+		progress: true,
+		ui:       ui,
+	}
+
+	for i := 1; i <= 25; i++ {
+		g.renderProgress(i, 25)
+	}
+
+	expected := `processed 3 of 25 files (12%)
+processed 5 of 25 files (20%)
+processed 8 of 25 files (32%)
+processed 10 of 25 files (40%)
+processed 13 of 25 files (52%)
+processed 15 of 25 files (60%)
+processed 18 of 25 files (72%)
+processed 20 of 25 files (80%)
+processed 23 of 25 files (92%)
+processed 25 of 25 files (100%)
+`
+
+	if diff := cmp.Diff(expected, ui.OutputWriter.String()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v3"
+)
+
+// subcategoryFile is the name of the file in the provider directory which
+// maps patterns of resources, data sources, ephemeral resources, list
+// resources, actions, and functions to the subcategory of their documentation.
+const subcategoryFile = ".tfplugindocs-subcategories.yml"
+
+// subcategoryMapping assigns subcategories to items by the first matching
+// pattern, in the order of the subcategory file.
+type subcategoryMapping struct {
+	rules []subcategoryRule
+}
+
+type subcategoryRule struct {
+	pattern     *itemFilter
+	subcategory string
+}
+
+// Subcategory returns the subcategory of the named item, in the given
+// rendered website subdirectory, or an empty string if no pattern matches.
+func (m *subcategoryMapping) Subcategory(dir, name string) string {
+	if m == nil {
+		return ""
+	}
+
+	for _, rule := range m.rules {
+		if rule.pattern.Match(dir, name) {
+			return rule.subcategory
+		}
+	}
+
+	return ""
+}
+
+// itemSubcategory returns the subcategory of the named item, in the given
+// rendered website subdirectory, which is the subcategory of the item metadata
+// file, if set, then the deprecated subcategory for deprecated items, if set,
+// otherwise the subcategory of the subcategory file.
+func (g *generator) itemSubcategory(dir, name string) string {
+	if subcategory := g.itemMetadata[dir][name].Subcategory; subcategory != "" {
+		return subcategory
+	}
+
+	if g.deprecatedSubcategory != "" && g.deprecatedItems[dir][name] {
+		return g.deprecatedSubcategory
+	}
+
+	return g.subcategories.Subcategory(dir, name)
+}
+
+// loadSubcategoryMapping returns the mapping of the subcategory file in the
+// provider directory, which is a YAML mapping of item patterns, using the
+// syntax of ignore patterns, to subcategories. A nil mapping, which matches
+// nothing, is returned if the file does not exist.
+func loadSubcategoryMapping(providerDir string) (*subcategoryMapping, error) {
+	content, err := os.ReadFile(filepath.Join(providerDir, subcategoryFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read subcategory file %q: %w", subcategoryFile, err)
+	}
+
+	m, err := parseSubcategoryMapping(content)
+	if err != nil {
+		return nil, fmt.Errorf("invalid subcategory file %q: %w", subcategoryFile, err)
+	}
+
+	return m, nil
+}
+
+func parseSubcategoryMapping(content []byte) (*subcategoryMapping, error) {
+	var doc yaml.Node
+
+	err := yaml.Unmarshal(content, &doc)
+	if err != nil {
+		return nil, err
+	}
+
+	// Empty file
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("expected a mapping of patterns to subcategories")
+	}
+
+	m := &subcategoryMapping{}
+
+	// Mapping nodes contain alternating key and value nodes, which are kept
+	// in file order so the first matching pattern wins.
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+
+		if value.Kind != yaml.ScalarNode {
+			return nil, fmt.Errorf("line %d: expected subcategory of pattern %q to be a string", value.Line, key.Value)
+		}
+
+		pattern, err := newItemFilter([]string{key.Value})
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", key.Line, err)
+		}
+
+		if pattern == nil {
+			return nil, fmt.Errorf("line %d: expected a non-empty pattern", key.Line)
+		}
+
+		m.rules = append(m.rules, subcategoryRule{
+			pattern:     pattern,
+			subcategory: value.Value,
+		})
+	}
+
+	return m, nil
+}
@@ -0,0 +1,126 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+func TestSubcategoryMapping(t *testing.T) {
+	t.Parallel()
+
+	m, err := parseSubcategoryMapping([]byte(`
+# Policies are grouped separately from other S3 resources
+resources/aws_s3_bucket_policy: IAM
+aws_s3_*: S3
+data-sources/aws_ami*: "EC2"
+`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	testCases := []struct {
+		dir      string
+		name     string
+		expected string
+	}{
+		{"resources", "aws_s3_bucket_policy", "IAM"},
+		{"data-sources", "aws_s3_bucket_policy", "S3"},
+		{"resources", "aws_s3_bucket", "S3"},
+		{"data-sources", "aws_ami_ids", "EC2"},
+		{"resources", "aws_ami", ""},
+		{"functions", "arn_parse", ""},
+	}
+
+	for _, testCase := range testCases {
+		if actual := m.Subcategory(testCase.dir, testCase.name); actual != testCase.expected {
+			t.Errorf("expected subcategory of %s/%s to be %q, got %q", testCase.dir, testCase.name, testCase.expected, actual)
+		}
+	}
+}
+
+func TestGenerator_itemSubcategory_deprecated(t *testing.T) {
+	t.Parallel()
+
+	subcategories, err := parseSubcategoryMapping([]byte("aws_s3_*: S3\n"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	g := &generator{
+		subcategories:         subcategories,
+		deprecatedSubcategory: "Deprecated",
+		deprecations: deprecations{
+			"data-sources": {"aws_s3_objects": {"": "aws_s3_bucket_objects"}},
+		},
+	}
+
+	g.deprecatedItems = g.findDeprecatedItems(&tfjson.ProviderSchema{
+		ResourceSchemas: map[string]*tfjson.Schema{
+			"aws_s3_bucket":        {Block: &tfjson.SchemaBlock{}},
+			"aws_s3_bucket_object": {Block: &tfjson.SchemaBlock{Deprecated: true}},
+		},
+		DataSourceSchemas: map[string]*tfjson.Schema{
+			"aws_s3_objects": {Block: &tfjson.SchemaBlock{}},
+		},
+		Functions: map[string]*tfjson.FunctionSignature{
+			"arn_parse": {DeprecationMessage: "Use arn_build instead."},
+		},
+	})
+
+	testCases := []struct {
+		dir      string
+		name     string
+		expected string
+	}{
+		{"resources", "aws_s3_bucket", "S3"},
+		{"resources", "aws_s3_bucket_object", "Deprecated"},
+		{"data-sources", "aws_s3_objects", "Deprecated"},
+		{"functions", "arn_parse", "Deprecated"},
+	}
+
+	for _, testCase := range testCases {
+		if actual := g.itemSubcategory(testCase.dir, testCase.name); actual != testCase.expected {
+			t.Errorf("expected subcategory of %s/%s to be %q, got %q", testCase.dir, testCase.name, testCase.expected, actual)
+		}
+	}
+}
+
+func TestParseSubcategoryMapping_errors(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content       string
+		expectedError string
+	}{
+		"not a mapping": {
+			content:       "- aws_s3_*\n",
+			expectedError: "expected a mapping of patterns to subcategories",
+		},
+		"invalid subcategory": {
+			content:       "aws_s3_*:\n  - S3\n",
+			expectedError: `line 2: expected subcategory of pattern "aws_s3_*" to be a string`,
+		},
+		"invalid pattern": {
+			content:       "modules/aws_s3_*: S3\n",
+			expectedError: `line 1: invalid pattern "modules/aws_s3_*", expected prefix to be one of: actions, data-sources, ephemeral-resources, functions, list-resources, resources`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := parseSubcategoryMapping([]byte(testCase.content))
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Errorf("expected error: %s, got error: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
@@ -7,20 +7,23 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"os"
 	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
+	"time"
 
+	"github.com/Masterminds/sprig/v3"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 
 	tfjson "github.com/hashicorp/terraform-json"
 
-	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
-
-	"github.com/hashicorp/terraform-plugin-docs/internal/functionmd"
+	"github.com/hashicorp/terraform-plugin-docs/functionmd"
 	"github.com/hashicorp/terraform-plugin-docs/internal/mdplain"
 	"github.com/hashicorp/terraform-plugin-docs/internal/tmplfuncs"
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
 )
 
 const (
@@ -37,24 +40,206 @@ type (
 	functionTemplate string
 	providerTemplate string
 
+	deprecationsTemplate string
+
 	docTemplate string
 )
 
-func newTemplate(providerDir, name, text string) (*template.Template, error) {
+// Names of the headings of the default templates, which are configured in
+// addition to the schemamd headings.
+const (
+	headingExampleUsage        = "example-usage"
+	headingImport              = "import"
+	headingSensitiveAttributes = "sensitive-attributes"
+	headingRelatedResources    = "related-resources"
+)
+
+// defaultTemplateHeadings contains the default text of each heading of the
+// default templates, by name.
+var defaultTemplateHeadings = map[string]string{
+	headingExampleUsage:        "Example Usage",
+	headingImport:              "Import",
+	headingSensitiveAttributes: "Sensitive Attributes",
+	headingRelatedResources:    "Related Resources",
+}
+
+// templateOptions configures how templates are parsed and rendered.
+type templateOptions struct {
+	// providerDir is the directory which relative file paths passed to the
+	// codefile and tffile functions are resolved against.
+	providerDir string
+
+	// partials contains shared templates, keyed by their slash separated path
+	// relative to the templates directory (e.g. "partials/note.tmpl"), which
+	// are parsed into the same template set so that any template can include
+	// them with the template action.
+	partials map[string]string
+
+	// schemaOptions configures how schemas are rendered as Markdown, both for
+	// the SchemaMarkdown field and the schemamarkdown function.
+	schemaOptions *schemamd.RenderOptions
+
+	// codeFileOptions configures which content of files is included by the
+	// codefile and tffile functions.
+	codeFileOptions *tmplfuncs.CodeFileOptions
+
+	// plainMarkdownOptions configures how Markdown is rendered as plain text
+	// by the plainmarkdown function.
+	plainMarkdownOptions *mdplain.Options
+
+	// readFiles records the files read by the codefile and tffile functions,
+	// if set, so they can be included in the render cache.
+	readFiles *fileRecorder
+
+	// exampleSyntax is the severity of the syntax errors of the Terraform
+	// files included by the codefile and tffile functions, one of
+	// RuleSeverities. Empty is equivalent to RuleSeverityOff.
+	exampleSyntax string
+
+	// generateExamples synthesizes a skeleton example from the schema of
+	// items without an example, for the GeneratedExample field.
+	generateExamples bool
+
+	// logger receives the warnings of the rendered page, such as syntax
+	// errors of examples with the RuleSeverityWarn severity, if set.
+	logger *bufferedLogger
+
+	// subcategory is the subcategory of the rendered item, as assigned by the
+	// subcategory file, for the Subcategory field.
+	subcategory string
+
+	// itemIndexMarkdown is the Markdown overview of all documented items, for
+	// the ItemIndexMarkdown field of the provider template.
+	itemIndexMarkdown string
+
+	// providerVersion is the version of the documented provider, for the
+	// ProviderVersion field.
+	providerVersion string
+
+	// providerSource is the source address of the documented provider, for
+	// the RequiredProvidersBlock field of the provider template.
+	providerSource string
+
+	// headings overrides the text of the headings of the default templates
+	// and schemas, by name, for the heading function.
+	headings map[string]string
+
+	// sensitiveAttributesSection enables the section of the default resource
+	// templates which lists the sensitive attributes, for the
+	// SensitiveAttributesSection field.
+	sensitiveAttributesSection bool
+
+	// deprecationReplacement is the replacement of the rendered item, as
+	// assigned by the deprecations file, for the DeprecationReplacement
+	// field.
+	deprecationReplacement string
+
+	// relatedResources are the related resources of the rendered item, as
+	// assigned by the item metadata file, for the RelatedResources field.
+	relatedResources []relatedResource
+
+	// pageTitle and frontmatter are the page title and additional
+	// frontmatter entries of the rendered item, as assigned by the item
+	// metadata file, for the PageTitle and Frontmatter fields.
+	pageTitle   string
+	frontmatter map[string]string
+
+	// schema is the schema of the rendered provider or item, which the
+	// schemaattribute function renders attributes of.
+	schema *tfjson.Schema
+
+	// templateEnv are the names of the environment variables which the env
+	// function can read.
+	templateEnv []string
+
+	// buildInfo is the build provenance returned by the buildinfo function.
+	buildInfo buildInfo
+
+	// templateFuncs are additional functions, which take precedence over
+	// Sprig functions, but not over the built-in functions.
+	templateFuncs template.FuncMap
+}
+
+// buildInfo is the build provenance of rendered pages, which is returned by
+// the buildinfo template function.
+type buildInfo struct {
+	// Version is the version of tfplugindocs, e.g. 0.20.0.
+	Version string
+
+	// Commit is the git commit which tfplugindocs was built from, or empty if
+	// it is not known.
+	Commit string
+
+	// Timestamp is the time of the generation in UTC, which is the same for
+	// every page of a run, unless it is fixed for reproducible output.
+	Timestamp time.Time
+}
+
+// fileRecorder records the paths of files read while rendering a template.
+type fileRecorder struct {
+	files []string
+}
+
+func (r *fileRecorder) record(file string) {
+	if r == nil {
+		return
+	}
+
+	r.files = append(r.files, file)
+}
+
+func newTemplate(opts templateOptions, name, text string) (*template.Template, error) {
 	tmpl := template.New(name)
 	titleCaser := cases.Title(language.Und)
 
-	tmpl.Funcs(map[string]interface{}{
-		"codefile":      codeFile(providerDir),
-		"lower":         strings.ToLower,
-		"plainmarkdown": mdplain.PlainMarkdown,
-		"prefixlines":   tmplfuncs.PrefixLines,
-		"split":         strings.Split,
-		"tffile":        terraformCodeFile(providerDir),
-		"title":         titleCaser.String,
-		"trimspace":     strings.TrimSpace,
-		"upper":         strings.ToUpper,
-	})
+	// Sprig functions which are not repeatable, such as the date, random,
+	// and environment variable functions, are excluded so rendered output
+	// only depends on the templates, examples, and schema. The built-in
+	// functions take precedence over Sprig functions with the same name,
+	// such as env, which only reads allowed environment variables.
+	funcs := sprig.HermeticTxtFuncMap()
+	for name, fn := range opts.templateFuncs {
+		funcs[name] = fn
+	}
+	for name, fn := range map[string]interface{}{
+		"attributeanchor": attributeAnchor,
+		"attributelink":   attributeLink,
+		"buildinfo":       func() buildInfo { return opts.buildInfo },
+		"codefile":        codeFile(opts),
+		"env":             templateEnv(opts.templateEnv),
+		"firstline":       tmplfuncs.FirstLine,
+		"firstparagraph":  tmplfuncs.FirstParagraph,
+		"heading":         templateHeading(opts.headings),
+		"lower":           strings.ToLower,
+		"plainmarkdown":   plainMarkdown(opts.plainMarkdownOptions),
+		"prefixlines":     tmplfuncs.PrefixLines,
+		"renderschema":    renderSchema(opts.schema, opts.schemaOptions),
+		"schemaattribute": schemaAttribute(opts.schema, opts.schemaOptions),
+		"schemamarkdown":  schemaMarkdown(opts.schemaOptions),
+		"split":           strings.Split,
+		"tffile":          terraformCodeFile(opts),
+		"title":           titleCaser.String,
+		"trimspace":       strings.TrimSpace,
+		"truncate":        tmplfuncs.Truncate,
+		"upper":           strings.ToUpper,
+	} {
+		funcs[name] = fn
+	}
+
+	tmpl.Funcs(funcs)
+
+	partialNames := make([]string, 0, len(opts.partials))
+	for partialName := range opts.partials {
+		partialNames = append(partialNames, partialName)
+	}
+	sort.Strings(partialNames)
+
+	for _, partialName := range partialNames {
+		_, err := tmpl.New(partialName).Parse(opts.partials[partialName])
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse partial template %q: %w", partialName, err)
+		}
+	}
 
 	var err error
 	tmpl, err = tmpl.Parse(text)
@@ -65,29 +250,436 @@ func newTemplate(providerDir, name, text string) (*template.Template, error) {
 	return tmpl, nil
 }
 
-func codeFile(providerDir string) func(string, string) (string, error) {
-	return func(format string, file string) (string, error) {
-		if filepath.IsAbs(file) {
-			return tmplfuncs.CodeFile(format, file)
+// templateEnv returns a template function which returns the value of an
+// environment variable, e.g. {{ env "GITHUB_SHA" }}, or an error if the
+// variable is not one of the allowed variables, so templates cannot read
+// secrets from the environment.
+func templateEnv(allowed []string) func(string) (string, error) {
+	return func(name string) (string, error) {
+		for _, allowedName := range allowed {
+			if name == allowedName {
+				return os.Getenv(name), nil
+			}
 		}
 
-		return tmplfuncs.CodeFile(format, filepath.Join(providerDir, file))
+		return "", fmt.Errorf("environment variable %q is not allowed in templates", name)
+	}
+}
+
+// templateHeading returns a template function which returns the configured
+// text of the named heading of the default templates or schemas, e.g.
+// {{ heading "example-usage" }}.
+func templateHeading(headings map[string]string) func(string) (string, error) {
+	return func(name string) (string, error) {
+		if text := headings[name]; text != "" {
+			return text, nil
+		}
+
+		if text, ok := defaultTemplateHeadings[name]; ok {
+			return text, nil
+		}
+
+		if text, ok := schemamd.DefaultHeadings[name]; ok {
+			return text, nil
+		}
+
+		return "", fmt.Errorf("unknown heading %q", name)
+	}
+}
+
+// attributeAnchor is a template function which returns the ID of the anchor
+// of the attribute or block at the dot separated path, which rendered schemas
+// contain with attribute anchors enabled, e.g.
+// {{ attributeanchor "versioning.enabled" }} returns "attr-versioning-enabled".
+func attributeAnchor(path string) string {
+	return schemamd.AttributeAnchorID(strings.Split(path, "."))
+}
+
+// attributeLink is a template function which returns a Markdown link to the
+// anchor of the attribute or block at the dot separated path on the same
+// page, e.g. {{ attributelink "versioning.enabled" }} returns
+// "[`versioning.enabled`](#attr-versioning-enabled)".
+func attributeLink(path string) string {
+	return "[`" + path + "`](#" + attributeAnchor(path) + ")"
+}
+
+// schemaAttribute returns a template function which renders the single
+// attribute, or block, at a dot separated path of the schema of the rendered
+// provider or item, with its type, markers, and description, e.g.
+// {{ schemaattribute "versioning.enabled" }}.
+func schemaAttribute(schema *tfjson.Schema, opts *schemamd.RenderOptions) func(string) (string, error) {
+	return func(path string) (string, error) {
+		if schema == nil {
+			return "", fmt.Errorf("unable to render attribute %q: the template has no schema", path)
+		}
+
+		b := &strings.Builder{}
+
+		err := schemamd.RenderAttribute(schema, path, b, opts)
+		if err != nil {
+			return "", err
+		}
+
+		return b.String(), nil
+	}
+}
+
+// renderSchema returns a template function which renders the nested schema
+// section of the nested block, or nested attribute, at a dot separated path
+// of the schema of the rendered provider or item, with the sections of its
+// own nested types. An optional dictionary of render options overrides the
+// configured options, the same as for schemamarkdown, e.g.
+// {{ renderschema "versioning" (dict "Style" "table") }}.
+func renderSchema(schema *tfjson.Schema, defaults *schemamd.RenderOptions) func(string, ...map[string]interface{}) (string, error) {
+	return func(path string, overrides ...map[string]interface{}) (string, error) {
+		if schema == nil {
+			return "", fmt.Errorf("unable to render nested schema %q: the template has no schema", path)
+		}
+
+		opts, err := schemaRenderOptions(defaults, overrides)
+		if err != nil {
+			return "", err
+		}
+
+		b := &strings.Builder{}
+
+		err = schemamd.RenderNestedSchema(schema, path, b, opts)
+		if err != nil {
+			return "", err
+		}
+
+		return b.String(), nil
+	}
+}
+
+// schemaMarkdown returns a template function which renders a schema as
+// Markdown, the same as the SchemaMarkdown field. An optional dictionary of
+// render options overrides the configured options for the template, e.g.
+// {{ schemamarkdown .Schema (dict "Style" "legacy" "InlineNestedDepth" 2) }}.
+func schemaMarkdown(defaults *schemamd.RenderOptions) func(*tfjson.Schema, ...map[string]interface{}) (string, error) {
+	return func(schema *tfjson.Schema, overrides ...map[string]interface{}) (string, error) {
+		if schema == nil {
+			return "", fmt.Errorf("expected a schema, got nil")
+		}
+
+		opts, err := schemaRenderOptions(defaults, overrides)
+		if err != nil {
+			return "", err
+		}
+
+		schemaBuffer := bytes.NewBuffer(nil)
+		err = schemamd.Render(schema, schemaBuffer, opts)
+		if err != nil {
+			return "", fmt.Errorf("unable to render schema: %w", err)
+		}
+
+		return schemaComment + "\n" + schemaBuffer.String(), nil
+	}
+}
+
+// schemaRenderOptions returns the schema render options with the overrides
+// of the optional dictionaries of a template function applied.
+func schemaRenderOptions(defaults *schemamd.RenderOptions, overrides []map[string]interface{}) (*schemamd.RenderOptions, error) {
+	opts := schemamd.RenderOptions{}
+	if defaults != nil {
+		opts = *defaults
+	}
+
+	for _, o := range overrides {
+		for _, key := range sortedKeys(o) {
+			value := o[key]
+			switch key {
+			case "Style":
+				style, ok := value.(string)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a string, got %T", key, value)
+				}
+				opts.Style = style
+			case "InlineNestedDepth":
+				depth, ok := value.(int)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be an integer, got %T", key, value)
+				}
+				opts.InlineNestedDepth = depth
+			case "MaxNestedDepth":
+				depth, ok := value.(int)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be an integer, got %T", key, value)
+				}
+				opts.MaxNestedDepth = depth
+			case "InlineObjectMaxAttributes":
+				maxAttributes, ok := value.(int)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be an integer, got %T", key, value)
+				}
+				opts.InlineObjectMaxAttributes = maxAttributes
+			case "AttributeAnchors":
+				anchors, ok := value.(bool)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a boolean, got %T", key, value)
+				}
+				opts.AttributeAnchors = anchors
+			case "CollapsibleNestedSchemas":
+				collapsible, ok := value.(bool)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a boolean, got %T", key, value)
+				}
+				opts.CollapsibleNestedSchemas = collapsible
+			case "WriteOnlySection":
+				section, ok := value.(bool)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a boolean, got %T", key, value)
+				}
+				opts.WriteOnlySection = section
+			case "TimeoutsSection":
+				section, ok := value.(bool)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a boolean, got %T", key, value)
+				}
+				opts.TimeoutsSection = section
+			case "AttributeSort":
+				sort, ok := value.(string)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a string, got %T", key, value)
+				}
+				opts.AttributeSort = sort
+			case "TypeSyntax":
+				syntax, ok := value.(string)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a string, got %T", key, value)
+				}
+				opts.TypeSyntax = syntax
+			case "AttributeOrder":
+				order, err := stringList(value)
+				if err != nil {
+					return nil, fmt.Errorf("expected %s to be a list of strings: %w", key, err)
+				}
+				opts.AttributeOrder = order
+			default:
+				return nil, fmt.Errorf("unsupported schema render option %q", key)
+			}
+		}
+	}
+
+	return &opts, nil
+}
+
+// stringList returns the strings of a template value which is a list of
+// strings, such as the result of the list function.
+func stringList(value interface{}) ([]string, error) {
+	switch value := value.(type) {
+	case []string:
+		return value, nil
+	case []interface{}:
+		list := make([]string, 0, len(value))
+		for _, v := range value {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("got %T element", v)
+			}
+			list = append(list, s)
+		}
+		return list, nil
+	default:
+		return nil, fmt.Errorf("got %T", value)
+	}
+}
+
+// codeFile returns a template function which renders the content of a file
+// as a Markdown code block. An optional dictionary of options overrides the
+// configured options for the file, e.g.
+// {{ codefile "shell" .ImportFile (dict "StripHeaders" true) }}, or selects
+// part of the file, e.g. {{ tffile .ExampleFile (dict "Snippet" "basic") }}.
+func codeFile(tmplOpts templateOptions) func(string, string, ...map[string]interface{}) (string, error) {
+	return func(format string, file string, overrides ...map[string]interface{}) (string, error) {
+		opts, err := codeFileOptions(tmplOpts.codeFileOptions, overrides)
+		if err != nil {
+			return "", err
+		}
+
+		if !filepath.IsAbs(file) {
+			file = filepath.Join(tmplOpts.providerDir, file)
+		}
+
+		tmplOpts.readFiles.record(file)
+
+		if tmplfuncs.IsTerraformFormat(format) {
+			err = tmplOpts.checkExampleSyntax(file)
+			if err != nil {
+				return "", err
+			}
+		}
+
+		return tmplfuncs.CodeFile(format, file, opts)
+	}
+}
+
+// checkExampleSyntax checks the syntax of a Terraform file included by the
+// codefile and tffile functions. Files which cannot be read are reported when
+// they are included instead.
+func (opts templateOptions) checkExampleSyntax(file string) error {
+	if opts.exampleSyntax == "" || opts.exampleSyntax == RuleSeverityOff {
+		return nil
+	}
+
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return nil
+	}
+
+	if opts.codeFileOptions != nil {
+		content = []byte(tmplfuncs.ReplacePlaceholders(string(content), opts.codeFileOptions.Values))
+	}
+
+	name := file
+	if rel, err := filepath.Rel(opts.providerDir, file); err == nil && !strings.HasPrefix(rel, "..") {
+		name = filepath.ToSlash(rel)
+	}
+
+	warnf := func(string, ...interface{}) {}
+	if opts.logger != nil {
+		warnf = opts.logger.warnf
+	}
+
+	return checkExampleSyntax(opts.exampleSyntax, warnf, name, content)
+}
+
+// checkExampleSyntax returns the syntax errors of the content of the
+// Terraform file with the given name, with the RuleSeverityError severity, or
+// outputs them as warnings, with the RuleSeverityWarn severity.
+func checkExampleSyntax(severity string, warnf func(string, ...interface{}), name string, content []byte) error {
+	if severity == "" || severity == RuleSeverityOff {
+		return nil
+	}
+
+	err := tmplfuncs.CheckTerraformSyntax(name, content)
+	if err == nil {
+		return nil
+	}
+
+	if severity == RuleSeverityWarn {
+		for _, e := range flattenErrors(err) {
+			warnf("example syntax error: %s", e)
+		}
+
+		return nil
+	}
+
+	return fmt.Errorf("example syntax error: %w", err)
+}
+
+func terraformCodeFile(tmplOpts templateOptions) func(string, ...map[string]interface{}) (string, error) {
+	codeFile := codeFile(tmplOpts)
+
+	return func(file string, overrides ...map[string]interface{}) (string, error) {
+		return codeFile("terraform", file, overrides...)
+	}
+}
+
+// codeFileOptions returns the configured code file options with the
+// template function option overrides applied.
+func codeFileOptions(defaults *tmplfuncs.CodeFileOptions, overrides []map[string]interface{}) (*tmplfuncs.CodeFileOptions, error) {
+	opts := tmplfuncs.CodeFileOptions{}
+	if defaults != nil {
+		opts = *defaults
+	}
+
+	for _, o := range overrides {
+		for _, key := range sortedKeys(o) {
+			value := o[key]
+			switch key {
+			case "StripHeaders":
+				strip, ok := value.(bool)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a boolean, got %T", key, value)
+				}
+				opts.StripHeaders = strip
+			case "Lines":
+				lines, ok := value.(string)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a string, got %T", key, value)
+				}
+				opts.Lines = lines
+			case "Snippet":
+				snippet, ok := value.(string)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a string, got %T", key, value)
+				}
+				opts.Snippet = snippet
+			case "FormatTerraform":
+				format, ok := value.(bool)
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a boolean, got %T", key, value)
+				}
+				opts.FormatTerraform = format
+			case "Values":
+				values, ok := value.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("expected %s to be a dictionary, got %T", key, value)
+				}
+
+				merged := make(map[string]string, len(opts.Values)+len(values))
+				for name, v := range opts.Values {
+					merged[name] = v
+				}
+				for name, v := range values {
+					merged[name] = fmt.Sprint(v)
+				}
+				opts.Values = merged
+			default:
+				return nil, fmt.Errorf("unsupported code file option %q", key)
+			}
+		}
 	}
+
+	return &opts, nil
 }
 
-func terraformCodeFile(providerDir string) func(string) (string, error) {
-	// TODO: omit comment handling
-	return func(file string) (string, error) {
-		if filepath.IsAbs(file) {
-			return tmplfuncs.CodeFile("terraform", file)
+// plainMarkdown returns a template function which renders Markdown as plain
+// text, with the configured options. An optional dictionary of options
+// overrides them for the template, e.g.
+// {{ plainmarkdown .Description (dict "FirstSentence" true "MaxLength" 160) }}.
+func plainMarkdown(defaults *mdplain.Options) func(string, ...map[string]interface{}) (string, error) {
+	return func(markdown string, overrides ...map[string]interface{}) (string, error) {
+		opts := mdplain.Options{}
+		if defaults != nil {
+			opts = *defaults
+		}
+
+		for _, o := range overrides {
+			for _, key := range sortedKeys(o) {
+				value := o[key]
+				switch key {
+				case "MaxLength":
+					maxLength, ok := value.(int)
+					if !ok {
+						return "", fmt.Errorf("expected %s to be an integer, got %T", key, value)
+					}
+					opts.MaxLength = maxLength
+				case "FirstSentence":
+					firstSentence, ok := value.(bool)
+					if !ok {
+						return "", fmt.Errorf("expected %s to be a boolean, got %T", key, value)
+					}
+					opts.FirstSentence = firstSentence
+				case "OmitLinkURLs":
+					omit, ok := value.(bool)
+					if !ok {
+						return "", fmt.Errorf("expected %s to be a boolean, got %T", key, value)
+					}
+					opts.OmitLinkURLs = omit
+				default:
+					return "", fmt.Errorf("unsupported plain markdown option %q", key)
+				}
+			}
 		}
 
-		return tmplfuncs.CodeFile("terraform", filepath.Join(providerDir, file))
+		return mdplain.PlainMarkdownWithOptions(markdown, opts)
 	}
 }
 
-func renderTemplate(providerDir, name string, text string, out io.Writer, data interface{}) error {
-	tmpl, err := newTemplate(providerDir, name, text)
+func renderTemplate(opts templateOptions, name string, text string, out io.Writer, data interface{}) error {
+	tmpl, err := newTemplate(opts, name, text)
 	if err != nil {
 		return err
 	}
@@ -100,10 +692,10 @@ func renderTemplate(providerDir, name string, text string, out io.Writer, data i
 	return nil
 }
 
-func renderStringTemplate(providerDir, name, text string, data interface{}) (string, error) {
+func renderStringTemplate(opts templateOptions, name, text string, data interface{}) (string, error) {
 	var buf bytes.Buffer
 
-	err := renderTemplate(providerDir, name, text, &buf, data)
+	err := renderTemplate(opts, name, text, &buf, data)
 	if err != nil {
 		return "", err
 	}
@@ -111,18 +703,27 @@ func renderStringTemplate(providerDir, name, text string, data interface{}) (str
 	return buf.String(), nil
 }
 
-func (t docTemplate) Render(providerDir string, out io.Writer) error {
+func (t docTemplate) Render(opts templateOptions, providerName, renderedProviderName string, out io.Writer) error {
 	s := string(t)
 	if s == "" {
 		return nil
 	}
 
-	return renderTemplate(providerDir, "docTemplate", s, out, nil)
+	// The data is a map, rather than a struct, as templates of items which do
+	// not exist in the schema are also rendered as doc templates, and other
+	// fields of those templates must render without a value, not fail.
+	return renderTemplate(opts, "docTemplate", s, out, map[string]string{
+		"ProviderName":      providerName,
+		"ProviderShortName": providerShortName(providerName),
+		"ProviderVersion":   opts.providerVersion,
+
+		"RenderedProviderName": renderedProviderName,
+	})
 }
 
-func (t providerTemplate) Render(providerDir, providerName, renderedProviderName, exampleFile string, schema *tfjson.Schema) (string, error) {
+func (t providerTemplate) Render(opts templateOptions, providerName, renderedProviderName, exampleFile string, schema *tfjson.Schema) (string, error) {
 	schemaBuffer := bytes.NewBuffer(nil)
-	err := schemamd.Render(schema, schemaBuffer)
+	err := schemamd.Render(schema, schemaBuffer, opts.schemaOptions)
 	if err != nil {
 		return "", fmt.Errorf("unable to render schema: %w", err)
 	}
@@ -132,7 +733,9 @@ func (t providerTemplate) Render(providerDir, providerName, renderedProviderName
 		return "", nil
 	}
 
-	return renderStringTemplate(providerDir, "providerTemplate", s, struct {
+	opts.schema = schema
+
+	return renderStringTemplate(opts, "providerTemplate", s, struct {
 		Description string
 
 		HasExample  bool
@@ -140,7 +743,13 @@ func (t providerTemplate) Render(providerDir, providerName, renderedProviderName
 
 		ProviderName      string
 		ProviderShortName string
+		ProviderVersion   string
 		SchemaMarkdown    string
+		Schema            *tfjson.Schema
+
+		ItemIndexMarkdown string
+
+		RequiredProvidersBlock string
 
 		RenderedProviderName string
 	}{
@@ -151,74 +760,179 @@ func (t providerTemplate) Render(providerDir, providerName, renderedProviderName
 
 		ProviderName:      providerName,
 		ProviderShortName: providerShortName(providerName),
+		ProviderVersion:   opts.providerVersion,
 
 		SchemaMarkdown: schemaComment + "\n" + schemaBuffer.String(),
+		Schema:         schema,
+
+		ItemIndexMarkdown: opts.itemIndexMarkdown,
+
+		RequiredProvidersBlock: requiredProvidersBlock(opts.providerSource, opts.providerVersion),
+
+		RenderedProviderName: renderedProviderName,
+	})
+}
+
+func (t deprecationsTemplate) Render(opts templateOptions, providerName, renderedProviderName, deprecationsMarkdown string) (string, error) {
+	s := string(t)
+	if s == "" {
+		return "", nil
+	}
+
+	return renderStringTemplate(opts, "deprecationsTemplate", s, struct {
+		ProviderName      string
+		ProviderShortName string
+		ProviderVersion   string
+
+		HasDeprecations      bool
+		DeprecationsMarkdown string
+
+		RenderedProviderName string
+	}{
+		ProviderName:      providerName,
+		ProviderShortName: providerShortName(providerName),
+		ProviderVersion:   opts.providerVersion,
+
+		HasDeprecations:      deprecationsMarkdown != "",
+		DeprecationsMarkdown: deprecationsMarkdown,
 
 		RenderedProviderName: renderedProviderName,
 	})
 }
 
-func (t resourceTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile, importFile string, schema *tfjson.Schema) (string, error) {
+func (t resourceTemplate) Render(opts templateOptions, name, providerName, renderedProviderName, typeName, exampleFile, importFile, importBlockFile string, schema *tfjson.Schema, identitySchema *tfjson.IdentitySchema) (string, error) {
 	schemaBuffer := bytes.NewBuffer(nil)
-	err := schemamd.Render(schema, schemaBuffer)
+	err := schemamd.Render(schema, schemaBuffer, opts.schemaOptions)
 	if err != nil {
 		return "", fmt.Errorf("unable to render schema: %w", err)
 	}
 
+	identitySchemaBuffer := bytes.NewBuffer(nil)
+	if identitySchema != nil {
+		err = schemamd.RenderIdentitySchema(identitySchema, identitySchemaBuffer)
+		if err != nil {
+			return "", fmt.Errorf("unable to render identity schema: %w", err)
+		}
+	}
+
+	examples, err := additionalExamples(exampleFile)
+	if err != nil {
+		return "", err
+	}
+
 	s := string(t)
 	if s == "" {
 		return "", nil
 	}
 
-	return renderStringTemplate(providerDir, "resourceTemplate", s, struct {
+	hasExample := exampleFile != "" && fileExists(exampleFile)
+
+	var generatedExample string
+	if opts.generateExamples && !hasExample && len(examples) == 0 {
+		generatedExample = exampleSkeleton(exampleBlockTypes[typeName], name, providerName, schema)
+	}
+
+	opts.schema = schema
+
+	return renderStringTemplate(opts, "resourceTemplate", s, struct {
 		Type        string
 		Name        string
 		Description string
+		Subcategory string
 
-		HasExample  bool
-		ExampleFile string
+		HasExample       bool
+		ExampleFile      string
+		Examples         []resourceExample
+		GeneratedExample string
 
 		HasImport  bool
 		ImportFile string
 
+		HasImportBlock  bool
+		ImportBlockFile string
+
 		ProviderName      string
 		ProviderShortName string
+		ProviderVersion   string
 
 		SchemaMarkdown string
+		Schema         *tfjson.Schema
+
+		SensitiveAttributes        []string
+		SensitiveAttributesSection bool
+
+		IsDeprecated           bool
+		DeprecationReplacement string
+		DeprecatedAttributes   []string
+
+		RelatedResources []relatedResource
+
+		PageTitle   string
+		Frontmatter map[string]string
+
+		HasIdentity            bool
+		IdentitySchemaMarkdown string
+		IdentitySchema         *tfjson.IdentitySchema
+		IdentityImportBlock    string
 
 		RenderedProviderName string
 	}{
 		Type:        typeName,
 		Name:        name,
 		Description: schema.Block.Description,
+		Subcategory: opts.subcategory,
 
-		HasExample:  exampleFile != "" && fileExists(exampleFile),
-		ExampleFile: exampleFile,
+		HasExample:       hasExample,
+		ExampleFile:      exampleFile,
+		Examples:         examples,
+		GeneratedExample: generatedExample,
 
 		HasImport:  importFile != "" && fileExists(importFile),
 		ImportFile: importFile,
 
+		HasImportBlock:  importBlockFile != "" && fileExists(importBlockFile),
+		ImportBlockFile: importBlockFile,
+
 		ProviderName:      providerName,
 		ProviderShortName: providerShortName(providerName),
+		ProviderVersion:   opts.providerVersion,
 
 		SchemaMarkdown: schemaComment + "\n" + schemaBuffer.String(),
+		Schema:         schema,
+
+		SensitiveAttributes:        schemamd.SensitiveAttributes(schema),
+		SensitiveAttributesSection: opts.sensitiveAttributesSection,
+
+		IsDeprecated:           schema.Block.Deprecated,
+		DeprecationReplacement: opts.deprecationReplacement,
+		DeprecatedAttributes:   schemamd.DeprecatedAttributes(schema),
+
+		RelatedResources: opts.relatedResources,
+
+		PageTitle:   opts.pageTitle,
+		Frontmatter: opts.frontmatter,
+
+		HasIdentity:            identitySchema != nil,
+		IdentitySchemaMarkdown: schemaComment + "\n" + identitySchemaBuffer.String(),
+		IdentitySchema:         identitySchema,
+		IdentityImportBlock:    identityImportBlock(name, identitySchema),
 
 		RenderedProviderName: renderedProviderName,
 	})
 }
 
-func (t functionTemplate) Render(providerDir, name, providerName, renderedProviderName, typeName, exampleFile string, signature *tfjson.FunctionSignature) (string, error) {
-	funcSig, err := functionmd.RenderSignature(name, signature)
+func (t functionTemplate) Render(opts templateOptions, name, providerName, renderedProviderName, typeName, exampleFile string, signature *tfjson.FunctionSignature) (string, error) {
+	funcSig, err := functionmd.RenderSignature(name, signature, nil)
 	if err != nil {
 		return "", fmt.Errorf("unable to render function signature: %w", err)
 	}
 
-	funcArgs, err := functionmd.RenderArguments(signature)
+	funcArgs, err := functionmd.RenderArguments(signature, nil)
 	if err != nil {
 		return "", fmt.Errorf("unable to render function arguments: %w", err)
 	}
 
-	funcVarArg, err := functionmd.RenderVariadicArg(signature)
+	funcVarArg, err := functionmd.RenderVariadicArg(signature, nil)
 	if err != nil {
 		return "", fmt.Errorf("unable to render variadic argument: %w", err)
 	}
@@ -228,17 +942,19 @@ func (t functionTemplate) Render(providerDir, name, providerName, renderedProvid
 		return "", nil
 	}
 
-	return renderStringTemplate(providerDir, "resourceTemplate", s, struct {
+	return renderStringTemplate(opts, "resourceTemplate", s, struct {
 		Type        string
 		Name        string
 		Description string
 		Summary     string
+		Subcategory string
 
 		HasExample  bool
 		ExampleFile string
 
 		ProviderName      string
 		ProviderShortName string
+		ProviderVersion   string
 
 		FunctionSignatureMarkdown string
 		FunctionArgumentsMarkdown string
@@ -252,12 +968,14 @@ func (t functionTemplate) Render(providerDir, name, providerName, renderedProvid
 		Name:        name,
 		Description: signature.Description,
 		Summary:     signature.Summary,
+		Subcategory: opts.subcategory,
 
 		HasExample:  exampleFile != "" && fileExists(exampleFile),
 		ExampleFile: exampleFile,
 
 		ProviderName:      providerName,
 		ProviderShortName: providerShortName(providerName),
+		ProviderVersion:   opts.providerVersion,
 
 		FunctionSignatureMarkdown: signatureComment + "\n" + funcSig,
 		FunctionArgumentsMarkdown: argumentComment + "\n" + funcArgs,
@@ -269,39 +987,154 @@ func (t functionTemplate) Render(providerDir, name, providerName, renderedProvid
 	})
 }
 
+// relatedResourcesSection is the related resources section of the default
+// resource templates, which links to the related resources assigned by the
+// item metadata file.
+const relatedResourcesSection = `
+{{- if .RelatedResources }}
+
+## {{ heading "related-resources" }}
+{{ range .RelatedResources }}
+- {{ if .Link }}[` + "`{{ .Name }}`" + `]({{ .Link }}){{ else }}` + "`{{ .Name }}`" + `{{ end }}
+{{- end }}
+{{- end }}`
+
 const defaultResourceTemplate resourceTemplate = `---
 ` + frontmatterComment + `
-page_title: "{{.Name}} {{.Type}} - {{.ProviderName}}"
-subcategory: ""
+page_title: {{ if .PageTitle }}{{ printf "%q" .PageTitle }}{{ else }}"{{.Name}} {{.Type}} - {{.ProviderName}}"{{ end }}
+subcategory: "{{.Subcategory}}"
 description: |-
 {{ .Description | plainmarkdown | trimspace | prefixlines "  " }}
+{{- range $key, $value := .Frontmatter }}
+{{ $key }}: {{ printf "%q" $value }}
+{{- end }}
 ---
 
 # {{.Name}} ({{.Type}})
+{{- if .IsDeprecated }}
+
+~> **Deprecated:** This {{ lower .Type }} is deprecated and may be removed in a future version of the provider.
+{{- with .DeprecationReplacement }} Use ` + "`{{ . }}`" + ` instead.{{ end }}
+{{- end }}
 
 {{ .Description | trimspace }}
 
-{{ if .HasExample -}}
-## Example Usage
+{{ if or .HasExample .Examples .GeneratedExample -}}
+## {{ heading "example-usage" }}
+{{- if .HasExample }}
 
 {{tffile .ExampleFile }}
+{{- else if .GeneratedExample }}
+
+` + "```terraform" + `
+{{ .GeneratedExample }}
+` + "```" + `
+{{- end }}
+{{- range .Examples }}
+
+### {{ .Title }}
+
+{{tffile .File }}
+{{- end }}
 {{- end }}
 
 {{ .SchemaMarkdown | trimspace }}
-{{- if .HasImport }}
+{{- if and .SensitiveAttributesSection .SensitiveAttributes }}
+
+## {{ heading "sensitive-attributes" }}
+
+~> **Warning:** The following attributes are sensitive. Terraform redacts their values from plan and apply output, but stores them in plain text in the state, so the state should be stored in an encrypted backend with restricted access.
+{{ range .SensitiveAttributes }}
+- ` + "`{{ . }}`" + `
+{{- end }}
+{{- end }}
+{{- if or .HasImport .HasImportBlock .HasIdentity }}
 
-## Import
+## {{ heading "import" }}
+{{- end }}
+{{- if .HasImport }}
 
 Import is supported using the following syntax:
 
 {{codefile "shell" .ImportFile }}
 {{- end }}
+{{- if .HasImportBlock }}
+
+In Terraform v1.5.0 and later, an ` + "`import`" + ` block can be used to import the resource, for example:
+
+{{tffile .ImportBlockFile }}
+{{- end }}
+{{- if .HasIdentity }}
+
+In Terraform v1.12.0 and later, an ` + "`import`" + ` block can be used with the ` + "`identity`" + ` attribute, for example:
+
+` + "```terraform" + `
+{{ .IdentityImportBlock }}
+` + "```" + `
+
+{{ .IdentitySchemaMarkdown | trimspace }}
+{{- end }}
+` + relatedResourcesSection + `
+`
+
+// defaultItemTemplate is the default template of ephemeral resources, list
+// resources, and actions.
+const defaultItemTemplate resourceTemplate = `---
+` + frontmatterComment + `
+page_title: {{ if .PageTitle }}{{ printf "%q" .PageTitle }}{{ else }}"{{.Name}} {{.Type}} - {{.ProviderName}}"{{ end }}
+subcategory: "{{.Subcategory}}"
+description: |-
+{{ .Description | plainmarkdown | trimspace | prefixlines "  " }}
+{{- range $key, $value := .Frontmatter }}
+{{ $key }}: {{ printf "%q" $value }}
+{{- end }}
+---
+
+# {{.Name}} ({{.Type}})
+{{- if .IsDeprecated }}
+
+~> **Deprecated:** This {{ lower .Type }} is deprecated and may be removed in a future version of the provider.
+{{- with .DeprecationReplacement }} Use ` + "`{{ . }}`" + ` instead.{{ end }}
+{{- end }}
+
+{{ .Description | trimspace }}
+
+{{ if or .HasExample .Examples .GeneratedExample -}}
+## {{ heading "example-usage" }}
+{{- if .HasExample }}
+
+{{tffile .ExampleFile }}
+{{- else if .GeneratedExample }}
+
+` + "```terraform" + `
+{{ .GeneratedExample }}
+` + "```" + `
+{{- end }}
+{{- range .Examples }}
+
+### {{ .Title }}
+
+{{tffile .File }}
+{{- end }}
+{{- end }}
+
+{{ .SchemaMarkdown | trimspace }}
+{{- if and .SensitiveAttributesSection .SensitiveAttributes }}
+
+## {{ heading "sensitive-attributes" }}
+
+~> **Warning:** The following attributes are sensitive. Terraform redacts their values from plan and apply output, but stores them in plain text in the state, so the state should be stored in an encrypted backend with restricted access.
+{{ range .SensitiveAttributes }}
+- ` + "`{{ . }}`" + `
+{{- end }}
+{{- end }}
+` + relatedResourcesSection + `
 `
 
 const defaultFunctionTemplate functionTemplate = `---
 ` + frontmatterComment + `
 page_title: "{{.Name}} {{.Type}} - {{.ProviderName}}"
-subcategory: ""
+subcategory: "{{.Subcategory}}"
 description: |-
 {{ .Summary | plainmarkdown | trimspace | prefixlines "  " }}
 ---
@@ -311,7 +1144,7 @@ description: |-
 {{ .Description | trimspace }}
 
 {{ if .HasExample -}}
-## Example Usage
+## {{ heading "example-usage" }}
 
 {{tffile .ExampleFile }}
 {{- end }}
@@ -341,7 +1174,7 @@ description: |-
 {{ .Description | trimspace }}
 
 {{ if .HasExample -}}
-## Example Usage
+## {{ heading "example-usage" }}
 
 {{tffile .ExampleFile }}
 {{- end }}
@@ -349,6 +1182,25 @@ description: |-
 {{ .SchemaMarkdown | trimspace }}
 `
 
+const defaultDeprecationsTemplate deprecationsTemplate = `---
+` + frontmatterComment + `
+page_title: "Deprecations"
+subcategory: ""
+description: |-
+  Deprecated resources, data sources, and attributes of the {{.ProviderShortName}} provider.
+---
+
+# Deprecations
+
+The following resources, data sources, and attributes of the {{.ProviderShortName}} provider are deprecated, and may be removed in a future version of the provider.
+
+{{ if .HasDeprecations -}}
+{{ .DeprecationsMarkdown }}
+{{- else -}}
+No resources, data sources, or attributes are deprecated.
+{{- end }}
+`
+
 const migrateProviderTemplateComment string = `
 {{/* This template serves as a starting point for documentation generation, and can be customized with hardcoded values and/or doc gen templates.
 
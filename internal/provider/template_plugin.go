@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+// templateFuncNameRegexp matches the names which template functions can be
+// called by.
+var templateFuncNameRegexp = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// templateFuncsPlugin is an external binary which provides additional template
+// functions. It is run with the "functions" argument to list the names of its
+// functions, as a JSON array of strings on stdout, and with the "call" and
+// function name arguments for each call of a function, with the arguments of
+// the call as a JSON array on stdin, and the result as a JSON value on stdout.
+// A call fails if the binary exits with a non-zero status, with the message
+// written to stderr.
+type templateFuncsPlugin struct {
+	// path is the path of the binary, as resolved from the PATH.
+	path string
+}
+
+func newTemplateFuncsPlugin(name string) (*templateFuncsPlugin, error) {
+	path, err := exec.LookPath(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to find template functions plugin %q: %w", name, err)
+	}
+
+	return &templateFuncsPlugin{
+		path: path,
+	}, nil
+}
+
+// funcs returns the template functions of the plugin, which run the plugin
+// for each call.
+func (p *templateFuncsPlugin) funcs(ctx context.Context) (template.FuncMap, error) {
+	output, err := p.run(ctx, nil, "functions")
+	if err != nil {
+		return nil, fmt.Errorf("unable to list template functions of plugin %q: %w", p.path, err)
+	}
+
+	var names []string
+
+	err = json.Unmarshal(output, &names)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse template functions of plugin %q: %w", p.path, jsonErrorPosition(output, err))
+	}
+
+	funcs := make(template.FuncMap, len(names))
+	for _, name := range names {
+		if !templateFuncNameRegexp.MatchString(name) {
+			return nil, fmt.Errorf("invalid template function name %q of plugin %q", name, p.path)
+		}
+
+		funcs[name] = p.call(ctx, name)
+	}
+
+	return funcs, nil
+}
+
+// call returns the template function with the given name, which passes its
+// arguments to the plugin and returns the decoded result.
+func (p *templateFuncsPlugin) call(ctx context.Context, name string) func(...interface{}) (interface{}, error) {
+	return func(args ...interface{}) (interface{}, error) {
+		if args == nil {
+			args = []interface{}{}
+		}
+
+		input, err := json.Marshal(args)
+		if err != nil {
+			return nil, fmt.Errorf("unable to encode arguments of template function %q: %w", name, err)
+		}
+
+		output, err := p.run(ctx, input, "call", name)
+		if err != nil {
+			return nil, fmt.Errorf("template function %q: %w", name, err)
+		}
+
+		var result interface{}
+
+		err = json.Unmarshal(output, &result)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse result of template function %q: %w", name, jsonErrorPosition(output, err))
+		}
+
+		return result, nil
+	}
+}
+
+// run runs the plugin with the given input and arguments, and returns its
+// stdout.
+func (p *templateFuncsPlugin) run(ctx context.Context, input []byte, args ...string) ([]byte, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, p.path, args...)
+	cmd.Stdin = bytes.NewReader(input)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("%w: %s", err, message)
+		}
+
+		return nil, err
+	}
+
+	return stdout.Bytes(), nil
+}
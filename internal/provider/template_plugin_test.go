@@ -0,0 +1,103 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestTemplateFuncsPlugin(t *testing.T) {
+	t.Parallel()
+
+	if runtime.GOOS == "windows" {
+		t.Skip("plugin scripts require a unix shell")
+	}
+
+	testCases := map[string]struct {
+		script             string
+		args               []interface{}
+		expected           interface{}
+		expectedError      string
+		expectedFuncsError string
+	}{
+		"call": {
+			script:   `cat >/dev/null; echo '{"count": 2}'`,
+			args:     []interface{}{"a", 1},
+			expected: map[string]interface{}{"count": float64(2)},
+		},
+		"call-error": {
+			script:        `echo "bad input" >&2; exit 3`,
+			expectedError: `template function "example": exit status 3: bad input`,
+		},
+		"invalid-result": {
+			script:        `echo '{'`,
+			expectedError: `unable to parse result of template function "example": line 1, column 2: unexpected end of JSON input`,
+		},
+		"invalid-name": {
+			script:             ``,
+			expectedFuncsError: `invalid template function name "not-valid" of plugin`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			functions := `["example"]`
+			if testCase.expectedFuncsError != "" {
+				functions = `["not-valid"]`
+			}
+
+			path := filepath.Join(t.TempDir(), "plugin")
+			script := "#!/bin/sh\nif [ \"$1\" = functions ]; then echo '" + functions + "'; exit 0; fi\n" + testCase.script + "\n"
+
+			err := os.WriteFile(path, []byte(script), 0755)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			plugin, err := newTemplateFuncsPlugin(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			funcs, err := plugin.funcs(context.Background())
+			if testCase.expectedFuncsError != "" {
+				if err == nil || !strings.Contains(err.Error(), testCase.expectedFuncsError) {
+					t.Fatalf("expected error containing %q, got: %v", testCase.expectedFuncsError, err)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			fn := funcs["example"].(func(...interface{}) (interface{}, error))
+
+			actual, err := fn(testCase.args...)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -6,9 +6,13 @@ package provider
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
 )
 
 func TestRenderStringTemplate(t *testing.T) {
@@ -21,6 +25,8 @@ Trimspace: {{ trimspace .Text }}
 Lower: {{ upper .Text }}
 Upper: {{ lower .Text }}
 Title: {{ title .Text }}
+Attributeanchor: {{ attributeanchor "versioning.enabled_at" }}
+Attributelink: {{ attributelink "versioning.enabled_at" }}
 Prefixlines:
 {{ prefixlines "  " .MultiLineTest }}
 Printf tffile: {{ printf "{{tffile %q}}" .Code }}
@@ -34,6 +40,8 @@ Trimspace: my Odly cAsed striNg
 Lower: MY ODLY CASED STRING
 Upper: my odly cased string
 Title: My Odly Cased String
+Attributeanchor: attr-versioning-enabled-at
+Attributelink: [` + "`versioning.enabled_at`" + `](#attr-versioning-enabled-at)
 Prefixlines:
   This text used
   multiple lines
@@ -44,7 +52,7 @@ provider "scaffolding" {
 }
 
 `
-	result, err := renderStringTemplate("testdata/test-provider-dir", "testTemplate", template, struct {
+	result, err := renderStringTemplate(templateOptions{providerDir: "testdata/test-provider-dir"}, "testTemplate", template, struct {
 		Text          string
 		MultiLineTest string
 		Code          string
@@ -64,6 +72,177 @@ multiple lines`,
 	}
 }
 
+func TestRenderStringTemplate_SprigFunctions(t *testing.T) {
+	t.Parallel()
+
+	template := `
+default: {{ .Empty | default "fallback" }}
+replace: {{ .Name | replace "_" "-" }}
+regexReplaceAll: {{ regexReplaceAll "^scaffolding_" .Name "" }}
+dict: {{ $d := dict "key" "value" }}{{ $d.key }}
+list and join: {{ list "a" "b" "c" | join ", " }}
+indent:
+{{ "first\nsecond" | indent 2 }}
+contains: {{ contains "example" .Name }}
+hasPrefix: {{ hasPrefix "scaffolding_" .Name }}
+ternary: {{ ternary "yes" "no" (eq .Name "scaffolding_example") }}
+split: {{ index (split .Name "_") 1 }}
+title: {{ title .Name }}
+`
+
+	expectedString := `
+default: fallback
+replace: scaffolding-example
+regexReplaceAll: example
+dict: value
+list and join: a, b, c
+indent:
+  first
+  second
+contains: true
+hasPrefix: true
+ternary: yes
+split: example
+title: Scaffolding_example
+`
+
+	result, err := renderStringTemplate(templateOptions{}, "testTemplate", template, struct {
+		Empty string
+		Name  string
+	}{
+		Name: "scaffolding_example",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedString, result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestRenderStringTemplate_SummaryFunctions(t *testing.T) {
+	t.Parallel()
+
+	template := `
+firstline: {{ .Description | firstline }}
+firstparagraph: {{ .Description | firstparagraph | plainmarkdown }}
+truncate: {{ .Description | firstparagraph | plainmarkdown | truncate 40 }}
+`
+
+	expectedString := `
+firstline: Manages an ` + "`example`" + ` resource,
+firstparagraph: Manages an example resource,
+which stores configurable values.
+truncate: Manages an example resource,
+which...
+`
+
+	result, err := renderStringTemplate(templateOptions{}, "testTemplate", template, struct {
+		Description string
+	}{
+		Description: "Manages an `example` resource,\nwhich stores configurable values.\n\nSee the [guide](https://example.com) for details.",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedString, result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestRenderStringTemplate_Env(t *testing.T) {
+	t.Setenv("TFPLUGINDOCS_TEST_COMMIT", "abc123")
+	t.Setenv("TFPLUGINDOCS_TEST_SECRET", "secret")
+
+	opts := templateOptions{
+		templateEnv: []string{"TFPLUGINDOCS_TEST_COMMIT"},
+	}
+
+	result, err := renderStringTemplate(opts, "testTemplate", `{{ env "TFPLUGINDOCS_TEST_COMMIT" }}`, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if result != "abc123" {
+		t.Errorf("expected allowed environment variable value, got: %q", result)
+	}
+
+	_, err = renderStringTemplate(opts, "testTemplate", `{{ env "TFPLUGINDOCS_TEST_SECRET" }}`, nil)
+	if err == nil {
+		t.Error("expected error for environment variable which is not allowed")
+	}
+}
+
+func TestRenderStringTemplate_BuildInfo(t *testing.T) {
+	t.Parallel()
+
+	opts := templateOptions{
+		buildInfo: buildInfo{
+			Version:   "0.20.0",
+			Commit:    "abc123",
+			Timestamp: time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC),
+		},
+	}
+
+	template := `{{ with buildinfo }}{{ .Version }} {{ .Commit }} {{ .Timestamp.Format "2006-01-02T15:04:05Z07:00" }}{{ end }}`
+
+	result, err := renderStringTemplate(opts, "testTemplate", template, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff("0.20.0 abc123 2024-01-02T15:04:05Z", result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestRenderStringTemplate_NonHermeticFunctions(t *testing.T) {
+	t.Parallel()
+
+	for _, name := range []string{"expandenv", "now", "randAlpha", "uuidv4"} {
+		_, err := renderStringTemplate(templateOptions{}, "testTemplate", "{{ "+name+" }}", nil)
+		if err == nil {
+			t.Errorf("expected error for non-hermetic function %q", name)
+		}
+	}
+}
+
+func TestRenderStringTemplate_Partials(t *testing.T) {
+	t.Parallel()
+
+	template := `
+{{ template "partials/note.md.tmpl" .Name }}
+{{ template "partials/callouts/beta.md.tmpl" . }}
+`
+
+	expectedString := `
+-> **Note:** example is managed by test-provider.
+~> **Beta:** example is in beta.
+`
+
+	tmplOpts := templateOptions{
+		partials: map[string]string{
+			"partials/note.md.tmpl":          `-> **Note:** {{ . }} is managed by test-provider.`,
+			"partials/callouts/beta.md.tmpl": `~> **Beta:** {{ lower .Name }} is in beta.`,
+		},
+	}
+
+	result, err := renderStringTemplate(tmplOpts, "testTemplate", template, struct {
+		Name string
+	}{
+		Name: "example",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedString, result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func TestResourceTemplate_Render(t *testing.T) {
 	t.Parallel()
 
@@ -93,7 +272,7 @@ provider "scaffolding" {
 		},
 	}
 
-	result, err := tpl.Render("testdata/test-provider-dir", "testTemplate", "test-provider", "test-provider", "Resource", "provider.tf", "provider.tf", &schema)
+	result, err := tpl.Render(templateOptions{providerDir: "testdata/test-provider-dir"}, "testTemplate", "test-provider", "test-provider", "Resource", "provider.tf", "provider.tf", "", &schema, nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -133,7 +312,7 @@ provider "scaffolding" {
 		},
 	}
 
-	result, err := tpl.Render("testdata/test-provider-dir", "testTemplate", "test-provider", "provider.tf", &schema)
+	result, err := tpl.Render(templateOptions{providerDir: "testdata/test-provider-dir"}, "testTemplate", "test-provider", "provider.tf", &schema)
 	if err != nil {
 		t.Error(err)
 	}
@@ -143,3 +322,461 @@ provider "scaffolding" {
 		t.Errorf("expected: %+v, got: %+v", expectedString, cleanedResult)
 	}
 }
+
+func TestDocTemplate_Render(t *testing.T) {
+	t.Parallel()
+
+	template := `# Upgrading the {{ .RenderedProviderName }} Provider ({{ .ProviderName }}, {{ .ProviderShortName }}) to {{ .ProviderVersion }}
+`
+	expectedString := `# Upgrading the Scaffolding Provider (terraform-provider-scaffolding, scaffolding) to 1.2.0
+`
+
+	tpl := docTemplate(template)
+
+	var result strings.Builder
+	err := tpl.Render(templateOptions{providerVersion: "1.2.0"}, "terraform-provider-scaffolding", "Scaffolding", &result)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !cmp.Equal(expectedString, result.String()) {
+		t.Errorf("expected: %+v, got: %+v", expectedString, result.String())
+	}
+}
+
+func TestResourceTemplate_Render_Schema(t *testing.T) {
+	t.Parallel()
+
+	template := `
+{{- range $name, $attr := .Schema.Block.Attributes }}
+{{ $name }}: {{ $attr.Description }}
+{{- end }}
+`
+	expectedString := `
+id: The ID of this resource.
+name: The name of this resource.
+`
+
+	tpl := resourceTemplate(template)
+
+	schema := tfjson.Schema{
+		Version: 3,
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"id": {
+					AttributeType: cty.String,
+					Computed:      true,
+					Description:   "The ID of this resource.",
+				},
+				"name": {
+					AttributeType: cty.String,
+					Required:      true,
+					Description:   "The name of this resource.",
+				},
+			},
+		},
+	}
+
+	result, err := tpl.Render(templateOptions{providerDir: "testdata/test-provider-dir"}, "testTemplate", "test-provider", "test-provider", "Resource", "", "", "", &schema, nil)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if !cmp.Equal(expectedString, result) {
+		t.Errorf("expected: %+v, got: %+v", expectedString, result)
+	}
+}
+
+func TestResourceTemplate_Render_SchemaAttribute(t *testing.T) {
+	t.Parallel()
+
+	template := `
+Set {{ schemaattribute "name" }}
+`
+	expectedString := `
+Set ` + "`name`" + ` (String, Required) The name of this resource.
+`
+
+	tpl := resourceTemplate(template)
+
+	schema := tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"name": {
+					AttributeType: cty.String,
+					Required:      true,
+					Description:   "The name of this resource.",
+				},
+			},
+		},
+	}
+
+	result, err := tpl.Render(templateOptions{providerDir: "testdata/test-provider-dir"}, "testTemplate", "test-provider", "test-provider", "Resource", "", "", "", &schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedString, result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = renderStringTemplate(templateOptions{}, "testTemplate", `{{ schemaattribute "name" }}`, nil)
+	if err == nil {
+		t.Error("expected error for template without a schema")
+	}
+}
+
+func TestResourceTemplate_Render_RenderSchema(t *testing.T) {
+	t.Parallel()
+
+	template := `
+{{ renderschema "timeouts" }}
+{{ renderschema "timeouts" (dict "Style" "table") }}`
+	expectedString := `
+<a id="nestedblock--timeouts"></a>
+### Nested Schema for ` + "`timeouts`" + `
+
+Optional:
+
+- ` + "`create`" + ` (String) Timeout for creation.
+
+<a id="nestedblock--timeouts"></a>
+### Nested Schema for ` + "`timeouts`" + `
+
+| Name | Type | Required | Description |
+|------|------|----------|-------------|
+| ` + "`create`" + ` | String | Optional | Timeout for creation. |
+`
+
+	tpl := resourceTemplate(template)
+
+	schema := tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"timeouts": {
+					NestingMode: tfjson.SchemaNestingModeSingle,
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"create": {
+								AttributeType: cty.String,
+								Optional:      true,
+								Description:   "Timeout for creation.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	result, err := tpl.Render(templateOptions{providerDir: "testdata/test-provider-dir"}, "testTemplate", "test-provider", "test-provider", "Resource", "", "", "", &schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedString, result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	_, err = tpl.Render(templateOptions{}, "testTemplate", "test-provider", "test-provider", "Resource", "", "", "", &tfjson.Schema{Block: &tfjson.SchemaBlock{}}, nil)
+	if err == nil {
+		t.Error("expected error for missing nested schema")
+	}
+}
+
+func TestResourceTemplate_Render_InlineNestedDepth(t *testing.T) {
+	t.Parallel()
+
+	template := `
+{{ .SchemaMarkdown | trimspace }}
+
+{{ schemamarkdown .Schema (dict "InlineNestedDepth" 0) | trimspace }}
+`
+
+	expectedString := `
+<!-- schema generated by tfplugindocs -->
+## Schema
+
+### Optional
+
+- ` + "`timeouts`" + ` (Block, Optional)
+  - ` + "`create`" + ` (String, Optional) Timeout for creation.
+
+<!-- schema generated by tfplugindocs -->
+## Schema
+
+### Optional
+
+- ` + "`timeouts`" + ` (Block, Optional) (see [below for nested schema](#nestedblock--timeouts))
+
+<a id="nestedblock--timeouts"></a>
+### Nested Schema for ` + "`timeouts`" + `
+
+Optional:
+
+- ` + "`create`" + ` (String) Timeout for creation.
+`
+
+	tpl := resourceTemplate(template)
+
+	schema := tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"timeouts": {
+					NestingMode: tfjson.SchemaNestingModeSingle,
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"create": {
+								AttributeType: cty.String,
+								Description:   "Timeout for creation.",
+								Optional:      true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	tmplOpts := templateOptions{
+		schemaOptions: &schemamd.RenderOptions{
+			InlineNestedDepth: 1,
+		},
+	}
+
+	result, err := tpl.Render(tmplOpts, "testTemplate", "test-provider", "test-provider", "Resource", "", "", "", &schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedString, result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceTemplate_Render_SchemaStyle(t *testing.T) {
+	t.Parallel()
+
+	template := `
+{{ .SchemaMarkdown | trimspace }}
+
+{{ schemamarkdown .Schema (dict "Style" "default") | trimspace }}
+
+{{ schemamarkdown .Schema (dict "Style" "table") | trimspace }}
+`
+
+	expectedString := `
+<!-- schema generated by tfplugindocs -->
+## Argument Reference
+
+The following arguments are supported:
+
+- ` + "`name`" + ` (String, Required) Name of the example.
+
+## Attributes Reference
+
+In addition to all arguments above, the following attributes are exported:
+
+- ` + "`id`" + ` (String) Identifier of the example.
+
+<!-- schema generated by tfplugindocs -->
+## Schema
+
+### Required
+
+- ` + "`name`" + ` (String) Name of the example.
+
+### Read-Only
+
+- ` + "`id`" + ` (String) Identifier of the example.
+
+<!-- schema generated by tfplugindocs -->
+## Schema
+
+| Name | Type | Required | Description |
+|------|------|----------|-------------|
+| ` + "`name`" + ` | String | Required | Name of the example. |
+| ` + "`id`" + ` | String | Read-Only | Identifier of the example. |
+`
+
+	tpl := resourceTemplate(template)
+
+	schema := tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"id": {
+					AttributeType: cty.String,
+					Computed:      true,
+					Description:   "Identifier of the example.",
+				},
+				"name": {
+					AttributeType: cty.String,
+					Description:   "Name of the example.",
+					Required:      true,
+				},
+			},
+		},
+	}
+
+	tmplOpts := templateOptions{
+		schemaOptions: &schemamd.RenderOptions{
+			Style: schemamd.StyleLegacy,
+		},
+	}
+
+	result, err := tpl.Render(tmplOpts, "testTemplate", "test-provider", "test-provider", "Resource", "", "", "", &schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedString, result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceTemplate_Render_AttributeOrder(t *testing.T) {
+	t.Parallel()
+
+	template := `
+{{ schemamarkdown .Schema (dict "AttributeOrder" (list "name" "*" "description")) | trimspace }}
+
+{{ schemamarkdown .Schema (dict "AttributeSort" "required-first") | trimspace }}
+`
+
+	expectedString := `
+<!-- schema generated by tfplugindocs -->
+## Schema
+
+### Optional
+
+- ` + "`name`" + ` (String) Name of the example.
+- ` + "`enabled`" + ` (Boolean) Whether the example is enabled.
+- ` + "`description`" + ` (String) Description of the example.
+
+### Read-Only
+
+- ` + "`id`" + ` (String) Identifier of the example.
+
+<!-- schema generated by tfplugindocs -->
+## Schema
+
+- ` + "`description`" + ` (String, Optional) Description of the example.
+- ` + "`enabled`" + ` (Boolean, Optional) Whether the example is enabled.
+- ` + "`name`" + ` (String, Optional) Name of the example.
+- ` + "`id`" + ` (String, Read-only) Identifier of the example.
+`
+
+	tpl := resourceTemplate(template)
+
+	schema := tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"description": {
+					AttributeType: cty.String,
+					Description:   "Description of the example.",
+					Optional:      true,
+				},
+				"enabled": {
+					AttributeType: cty.Bool,
+					Description:   "Whether the example is enabled.",
+					Optional:      true,
+				},
+				"id": {
+					AttributeType: cty.String,
+					Computed:      true,
+					Description:   "Identifier of the example.",
+				},
+				"name": {
+					AttributeType: cty.String,
+					Description:   "Name of the example.",
+					Optional:      true,
+				},
+			},
+		},
+	}
+
+	result, err := tpl.Render(templateOptions{}, "testTemplate", "test-provider", "test-provider", "Resource", "", "", "", &schema, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expectedString, result); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestSchemaMarkdown_Errors(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{},
+	}
+
+	cases := map[string]struct {
+		schema        *tfjson.Schema
+		overrides     map[string]interface{}
+		expectedError string
+	}{
+		"nil schema": {
+			expectedError: "expected a schema, got nil",
+		},
+		"unsupported option": {
+			schema: schema,
+			overrides: map[string]interface{}{
+				"Unknown": true,
+			},
+			expectedError: `unsupported schema render option "Unknown"`,
+		},
+		"invalid option type": {
+			schema: schema,
+			overrides: map[string]interface{}{
+				"InlineNestedDepth": "2",
+			},
+			expectedError: "expected InlineNestedDepth to be an integer, got string",
+		},
+		"invalid style type": {
+			schema: schema,
+			overrides: map[string]interface{}{
+				"Style": 1,
+			},
+			expectedError: "expected Style to be a string, got int",
+		},
+		"unsupported style": {
+			schema: schema,
+			overrides: map[string]interface{}{
+				"Style": "modern",
+			},
+			expectedError: `unable to render schema: unsupported schema style "modern", expected one of: default, legacy, table`,
+		},
+		"invalid attribute order type": {
+			schema: schema,
+			overrides: map[string]interface{}{
+				"AttributeOrder": []interface{}{"name", 1},
+			},
+			expectedError: "expected AttributeOrder to be a list of strings: got int element",
+		},
+		"unsupported attribute sort": {
+			schema: schema,
+			overrides: map[string]interface{}{
+				"AttributeSort": "random",
+			},
+			expectedError: `unable to render schema: unsupported attribute sort "random", expected one of: alphabetical, required-first`,
+		},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := schemaMarkdown(nil)(c.schema, c.overrides)
+			if err == nil {
+				t.Fatalf("expected error %q, got none", c.expectedError)
+			}
+
+			if err.Error() != c.expectedError {
+				t.Errorf("expected error %q, got %q", c.expectedError, err.Error())
+			}
+		})
+	}
+}
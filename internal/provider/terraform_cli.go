@@ -0,0 +1,261 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+
+	"github.com/hashicorp/go-version"
+	install "github.com/hashicorp/hc-install"
+	"github.com/hashicorp/hc-install/checkpoint"
+	"github.com/hashicorp/hc-install/fs"
+	"github.com/hashicorp/hc-install/product"
+	"github.com/hashicorp/hc-install/releases"
+	"github.com/hashicorp/hc-install/src"
+)
+
+const (
+	terraformCLIProductOpenTofu = "OpenTofu"
+
+	// openTofuBinary is the name of the OpenTofu CLI binary, which is found
+	// in PATH when using OpenTofu.
+	openTofuBinary = "tofu"
+)
+
+// terraformCLIVersion matches the first line of the output of the version
+// command of the Terraform and OpenTofu CLIs, such as "OpenTofu v1.8.0".
+var terraformCLIVersion = regexp.MustCompile(`^(Terraform|OpenTofu) v(\S+)`)
+
+// terraformCLIOptions are the options which select the Terraform or OpenTofu
+// CLI binary used to export provider schemas.
+type terraformCLIOptions struct {
+	// binary is the path, or name in PATH, of a Terraform or OpenTofu CLI
+	// binary, which is used instead of finding or installing Terraform.
+	binary string
+
+	// useOpenTofu uses the OpenTofu CLI binary in PATH, unless binary is set,
+	// and requires the binary to be OpenTofu.
+	useOpenTofu bool
+
+	// version is the exact version of the Terraform CLI binary, which is
+	// found in PATH or the install directory, or downloaded.
+	version string
+
+	// installDir is the directory in which downloaded Terraform CLI binaries
+	// are kept, in a subdirectory named after the version, or "latest", and
+	// reused by later runs. Binaries are downloaded into a temporary
+	// directory if empty.
+	installDir string
+
+	// offline fails instead of downloading the Terraform CLI binary if it is
+	// not found in PATH or the install directory.
+	offline bool
+}
+
+// terraformCLI is a Terraform or OpenTofu CLI binary.
+type terraformCLI struct {
+	path string
+
+	// product is the detected product of the binary, either Terraform or
+	// OpenTofu.
+	product string
+
+	version string
+}
+
+// registryHostname returns the default provider registry hostname of the
+// binary, which is part of the source address of providers without a
+// hostname, such as in plugin directories and providers schema JSON.
+func (c *terraformCLI) registryHostname() string {
+	if c.product == terraformCLIProductOpenTofu {
+		return "registry.opentofu.org"
+	}
+
+	return "registry.terraform.io"
+}
+
+// validateTerraformCLIOptions returns an error if the Terraform CLI version
+// or install directory are set with options which do not download Terraform.
+func validateTerraformCLIOptions(opts terraformCLIOptions) error {
+	if opts.version == "" && opts.installDir == "" {
+		return nil
+	}
+
+	if opts.binary != "" {
+		return fmt.Errorf("tf version and tf install dir cannot be used with tf binary")
+	}
+
+	if opts.useOpenTofu {
+		return fmt.Errorf("tf version and tf install dir cannot be used with OpenTofu, as only Terraform is downloaded")
+	}
+
+	if opts.version != "" {
+		_, err := version.NewVersion(opts.version)
+		if err != nil {
+			return fmt.Errorf("invalid tf version %q: %w", opts.version, err)
+		}
+	}
+
+	return nil
+}
+
+// ensureTerraformCLI returns the Terraform or OpenTofu CLI binary selected by
+// the options, downloading Terraform into the install directory of the
+// options, or tmpDir, if needed, and detects its product and version.
+func ensureTerraformCLI(ctx context.Context, opts terraformCLIOptions, tmpDir string, infof func(format string, a ...interface{})) (*terraformCLI, error) {
+	var path string
+	var err error
+
+	switch {
+	case opts.binary != "":
+		path, err = exec.LookPath(opts.binary)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find CLI binary %q: %w", opts.binary, err)
+		}
+	case opts.useOpenTofu:
+		infof("using OpenTofu CLI binary from PATH")
+		path, err = exec.LookPath(openTofuBinary)
+		if err != nil {
+			return nil, fmt.Errorf("unable to find OpenTofu CLI binary %q: %w", openTofuBinary, err)
+		}
+	default:
+		path, err = ensureTerraformBinary(ctx, opts, tmpDir, infof)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	versionCmd := exec.CommandContext(ctx, path, "version")
+	versionCmd.Env = append(os.Environ(), "CHECKPOINT_DISABLE=1")
+
+	output, err := runCmd(versionCmd)
+	if err != nil {
+		return nil, fmt.Errorf("unable to detect CLI version: %w", err)
+	}
+
+	cliProduct, cliVersion, err := parseTerraformCLIVersion(string(output))
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.useOpenTofu && cliProduct != terraformCLIProductOpenTofu {
+		return nil, fmt.Errorf("expected CLI binary %q to be OpenTofu, got %s", path, cliProduct)
+	}
+
+	infof("using %s CLI version %s", cliProduct, cliVersion)
+
+	return &terraformCLI{
+		path:    path,
+		product: cliProduct,
+		version: cliVersion,
+	}, nil
+}
+
+// ensureTerraformBinary returns the path of the Terraform CLI binary of the
+// pinned version, or any version if not pinned, in PATH or the install
+// directory of the options if available, otherwise downloads it into the
+// install directory, or tmpDir if not set.
+func ensureTerraformBinary(ctx context.Context, opts terraformCLIOptions, tmpDir string, infof func(format string, a ...interface{})) (string, error) {
+	installDir := tmpDir
+	var extraPaths []string
+
+	if opts.installDir != "" {
+		subdir := "latest"
+		if opts.version != "" {
+			subdir = opts.version
+		}
+
+		// The binary is executed from another working directory, so its path
+		// must be absolute.
+		absInstallDir, err := filepath.Abs(filepath.Join(opts.installDir, subdir))
+		if err != nil {
+			return "", fmt.Errorf("unable to get absolute path of tf install dir %q: %w", opts.installDir, err)
+		}
+
+		err = os.MkdirAll(absInstallDir, 0755)
+		if err != nil {
+			return "", fmt.Errorf("unable to create tf install dir %q: %w", absInstallDir, err)
+		}
+
+		installDir = absInstallDir
+		extraPaths = []string{absInstallDir}
+	}
+
+	i := install.NewInstaller()
+	var sources []src.Source
+	switch {
+	case opts.offline && opts.version != "":
+		infof("offline mode, using Terraform CLI binary version %s from PATH or tf install dir", opts.version)
+		sources = []src.Source{
+			&fs.ExactVersion{
+				Product:    product.Terraform,
+				Version:    version.Must(version.NewVersion(opts.version)),
+				ExtraPaths: extraPaths,
+			},
+		}
+	case opts.offline:
+		infof("offline mode, using Terraform CLI binary from PATH or tf install dir")
+		sources = []src.Source{
+			&fs.AnyVersion{
+				Product:    &product.Terraform,
+				ExtraPaths: extraPaths,
+			},
+		}
+	case opts.version != "":
+		tfVersion := version.Must(version.NewVersion(opts.version))
+
+		infof("using Terraform CLI binary version %s from PATH or tf install dir if available, otherwise downloading it from releases.hashicorp.com", tfVersion)
+		sources = []src.Source{
+			&fs.ExactVersion{
+				Product:    product.Terraform,
+				Version:    tfVersion,
+				ExtraPaths: extraPaths,
+			},
+			&releases.ExactVersion{
+				Product:    product.Terraform,
+				Version:    tfVersion,
+				InstallDir: installDir,
+			},
+		}
+	default:
+		infof("using Terraform CLI binary from PATH or tf install dir if available, otherwise downloading latest Terraform CLI binary")
+		sources = []src.Source{
+			&fs.AnyVersion{
+				Product:    &product.Terraform,
+				ExtraPaths: extraPaths,
+			},
+			&checkpoint.LatestVersion{
+				InstallDir: installDir,
+				Product:    product.Terraform,
+			},
+		}
+	}
+
+	tfBin, err := i.Ensure(ctx, sources)
+	if err != nil {
+		if opts.offline {
+			return "", fmt.Errorf("unable to find Terraform binary, which is not downloaded in offline mode: %w", err)
+		}
+
+		return "", fmt.Errorf("unable to download Terraform binary: %w", err)
+	}
+
+	return tfBin, nil
+}
+
+// parseTerraformCLIVersion returns the product and version from the output of
+// the version command of the Terraform or OpenTofu CLI.
+func parseTerraformCLIVersion(output string) (string, string, error) {
+	m := terraformCLIVersion.FindStringSubmatch(output)
+	if m == nil {
+		return "", "", fmt.Errorf("unable to detect CLI version, expected Terraform or OpenTofu version output, got: %q", firstLine(output))
+	}
+
+	return m[1], m[2], nil
+}
@@ -0,0 +1,110 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestParseTerraformCLIVersion(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		output          string
+		expectedProduct string
+		expectedVersion string
+		expectedError   string
+	}{
+		"terraform": {
+			output:          "Terraform v1.9.5\non linux_amd64\n",
+			expectedProduct: "Terraform",
+			expectedVersion: "1.9.5",
+		},
+		"opentofu": {
+			output:          "OpenTofu v1.8.0\non linux_amd64\n",
+			expectedProduct: "OpenTofu",
+			expectedVersion: "1.8.0",
+		},
+		"unknown": {
+			output:        "Usage: example\n",
+			expectedError: `unable to detect CLI version, expected Terraform or OpenTofu version output, got: "Usage: example"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actualProduct, actualVersion, err := parseTerraformCLIVersion(testCase.output)
+
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actualProduct != testCase.expectedProduct || actualVersion != testCase.expectedVersion {
+				t.Errorf("expected %s %s, got %s %s", testCase.expectedProduct, testCase.expectedVersion, actualProduct, actualVersion)
+			}
+		})
+	}
+}
+
+func TestValidateTerraformCLIOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		opts          terraformCLIOptions
+		expectedError string
+	}{
+		"unset": {},
+		"tf version": {
+			opts: terraformCLIOptions{version: "1.9.5"},
+		},
+		"tf version and tf install dir": {
+			opts: terraformCLIOptions{version: "1.9.5", installDir: ".terraform-cli"},
+		},
+		"tf binary": {
+			opts: terraformCLIOptions{binary: "tofu", useOpenTofu: true},
+		},
+		"tf version and tf binary": {
+			opts:          terraformCLIOptions{binary: "terraform", version: "1.9.5"},
+			expectedError: "tf version and tf install dir cannot be used with tf binary",
+		},
+		"tf install dir and opentofu": {
+			opts:          terraformCLIOptions{installDir: ".terraform-cli", useOpenTofu: true},
+			expectedError: "tf version and tf install dir cannot be used with OpenTofu, as only Terraform is downloaded",
+		},
+		"invalid tf version": {
+			opts:          terraformCLIOptions{version: "latest"},
+			expectedError: `invalid tf version "latest": Malformed version: latest`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateTerraformCLIOptions(testCase.opts)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+// attributeTypeNames are the names rendered instead of the types of
+// attributes, such as the names of custom types of a provider framework.
+type attributeTypeNames = attributeMetadata[string]
+
+// loadAttributeTypeNames sets the type names of the attribute types file at
+// path into typeNames. The file is an attribute metadata file of strings, for
+// example:
+//
+//	{
+//	  "resources": {
+//	    "scaffolding_example": {"created_at": "RFC3339 Timestamp", "settings.arn": "ARN"}
+//	  }
+//	}
+func loadAttributeTypeNames(path string, typeNames attributeTypeNames) error {
+	values, err := readAttributeMetadataFile[string](path, "attribute types")
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range sortedKeys(values) {
+		for _, name := range sortedKeys(values[dir]) {
+			for _, attPath := range sortedKeys(values[dir][name]) {
+				typeNames.set(dir, name, attPath, values[dir][name][attPath])
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestLoadAttributeTypeNames(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		file          string
+		expected      attributeTypeNames
+		expectedError string
+	}{
+		"type names": {
+			file: `{
+  "provider": {"endpoint": "URL"},
+  "resources": {"scaffolding_example": {"created_at": "RFC3339 Timestamp", "settings.arn": "ARN"}}
+}`,
+			expected: attributeTypeNames{
+				"": {
+					"": {"endpoint": "URL"},
+				},
+				"resources": {
+					"scaffolding_example": {
+						"created_at":   "RFC3339 Timestamp",
+						"settings.arn": "ARN",
+					},
+				},
+			},
+		},
+		"invalid type name": {
+			file:          `{"resources": {"scaffolding_example": {"created_at": 1}}}`,
+			expectedError: `unable to parse attribute types file`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "types.json")
+			err := os.WriteFile(path, []byte(testCase.file), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			actual := make(attributeTypeNames)
+
+			err = loadAttributeTypeNames(path, actual)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.expectedError)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
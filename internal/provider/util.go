@@ -4,13 +4,22 @@
 package provider
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"io/fs"
 	"log"
+	"net/http"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/Kunde21/markdownfmt/v3/markdown"
 	tfjson "github.com/hashicorp/terraform-json"
@@ -18,8 +27,48 @@ import (
 	meta "github.com/yuin/goldmark-meta"
 	"github.com/yuin/goldmark/extension"
 	"github.com/yuin/goldmark/parser"
+	"github.com/zclconf/go-cty/cty"
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/tmplfuncs"
 )
 
+// jsonErrorPosition returns the error of decoding the JSON data with the line
+// and column of the error in the data, for syntax errors and values of the
+// wrong type, or the error unchanged otherwise.
+func jsonErrorPosition(data []byte, err error) error {
+	var offset int64
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+	default:
+		return err
+	}
+
+	// the offset is after the last byte read, which is the position of the
+	// error
+	pos := int(offset) - 1
+	if pos > len(data) {
+		pos = len(data)
+	}
+	if pos < 0 {
+		pos = 0
+	}
+
+	before := data[:pos]
+	line := bytes.Count(before, []byte("\n")) + 1
+	column := pos - bytes.LastIndexByte(before, '\n')
+
+	return fmt.Errorf("line %d, column %d: %w", line, column, err)
+}
+
 func providerShortName(n string) string {
 	return strings.TrimPrefix(n, "terraform-provider-")
 }
@@ -84,6 +133,341 @@ func resourceSchema(schemas map[string]*tfjson.Schema, providerShortName, templa
 	return nil, resName
 }
 
+// identityImportBlock returns an example import block for the resource which
+// imports it by identity, using placeholder values for the identity
+// attributes which are required for import. All identity attributes are
+// included if none are required. An empty string is returned if the resource
+// has no identity schema.
+func identityImportBlock(resourceName string, schema *tfjson.IdentitySchema) string {
+	if schema == nil {
+		return ""
+	}
+
+	var names []string
+	for name, att := range schema.Attributes {
+		if att.RequiredForImport {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		for name := range schema.Attributes {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	width := 0
+	for _, name := range names {
+		if len(name) > width {
+			width = len(name)
+		}
+	}
+
+	var b strings.Builder
+	b.WriteString("import {\n")
+	fmt.Fprintf(&b, "  to = %s.example\n", resourceName)
+	b.WriteString("  identity = {\n")
+	for _, name := range names {
+		fmt.Fprintf(&b, "    %-*s = %s\n", width, name, identityPlaceholder(name, schema.Attributes[name].IdentityType))
+	}
+	b.WriteString("  }\n")
+	b.WriteString("}")
+
+	return b.String()
+}
+
+// requiredProvidersBlock returns a terraform block which requires the
+// provider with the given source address, and the version if not empty.
+func requiredProvidersBlock(source, version string) string {
+	localName := source[strings.LastIndex(source, "/")+1:]
+
+	var b strings.Builder
+	b.WriteString("terraform {\n")
+	b.WriteString("  required_providers {\n")
+	fmt.Fprintf(&b, "    %s = {\n", localName)
+	if version != "" {
+		fmt.Fprintf(&b, "      source  = %q\n", source)
+		fmt.Fprintf(&b, "      version = %q\n", version)
+	} else {
+		fmt.Fprintf(&b, "      source = %q\n", source)
+	}
+	b.WriteString("    }\n")
+	b.WriteString("  }\n")
+	b.WriteString("}")
+
+	return b.String()
+}
+
+// identityPlaceholder returns a placeholder Terraform value of the given type
+// for the identity attribute.
+func identityPlaceholder(name string, ty cty.Type) string {
+	switch {
+	case ty == cty.String:
+		return fmt.Sprintf("%q", "<"+name+">")
+	case ty == cty.Number:
+		return "0"
+	case ty == cty.Bool:
+		return "false"
+	case ty.IsListType() || ty.IsSetType():
+		return "[" + identityPlaceholder(name, ty.ElementType()) + "]"
+	default:
+		return "null"
+	}
+}
+
+// exampleBlockTypes are the Terraform block types of the items rendered by
+// resource templates, by the Type field of the templates.
+var exampleBlockTypes = map[string]string{
+	"Resource":           "resource",
+	"Data Source":        "data",
+	"Ephemeral Resource": "ephemeral",
+	"List Resource":      "list",
+	"Action":             "action",
+}
+
+// exampleSkeleton returns a minimal example configuration of the item, with
+// the required attributes and blocks of its schema and placeholder values
+// derived from their types, in the canonical style of terraform fmt. The
+// arguments of list resources and actions are set in their config block.
+func exampleSkeleton(blockType, name, providerName string, schema *tfjson.Schema) string {
+	if blockType == "" || schema == nil || schema.Block == nil {
+		return ""
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %q \"example\" {\n", blockType, name)
+	switch blockType {
+	case "list":
+		fmt.Fprintf(&b, "  provider = %s\n\n", providerShortName(providerName))
+		b.WriteString("  config {\n")
+		writeExampleBody(&b, schema.Block, "    ")
+		b.WriteString("  }\n")
+	case "action":
+		b.WriteString("  config {\n")
+		writeExampleBody(&b, schema.Block, "    ")
+		b.WriteString("  }\n")
+	default:
+		writeExampleBody(&b, schema.Block, "  ")
+	}
+	b.WriteString("}")
+
+	return strings.TrimSuffix(tmplfuncs.FormatTerraform(b.String()), "\n")
+}
+
+// writeExampleBody writes the required attributes of the schema block, and
+// its nested blocks with a minimum number of items, with the given
+// indentation.
+func writeExampleBody(b *strings.Builder, block *tfjson.SchemaBlock, indent string) {
+	var names []string
+	for name, attr := range block.Attributes {
+		if attr.Required {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(b, "%s%s = %s\n", indent, name, exampleAttributePlaceholder(name, block.Attributes[name]))
+	}
+
+	var blockNames []string
+	for name, nested := range block.NestedBlocks {
+		if nested.MinItems > 0 && nested.Block != nil {
+			blockNames = append(blockNames, name)
+		}
+	}
+	sort.Strings(blockNames)
+
+	for i, name := range blockNames {
+		if i > 0 || len(names) > 0 {
+			b.WriteString("\n")
+		}
+
+		if block.NestedBlocks[name].NestingMode == tfjson.SchemaNestingModeMap {
+			fmt.Fprintf(b, "%s%s \"key\" {\n", indent, name)
+		} else {
+			fmt.Fprintf(b, "%s%s {\n", indent, name)
+		}
+		writeExampleBody(b, block.NestedBlocks[name].Block, indent+"  ")
+		fmt.Fprintf(b, "%s}\n", indent)
+	}
+}
+
+// exampleAttributePlaceholder returns a placeholder Terraform value of the
+// attribute, with the required attributes of nested attributes.
+func exampleAttributePlaceholder(name string, attr *tfjson.SchemaAttribute) string {
+	nested := attr.AttributeNestedType
+	if nested == nil {
+		return examplePlaceholder(name, attr.AttributeType)
+	}
+
+	var names []string
+	for nestedName, nestedAttr := range nested.Attributes {
+		if nestedAttr.Required {
+			names = append(names, nestedName)
+		}
+	}
+	sort.Strings(names)
+
+	values := make([]string, len(names))
+	for i, nestedName := range names {
+		values[i] = nestedName + " = " + exampleAttributePlaceholder(nestedName, nested.Attributes[nestedName])
+	}
+	object := exampleObject(values)
+
+	switch nested.NestingMode {
+	case tfjson.SchemaNestingModeList, tfjson.SchemaNestingModeSet:
+		return "[" + object + "]"
+	case tfjson.SchemaNestingModeMap:
+		return "{ key = " + object + " }"
+	default:
+		return object
+	}
+}
+
+// examplePlaceholder returns a placeholder Terraform value of the given type
+// for the attribute, such as "<name>" for strings.
+func examplePlaceholder(name string, ty cty.Type) string {
+	switch {
+	case ty == cty.DynamicPseudoType:
+		return fmt.Sprintf("%q", "<"+name+">")
+	case ty.IsListType() || ty.IsSetType():
+		return "[" + examplePlaceholder(name, ty.ElementType()) + "]"
+	case ty.IsMapType():
+		return "{ key = " + examplePlaceholder(name, ty.ElementType()) + " }"
+	case ty.IsObjectType():
+		var names []string
+		for attrName := range ty.AttributeTypes() {
+			names = append(names, attrName)
+		}
+		sort.Strings(names)
+
+		values := make([]string, len(names))
+		for i, attrName := range names {
+			values[i] = attrName + " = " + examplePlaceholder(attrName, ty.AttributeType(attrName))
+		}
+		return exampleObject(values)
+	case ty.IsTupleType():
+		values := make([]string, len(ty.TupleElementTypes()))
+		for i, elemType := range ty.TupleElementTypes() {
+			values[i] = examplePlaceholder(name, elemType)
+		}
+		return "[" + strings.Join(values, ", ") + "]"
+	default:
+		return identityPlaceholder(name, ty)
+	}
+}
+
+// exampleObject returns a single line Terraform object with the given
+// "<name> = <value>" attributes.
+func exampleObject(values []string) string {
+	if len(values) == 0 {
+		return "{}"
+	}
+
+	return "{ " + strings.Join(values, ", ") + " }"
+}
+
+// resourceExample is an additional Terraform configuration example of a
+// resource, data source, ephemeral resource, list resource, or action.
+type resourceExample struct {
+	// Title is taken from the leading comment of the example file, or
+	// derived from the file name if there is no leading comment.
+	Title string
+
+	// File is the path to the example file.
+	File string
+}
+
+// additionalExamplePrefix is the file name prefix of additional examples in
+// the directory of the conventional example file, such as
+// "example-with_logging.tf". Other Terraform configuration files in the
+// directory, such as shared fixtures, are not rendered.
+const additionalExamplePrefix = "example-"
+
+// additionalExamples returns the Terraform configuration files named with the
+// additional example prefix in the directory of the conventional example
+// file, sorted by file name.
+func additionalExamples(exampleFile string) ([]resourceExample, error) {
+	if exampleFile == "" {
+		return nil, nil
+	}
+
+	dir := filepath.Dir(exampleFile)
+	entries, err := os.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to read examples directory %q: %w", dir, err)
+	}
+
+	var examples []resourceExample
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".tf" || !strings.HasPrefix(entry.Name(), additionalExamplePrefix) {
+			continue
+		}
+
+		file := filepath.Join(dir, entry.Name())
+
+		title, err := exampleTitle(file)
+		if err != nil {
+			return nil, err
+		}
+
+		examples = append(examples, resourceExample{
+			Title: title,
+			File:  file,
+		})
+	}
+
+	return examples, nil
+}
+
+// exampleTitle returns the text of the first line of the leading comment of
+// the example file, ignoring any copyright and license header lines. If the
+// file has no leading comment, the title is derived from the file name, e.g.
+// "example-with_logging.tf" becomes "With Logging".
+func exampleTitle(file string) (string, error) {
+	content, err := os.ReadFile(file)
+	if err != nil {
+		return "", fmt.Errorf("unable to read example %q: %w", file, err)
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		var comment string
+		switch {
+		case strings.HasPrefix(line, "#"):
+			comment = strings.TrimSpace(strings.TrimPrefix(line, "#"))
+		case strings.HasPrefix(line, "//"):
+			comment = strings.TrimSpace(strings.TrimPrefix(line, "//"))
+		default:
+			// end of the leading comment
+			return exampleTitleFromFileName(file), nil
+		}
+
+		if comment == "" || strings.HasPrefix(comment, "Copyright") || strings.HasPrefix(comment, "SPDX-License-Identifier") {
+			continue
+		}
+
+		return comment, nil
+	}
+
+	return exampleTitleFromFileName(file), nil
+}
+
+func exampleTitleFromFileName(file string) string {
+	name := strings.TrimPrefix(removeAllExt(filepath.Base(file)), additionalExamplePrefix)
+	name = strings.NewReplacer("_", " ", "-", " ").Replace(name)
+
+	return cases.Title(language.Und).String(name)
+}
+
 func writeFile(path string, data string) error {
 	dir, _ := filepath.Split(path)
 
@@ -103,6 +487,22 @@ func writeFile(path string, data string) error {
 	return nil
 }
 
+// offlineGoEnv are the environment variables of go commands in offline mode,
+// which fail instead of downloading modules or toolchains.
+var offlineGoEnv = []string{"GOPROXY=off", "GOTOOLCHAIN=local"}
+
+// providerBuildCmd returns the command which builds the provider in
+// providerDir into outFile, without accessing the network if offline.
+func providerBuildCmd(ctx context.Context, providerDir, outFile string, offline bool) *exec.Cmd {
+	buildCmd := exec.CommandContext(ctx, "go", "build", "-o", outFile)
+	buildCmd.Dir = providerDir
+	if offline {
+		buildCmd.Env = append(os.Environ(), offlineGoEnv...)
+	}
+
+	return buildCmd
+}
+
 //nolint:unparam
 func runCmd(cmd *exec.Cmd) ([]byte, error) {
 	output, err := cmd.CombinedOutput()
@@ -145,6 +545,39 @@ func cp(srcDir, dstDir string) error {
 	return err
 }
 
+// copyFS copies the files of fsys into dstDir. Files and directories are
+// created with default permissions, as file systems such as fstest.MapFS
+// do not always set them.
+func copyFS(fsys fs.FS, dstDir string) error {
+	return fs.WalkDir(fsys, ".", func(srcPath string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		dstPath := filepath.Join(dstDir, filepath.FromSlash(srcPath))
+
+		switch {
+		case d.IsDir():
+			if err := os.MkdirAll(dstPath, 0755); err != nil {
+				return err
+			}
+		case d.Type().IsRegular():
+			content, err := fs.ReadFile(fsys, srcPath)
+			if err != nil {
+				return err
+			}
+
+			if err := os.WriteFile(dstPath, content, 0644); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown file type (%s) for %s", d.Type().String(), srcPath)
+		}
+
+		return nil
+	})
+}
+
 func fileExists(filename string) bool {
 	info, err := os.Stat(filename)
 	if os.IsNotExist(err) {
@@ -153,12 +586,140 @@ func fileExists(filename string) bool {
 	return !info.IsDir()
 }
 
-func extractSchemaFromFile(path string) (*tfjson.ProviderSchemas, error) {
+// sortedKeys returns the keys of m in increasing order.
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// providersSchemaStdin is the path of the providers schema JSON which is read
+// from stdin.
+const providersSchemaStdin = "-"
+
+// providersSchemaHTTPTimeout is the timeout of fetching the providers schema
+// JSON from an HTTP(S) URL.
+const providersSchemaHTTPTimeout = 30 * time.Second
+
+// stdinProvidersSchema caches the providers schema JSON read from stdin, as
+// stdin can only be read once but the schema is read multiple times.
+var stdinProvidersSchema struct {
+	once sync.Once
+	data []byte
+	err  error
+}
+
+// readProvidersSchemaFile returns the contents of the providers schema JSON at
+// path, which is either a file path, "-" to read from stdin, or an HTTP(S) URL.
+// If path is a comma separated list of paths, the providers schema JSONs are
+// merged.
+func readProvidersSchemaFile(ctx context.Context, path string) ([]byte, error) {
+	paths := providersSchemaPaths(path)
+	switch len(paths) {
+	case 0:
+		return readProvidersSchemaSource(ctx, path)
+	case 1:
+		return readProvidersSchemaSource(ctx, paths[0])
+	}
+
+	schemajsons := make([][]byte, 0, len(paths))
+	for _, p := range paths {
+		schemajson, err := readProvidersSchemaSource(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		schemajsons = append(schemajsons, schemajson)
+	}
+
+	return mergeProvidersSchemas(paths, schemajsons)
+}
+
+// readProvidersSchemaSource returns the contents of a single providers schema
+// JSON at path.
+func readProvidersSchemaSource(ctx context.Context, path string) ([]byte, error) {
+	if path == providersSchemaStdin {
+		stdinProvidersSchema.once.Do(func() {
+			stdinProvidersSchema.data, stdinProvidersSchema.err = io.ReadAll(os.Stdin)
+		})
+
+		if stdinProvidersSchema.err != nil {
+			return nil, fmt.Errorf("unable to read stdin: %w", stdinProvidersSchema.err)
+		}
+
+		return stdinProvidersSchema.data, nil
+	}
+
+	if isProvidersSchemaURL(path) {
+		return fetchProvidersSchema(ctx, path)
+	}
+
 	schemajson, err := os.ReadFile(path)
 	if err != nil {
 		return nil, fmt.Errorf("unable to read file %q: %w", path, err)
 	}
 
+	return schemajson, nil
+}
+
+// validateOfflineProvidersSchema returns an error if any of the providers
+// schema paths is a URL, which cannot be fetched in offline mode.
+func validateOfflineProvidersSchema(offline bool, providersSchemaPath string) error {
+	if !offline {
+		return nil
+	}
+
+	for _, path := range providersSchemaPaths(providersSchemaPath) {
+		if isProvidersSchemaURL(path) {
+			return fmt.Errorf("providers schema URL %q cannot be fetched in offline mode", path)
+		}
+	}
+
+	return nil
+}
+
+// isProvidersSchemaURL returns true if the providers schema path is an
+// HTTP(S) URL.
+func isProvidersSchemaURL(path string) bool {
+	return strings.HasPrefix(path, "http://") || strings.HasPrefix(path, "https://")
+}
+
+func fetchProvidersSchema(ctx context.Context, url string) ([]byte, error) {
+	client := &http.Client{Timeout: providersSchemaHTTPTimeout}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %q: %w", url, err)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("unable to fetch %q: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unable to fetch %q: unexpected status %s", url, resp.Status)
+	}
+
+	schemajson, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read response of %q: %w", url, err)
+	}
+
+	return schemajson, nil
+}
+
+func extractSchemaFromFile(path string) (*tfjson.ProviderSchemas, error) {
+	schemajson, err := readProvidersSchemaFile(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+
 	schemas := &tfjson.ProviderSchemas{
 		FormatVersion: "",
 		Schemas:       nil,
@@ -171,6 +732,42 @@ func extractSchemaFromFile(path string) (*tfjson.ProviderSchemas, error) {
 	return schemas, nil
 }
 
+// providerActionSchemas is the JSON representation of the provider-defined
+// action schemas in the providers schema JSON. Action schemas are not yet
+// supported by terraform-json, so they are decoded separately.
+type providerActionSchemas struct {
+	Schemas map[string]*struct {
+		ActionSchemas map[string]*tfjson.Schema `json:"action_schemas,omitempty"`
+	} `json:"provider_schemas,omitempty"`
+}
+
+// extractActionSchemas returns the action schemas of the given provider from
+// the providers schema JSON. No error is returned if the provider has no
+// action schemas.
+func extractActionSchemas(schemajson []byte, providerName string) (map[string]*tfjson.Schema, error) {
+	shortName := providerShortName(providerName)
+
+	return extractActionSchemasByAddress(schemajson, shortName, "registry.terraform.io/hashicorp/"+shortName)
+}
+
+// extractActionSchemasByAddress returns the action schemas of the first of the
+// given provider addresses in the providers schema JSON.
+func extractActionSchemasByAddress(schemajson []byte, addresses ...string) (map[string]*tfjson.Schema, error) {
+	schemas := &providerActionSchemas{}
+	err := json.Unmarshal(schemajson, schemas)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, address := range addresses {
+		if ps, ok := schemas.Schemas[address]; ok && ps != nil {
+			return ps.ActionSchemas, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func newMarkdownRenderer() goldmark.Markdown {
 	mr := markdown.NewRenderer()
 	extensions := []goldmark.Extender{
@@ -4,10 +4,18 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
 	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
 )
 
 func Test_resourceSchema(t *testing.T) {
@@ -114,3 +122,422 @@ func Test_extractSchemaFromFile(t *testing.T) {
 	}
 
 }
+
+func Test_readProvidersSchemaFile_URL(t *testing.T) {
+	t.Parallel()
+
+	schemajson, err := os.ReadFile("testdata/schema.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/schema.json" {
+			http.NotFound(w, r)
+			return
+		}
+
+		_, _ = w.Write(schemajson)
+	}))
+	defer server.Close()
+
+	schema, err := extractSchemaFromFile(server.URL + "/schema.json")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if schema.Schemas["registry.terraform.io/hashicorp/null"] == nil {
+		t.Fatalf("null provider not found")
+	}
+
+	_, err = readProvidersSchemaFile(context.Background(), server.URL+"/missing.json")
+	if err == nil || !strings.Contains(err.Error(), "unexpected status 404 Not Found") {
+		t.Errorf("expected unexpected status error, got: %v", err)
+	}
+}
+
+func Test_extractActionSchemas(t *testing.T) {
+	t.Parallel()
+
+	schemajson := []byte(`{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/scaffolding": {
+      "action_schemas": {
+        "scaffolding_example": {
+          "block": {
+            "attributes": {
+              "message": {
+                "type": "string",
+                "optional": true
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+	actionSchemas, err := extractActionSchemas(schemajson, "terraform-provider-scaffolding")
+	if err != nil {
+		t.Fatalf("received error %v:", err)
+	}
+
+	if actionSchemas["scaffolding_example"] == nil {
+		t.Fatalf("scaffolding_example action not found")
+	}
+	if actionSchemas["scaffolding_example"].Block.Attributes["message"] == nil {
+		t.Fatalf("scaffolding_example message attribute not found")
+	}
+
+	actionSchemas, err = extractActionSchemas(schemajson, "terraform-provider-null")
+	if err != nil {
+		t.Fatalf("received error %v:", err)
+	}
+
+	if len(actionSchemas) != 0 {
+		t.Fatalf("expected no action schemas, got %d", len(actionSchemas))
+	}
+}
+
+func Test_identityImportBlock(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		schema   *tfjson.IdentitySchema
+		expected string
+	}{
+		"no identity": {
+			schema:   nil,
+			expected: "",
+		},
+		"required attributes": {
+			schema: &tfjson.IdentitySchema{
+				Attributes: map[string]*tfjson.IdentityAttribute{
+					"account_id": {
+						IdentityType:      cty.Number,
+						RequiredForImport: true,
+					},
+					"name": {
+						IdentityType:      cty.String,
+						RequiredForImport: true,
+					},
+					"region": {
+						IdentityType:      cty.String,
+						OptionalForImport: true,
+					},
+				},
+			},
+			expected: `import {
+  to = test_resource.example
+  identity = {
+    account_id = 0
+    name       = "<name>"
+  }
+}`,
+		},
+		"optional attributes": {
+			schema: &tfjson.IdentitySchema{
+				Attributes: map[string]*tfjson.IdentityAttribute{
+					"tags": {
+						IdentityType:      cty.List(cty.String),
+						OptionalForImport: true,
+					},
+					"enabled": {
+						IdentityType:      cty.Bool,
+						OptionalForImport: true,
+					},
+				},
+			},
+			expected: `import {
+  to = test_resource.example
+  identity = {
+    enabled = false
+    tags    = ["<tags>"]
+  }
+}`,
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := identityImportBlock("test_resource", c.schema)
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func Test_requiredProvidersBlock(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		source   string
+		version  string
+		expected string
+	}{
+		"version": {
+			source:  "hashicorp/random",
+			version: "3.6.0",
+			expected: `terraform {
+  required_providers {
+    random = {
+      source  = "hashicorp/random"
+      version = "3.6.0"
+    }
+  }
+}`,
+		},
+		"hostname without version": {
+			source: "example.com/acme/scaffolding",
+			expected: `terraform {
+  required_providers {
+    scaffolding = {
+      source = "example.com/acme/scaffolding"
+    }
+  }
+}`,
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := requiredProvidersBlock(c.source, c.version)
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func Test_exampleSkeleton(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		blockType string
+		schema    *tfjson.Schema
+		expected  string
+	}{
+		"required attributes and blocks": {
+			blockType: "resource",
+			schema: &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"id":          {AttributeType: cty.String, Computed: true},
+						"name":        {AttributeType: cty.String, Required: true},
+						"description": {AttributeType: cty.String, Optional: true},
+						"port":        {AttributeType: cty.Number, Required: true},
+						"enabled":     {AttributeType: cty.Bool, Required: true},
+						"zones":       {AttributeType: cty.Set(cty.String), Required: true},
+						"labels":      {AttributeType: cty.Map(cty.String), Required: true},
+						"owner": {
+							AttributeType: cty.Object(map[string]cty.Type{
+								"email": cty.String,
+								"id":    cty.Number,
+							}),
+							Required: true,
+						},
+						"rules": {
+							AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+								NestingMode: tfjson.SchemaNestingModeList,
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"action":   {AttributeType: cty.String, Required: true},
+									"priority": {AttributeType: cty.Number, Optional: true},
+								},
+							},
+							Required: true,
+						},
+					},
+					NestedBlocks: map[string]*tfjson.SchemaBlockType{
+						"network": {
+							NestingMode: tfjson.SchemaNestingModeList,
+							MinItems:    1,
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"cidr": {AttributeType: cty.String, Required: true},
+								},
+							},
+						},
+						"timeouts": {
+							NestingMode: tfjson.SchemaNestingModeSingle,
+							Block: &tfjson.SchemaBlock{
+								Attributes: map[string]*tfjson.SchemaAttribute{
+									"create": {AttributeType: cty.String, Optional: true},
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: `resource "test_resource" "example" {
+  enabled = false
+  labels  = { key = "<labels>" }
+  name    = "<name>"
+  owner   = { email = "<email>", id = 0 }
+  port    = 0
+  rules   = [{ action = "<action>" }]
+  zones   = ["<zones>"]
+
+  network {
+    cidr = "<cidr>"
+  }
+}`,
+		},
+		"data source without required attributes": {
+			blockType: "data",
+			schema: &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"id": {AttributeType: cty.String, Computed: true},
+					},
+				},
+			},
+			expected: `data "test_resource" "example" {
+}`,
+		},
+		"list resource": {
+			blockType: "list",
+			schema: &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"filter": {AttributeType: cty.String, Required: true},
+					},
+				},
+			},
+			expected: `list "test_resource" "example" {
+  provider = test
+
+  config {
+    filter = "<filter>"
+  }
+}`,
+		},
+		"action": {
+			blockType: "action",
+			schema: &tfjson.Schema{
+				Block: &tfjson.SchemaBlock{
+					Attributes: map[string]*tfjson.SchemaAttribute{
+						"message": {AttributeType: cty.String, Required: true},
+					},
+				},
+			},
+			expected: `action "test_resource" "example" {
+  config {
+    message = "<message>"
+  }
+}`,
+		},
+	}
+
+	for name, c := range cases {
+		c := c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := exampleSkeleton(c.blockType, "test_resource", "terraform-provider-test", c.schema)
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func Test_additionalExamples(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"resource.tf":             "resource \"test_example\" \"example\" {}\n",
+		"import.tf":               "import {}\n",
+		"import.sh":               "terraform import test_example.example id\n",
+		"fixtures.tf":             "resource \"test_example\" \"fixture\" {}\n",
+		"example-basic.tf":        "# Copyright (c) HashiCorp, Inc.\n# SPDX-License-Identifier: MPL-2.0\n\nresource \"test_example\" \"basic\" {}\n",
+		"example-with_logging.tf": "# Example with logging enabled\nresource \"test_example\" \"logging\" {}\n",
+		"example-with-tags.tf":    "// Example with tags\nresource \"test_example\" \"tags\" {}\n",
+	} {
+		err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	actual, err := additionalExamples(filepath.Join(dir, "resource.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []resourceExample{
+		{Title: "Basic", File: filepath.Join(dir, "example-basic.tf")},
+		{Title: "Example with tags", File: filepath.Join(dir, "example-with-tags.tf")},
+		{Title: "Example with logging enabled", File: filepath.Join(dir, "example-with_logging.tf")},
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference (-want +got): %s", diff)
+	}
+
+	actual, err = additionalExamples(filepath.Join(dir, "missing", "resource.tf"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(actual) != 0 {
+		t.Errorf("expected no examples, got: %v", actual)
+	}
+}
+
+func Test_jsonErrorPosition(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		data             string
+		expectedPosition string
+	}{
+		"syntax error": {
+			data:             `{"a": x}`,
+			expectedPosition: "line 1, column 7",
+		},
+		"syntax error on later line": {
+			data:             "{\n  \"a\": 1,\n}",
+			expectedPosition: "line 3, column 1",
+		},
+		"type error": {
+			data:             "{\n  \"a\": \"b\"\n}",
+			expectedPosition: "line 2, column 10",
+		},
+		"unexpected end": {
+			data:             `{"a": 1`,
+			expectedPosition: "line 1, column 7",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var value map[string]int
+			err := json.Unmarshal([]byte(testCase.data), &value)
+			if err == nil {
+				t.Fatal("expected error, got none")
+			}
+
+			expected := testCase.expectedPosition + ": " + err.Error()
+
+			actual := jsonErrorPosition([]byte(testCase.data), err)
+			if actual.Error() != expected {
+				t.Errorf("expected error %q, got: %s", expected, actual)
+			}
+		})
+	}
+}
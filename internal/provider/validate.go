@@ -7,13 +7,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"log"
 	"os"
 	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/bmatcuk/doublestar/v4"
 	"github.com/hashicorp/cli"
 	tfjson "github.com/hashicorp/terraform-json"
+	"golang.org/x/exp/slices"
 
 	"github.com/hashicorp/terraform-plugin-docs/internal/check"
 )
@@ -24,8 +29,8 @@ const (
 	FileExtensionMarkdown     = `.markdown`
 	FileExtensionMd           = `.md`
 
-	DocumentationGlobPattern    = `{docs/index.md,docs/{,cdktf/}{data-sources,guides,resources,functions}/**/*,website/docs/**/*}`
-	DocumentationDirGlobPattern = `{docs/{,cdktf/}{data-sources,guides,resources,functions}{,/*},website/docs/**/*}`
+	DocumentationGlobPattern    = `{docs/index.md,docs/{,cdktf/}{actions,data-sources,ephemeral-resources,guides,list-resources,resources,functions}/**/*,website/docs/**/*}`
+	DocumentationDirGlobPattern = `{docs/{,cdktf/}{actions,data-sources,ephemeral-resources,guides,list-resources,resources,functions}{,/*},website/docs/**/*}`
 )
 
 var ValidLegacyFileExtensions = []string{
@@ -84,12 +89,140 @@ type validator struct {
 	providersSchemaPath string
 
 	tfVersion      string
+	tfBinary       string
+	useOpenTofu    bool
+	tfInstallDir   string
+	offline        bool
 	providerSchema *tfjson.ProviderSchema
 
+	// ignore matches the resources, data sources, ephemeral resources, list
+	// resources, and functions which are not required to be documented.
+	ignore *itemFilter
+
+	// ruleSeverities are the severities of check rules which are not
+	// reported as errors, by rule ID.
+	ruleSeverities map[string]string
+
+	// maxFileSize, maxFiles, and maxPathDepth are the documentation limits,
+	// which default to the Terraform Registry limits if zero.
+	maxFileSize  int64
+	maxFiles     int
+	maxPathDepth int
+
+	// frontMatter are the additional frontmatter requirements of every
+	// documentation file, which are added to the built-in requirements of
+	// each file type.
+	frontMatter check.FrontMatterOptions
+
+	// descriptionStyle are the style rules of schema descriptions, which are
+	// only checked if any rule is set.
+	descriptionStyle check.DescriptionStyleOptions
+
+	// examplesDir is the examples directory, relative to the provider
+	// directory, whose Terraform files are checked to be formatted if
+	// exampleFormat is set.
+	examplesDir   string
+	exampleFormat bool
+
 	logger *Logger
 }
 
-func Validate(ui cli.Ui, providerDir, providerName, providersSchemaPath, tfversion string) error {
+// ValidateOptions are the options of website validation, which correspond to
+// the flags of the validate command.
+type ValidateOptions struct {
+	// ProviderDir is the path to the root provider directory. Defaults to the
+	// working directory.
+	ProviderDir string
+
+	ProviderName        string
+	ProvidersSchemaPath string
+	TFVersion           string
+
+	// TFBinary is the path, or name in PATH, of the Terraform or OpenTofu CLI
+	// binary used to export the provider schema. It cannot be used with
+	// TFVersion.
+	TFBinary string
+
+	// UseOpenTofu exports the provider schema with the OpenTofu CLI binary
+	// in PATH, or TFBinary if set, which must be OpenTofu.
+	UseOpenTofu bool
+
+	// TFInstallDir is the directory in which downloaded Terraform CLI
+	// binaries are kept and reused by later runs.
+	TFInstallDir string
+
+	// Offline fails instead of downloading the Terraform CLI binary, the
+	// modules of the provider, or the ProvidersSchemaPath URL.
+	Offline bool
+
+	// Ignore are the item patterns which are not required to be documented.
+	Ignore []string
+
+	// Rules are the severities of check rules, as <rule>=<severity> values.
+	Rules []string
+
+	// FrontMatterRequired and FrontMatterForbidden are the frontmatter keys
+	// which must, and must not, be present in every documentation file.
+	FrontMatterRequired  []string
+	FrontMatterForbidden []string
+
+	// FrontMatterPatterns are the regular expressions which frontmatter
+	// values must match, as comma separated <key>=<pattern> values.
+	FrontMatterPatterns string
+
+	// DescriptionStyle are the style rules of schema descriptions, as
+	// uppercase, period, max-length=<n>, and no-todo values. Descriptions are
+	// not checked if empty.
+	DescriptionStyle []string
+
+	// ExamplesDir is the examples directory, relative to the provider
+	// directory. Defaults to "examples".
+	ExamplesDir string
+
+	// ExampleFormat checks that the Terraform files of the examples
+	// directory are formatted in the canonical style of terraform fmt.
+	ExampleFormat bool
+
+	// MaxFileSize, MaxFiles, and MaxPathDepth are the maximum size in bytes
+	// of a documentation file, number of documentation files, and path depth
+	// of a documentation file below the documentation directory. Each
+	// defaults to the Terraform Registry limit if zero.
+	MaxFileSize  int64
+	MaxFiles     int
+	MaxPathDepth int
+
+	// Format is the output format of validation findings, one of
+	// ValidateFormats. Defaults to text.
+	Format string
+}
+
+func Validate(ui cli.Ui, opts ValidateOptions) error {
+	providerDir := opts.ProviderDir
+
+	format := opts.Format
+	if format == "" {
+		format = ValidateFormatText
+	}
+
+	if err := validateFormat(format); err != nil {
+		return err
+	}
+
+	ruleSeverities, err := parseRuleSeverities(opts.Rules)
+	if err != nil {
+		return err
+	}
+
+	frontMatterPatterns, err := parseFrontMatterPatterns(opts.FrontMatterPatterns)
+	if err != nil {
+		return err
+	}
+
+	descriptionStyle, err := parseDescriptionStyle(opts.DescriptionStyle)
+	if err != nil {
+		return err
+	}
+
 	// Ensure provider directory is resolved absolute path
 	if providerDir == "" {
 		wd, err := os.Getwd()
@@ -120,18 +253,94 @@ func Validate(ui cli.Ui, providerDir, providerName, providersSchemaPath, tfversi
 		return fmt.Errorf("expected %q to be a directory", providerDir)
 	}
 
+	err = validateTerraformCLIOptions(terraformCLIOptions{
+		binary:      opts.TFBinary,
+		useOpenTofu: opts.UseOpenTofu,
+		version:     opts.TFVersion,
+		installDir:  opts.TFInstallDir,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = validateOfflineProvidersSchema(opts.Offline, opts.ProvidersSchemaPath)
+	if err != nil {
+		return err
+	}
+
+	ignoreFilter, err := loadIgnoreFilter(providerDir, opts.Ignore)
+	if err != nil {
+		return err
+	}
+
 	v := &validator{
-		providerName:        providerName,
+		providerName:        opts.ProviderName,
 		providerDir:         providerDir,
-		providersSchemaPath: providersSchemaPath,
-		tfVersion:           tfversion,
+		providersSchemaPath: opts.ProvidersSchemaPath,
+		tfVersion:           opts.TFVersion,
+		tfBinary:            opts.TFBinary,
+		useOpenTofu:         opts.UseOpenTofu,
+		tfInstallDir:        opts.TFInstallDir,
+		offline:             opts.Offline,
+		ignore:              ignoreFilter,
+		ruleSeverities:      ruleSeverities,
+		maxFileSize:         opts.MaxFileSize,
+		maxFiles:            opts.MaxFiles,
+		maxPathDepth:        opts.MaxPathDepth,
+		frontMatter: check.FrontMatterOptions{
+			RequireFields: opts.FrontMatterRequired,
+			NoFields:      opts.FrontMatterForbidden,
+			FieldPatterns: frontMatterPatterns,
+		},
+		descriptionStyle: descriptionStyle,
+		examplesDir:      opts.ExamplesDir,
+		exampleFormat:    opts.ExampleFormat,
 
 		logger: NewLogger(ui),
 	}
 
+	// Progress messages would otherwise be interleaved with the findings
+	// document on standard output.
+	if format != ValidateFormatText {
+		v.logger = NewLogger(&cli.BasicUi{Writer: io.Discard, ErrorWriter: io.Discard})
+	}
+
 	ctx := context.Background()
 
-	return v.validate(ctx)
+	warnings, err := v.applyRuleSeverities(v.validate(ctx))
+
+	if format == ValidateFormatText {
+		for _, warning := range warnings {
+			v.logger.warnf("warning: %s", warning)
+		}
+
+		return err
+	}
+
+	findings := validationFindings(providerDir, err)
+
+	for _, f := range validationFindings(providerDir, errors.Join(warnings...)) {
+		f.Severity = severityWarning
+		findings = append(findings, f)
+	}
+
+	var output string
+	var renderErr error
+
+	switch format {
+	case ValidateFormatJSON:
+		output, renderErr = renderFindingsJSON(findings)
+	case ValidateFormatSARIF:
+		output, renderErr = renderFindingsSARIF(findings)
+	}
+
+	if renderErr != nil {
+		return errors.Join(err, renderErr)
+	}
+
+	ui.Output(output)
+
+	return err
 }
 
 func (v *validator) validate(ctx context.Context) error {
@@ -145,7 +354,13 @@ func (v *validator) validate(ctx context.Context) error {
 
 	if v.providersSchemaPath == "" {
 		v.logger.infof("exporting schema from Terraform")
-		v.providerSchema, err = TerraformProviderSchemaFromTerraform(ctx, v.providerName, v.providerDir, v.tfVersion, v.logger)
+		v.providerSchema, err = TerraformProviderSchemaFromTerraform(ctx, v.providerName, v.providerDir, terraformCLIOptions{
+			binary:      v.tfBinary,
+			useOpenTofu: v.useOpenTofu,
+			version:     v.tfVersion,
+			installDir:  v.tfInstallDir,
+			offline:     v.offline,
+		}, v.logger)
 		if err != nil {
 			return fmt.Errorf("error exporting provider schema from Terraform: %w", err)
 		}
@@ -182,6 +397,110 @@ func (v *validator) validate(ctx context.Context) error {
 		result = errors.Join(result, err)
 	}
 
+	if v.descriptionStyle.Enabled() {
+		v.logger.infof("running description style check")
+		result = errors.Join(result, v.validateDescriptionStyle())
+	}
+
+	if v.exampleFormat {
+		v.logger.infof("running example format check")
+		result = errors.Join(result, v.validateExampleFormat())
+	}
+
+	return result
+}
+
+// validateExampleFormat checks that the Terraform files of the examples
+// directory, if it exists, are formatted.
+func (v *validator) validateExampleFormat() error {
+	examplesDir := v.examplesDir
+	if examplesDir == "" {
+		examplesDir = "examples"
+	}
+
+	dir := filepath.Join(v.providerDir, examplesDir)
+	if !dirExists(dir) {
+		log.Printf("[DEBUG] Skipping example format check due to missing examples directory %q", dir)
+		return nil
+	}
+
+	var result error
+
+	formatCheck := check.NewExampleFormatCheck()
+
+	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() || filepath.Ext(path) != ".tf" {
+			return nil
+		}
+
+		rel, err := filepath.Rel(v.providerDir, path)
+		if err != nil {
+			return err
+		}
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("%s: error reading file: %w", rel, err)
+		}
+
+		result = errors.Join(result, formatCheck.Run(filepath.ToSlash(rel), content))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("error walking directory %q: %w", dir, err)
+	}
+
+	return result
+}
+
+// validateDescriptionStyle checks the descriptions of the provider schema,
+// except for the descriptions of ignored items, against the description style
+// rules.
+func (v *validator) validateDescriptionStyle() error {
+	if v.providerSchema == nil {
+		log.Printf("[DEBUG] Skipping description style check due to missing provider schema")
+		return nil
+	}
+
+	var result error
+
+	descriptionCheck := check.NewDescriptionStyleCheck(v.descriptionStyle)
+
+	result = errors.Join(result, descriptionCheck.RunSchema("provider", providerShortName(v.providerName), v.providerSchema.ConfigSchema))
+
+	sections := []struct {
+		kind    string
+		dir     string
+		schemas map[string]*tfjson.Schema
+	}{
+		{"resource", "resources", v.providerSchema.ResourceSchemas},
+		{"data source", "data-sources", v.providerSchema.DataSourceSchemas},
+		{"ephemeral resource", "ephemeral-resources", v.providerSchema.EphemeralResourceSchemas},
+		{"list resource", "list-resources", v.providerSchema.ListResourceSchemas},
+	}
+
+	for _, section := range sections {
+		for _, name := range sortedKeys(section.schemas) {
+			if v.ignore.Match(section.dir, name) {
+				continue
+			}
+
+			result = errors.Join(result, descriptionCheck.RunSchema(section.kind, name, section.schemas[name]))
+		}
+	}
+
+	for _, name := range sortedKeys(v.providerSchema.Functions) {
+		if v.ignore.Match("functions", name) {
+			continue
+		}
+
+		result = errors.Join(result, descriptionCheck.RunFunction(name, v.providerSchema.Functions[name]))
+	}
+
 	return result
 }
 
@@ -192,10 +511,13 @@ func (v *validator) validateStaticDocs(dir string) error {
 	options := &check.ProviderFileOptions{
 		FrontMatter:     RegistryFrontMatterOptions,
 		ValidExtensions: ValidRegistryFileExtensions,
+		MaxFileSize:     v.maxFileSize,
 	}
 
 	var files []string
 
+	linksCheck := check.NewLinksCheck(v.providerDir)
+
 	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
 		if err != nil {
 			return fmt.Errorf("error walking directory %q: %w", dir, err)
@@ -228,14 +550,23 @@ func (v *validator) validateStaticDocs(dir string) error {
 
 		// Configure FrontMatterOptions based on file type
 		if d.Name() == "index.md" {
-			options.FrontMatter = RegistryIndexFrontMatterOptions
+			options.FrontMatter = v.frontMatterOptions(RegistryIndexFrontMatterOptions)
 		} else if _, relErr := filepath.Rel(rel, "guides"); relErr != nil {
-			options.FrontMatter = RegistryGuideFrontMatterOptions
+			options.FrontMatter = v.frontMatterOptions(RegistryGuideFrontMatterOptions)
 		} else {
-			options.FrontMatter = RegistryFrontMatterOptions
+			options.FrontMatter = v.frontMatterOptions(RegistryFrontMatterOptions)
 		}
 		v.logger.infof("running file checks on %s", rel)
 		result = errors.Join(result, check.NewProviderFileCheck(options).Run(path))
+		result = errors.Join(result, check.PathDepthCheck(rel, v.maxPathDepth))
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("%s: error reading file: %w", rel, err)
+		}
+
+		result = errors.Join(result, linksCheck.Run(filepath.ToSlash(rel), content))
+		result = errors.Join(result, check.NewRegistryMarkdownCheck().Run(filepath.ToSlash(rel), content))
 
 		files = append(files, path)
 		return nil
@@ -244,9 +575,30 @@ func (v *validator) validateStaticDocs(dir string) error {
 		return fmt.Errorf("error walking directory %q: %w", dir, err)
 	}
 
+	v.logger.infof("running file count check")
+	result = errors.Join(result, check.FileCountCheck(files, v.maxFiles))
+
+	ignoredNames := v.ignore.MatchingNames(v.providerSchema, nil)
+
+	relDir, err := filepath.Rel(v.providerDir, dir)
+	if err != nil {
+		return err
+	}
+	relDir = filepath.ToSlash(relDir)
+
 	mismatchOpt := &check.FileMismatchOptions{
-		ProviderShortName: providerShortName(v.providerName),
-		Schema:            v.providerSchema,
+		ProviderShortName:       providerShortName(v.providerName),
+		DatasourceIgnore:        ignoredNames["data-sources"],
+		ResourceIgnore:          ignoredNames["resources"],
+		EphemeralResourceIgnore: ignoredNames["ephemeral-resources"],
+		ListResourceIgnore:      ignoredNames["list-resources"],
+		FunctionIgnore:          ignoredNames["functions"],
+		DatasourceDir:           relDir + "/data-sources",
+		ResourceDir:             relDir + "/resources",
+		EphemeralResourceDir:    relDir + "/ephemeral-resources",
+		ListResourceDir:         relDir + "/list-resources",
+		FunctionDir:             relDir + "/functions",
+		Schema:                  v.providerSchema,
 	}
 
 	if dirExists(filepath.Join(dir, "data-sources")) {
@@ -257,6 +609,14 @@ func (v *validator) validateStaticDocs(dir string) error {
 		resourceFiles, _ := os.ReadDir(filepath.Join(dir, "resources"))
 		mismatchOpt.ResourceEntries = resourceFiles
 	}
+	if dirExists(filepath.Join(dir, "ephemeral-resources")) {
+		ephemeralResourceFiles, _ := os.ReadDir(filepath.Join(dir, "ephemeral-resources"))
+		mismatchOpt.EphemeralResourceEntries = ephemeralResourceFiles
+	}
+	if dirExists(filepath.Join(dir, "list-resources")) {
+		listResourceFiles, _ := os.ReadDir(filepath.Join(dir, "list-resources"))
+		mismatchOpt.ListResourceEntries = listResourceFiles
+	}
 	if dirExists(filepath.Join(dir, "functions")) {
 		functionFiles, _ := os.ReadDir(filepath.Join(dir, "functions"))
 		mismatchOpt.FunctionEntries = functionFiles
@@ -267,6 +627,9 @@ func (v *validator) validateStaticDocs(dir string) error {
 		result = errors.Join(result, err)
 	}
 
+	v.logger.infof("running schema attributes check")
+	result = errors.Join(result, v.validateSchemaAttributes(dir, ignoredNames, "data-sources", "resources"))
+
 	return result
 }
 
@@ -277,9 +640,12 @@ func (v *validator) validateLegacyWebsite(dir string) error {
 	options := &check.ProviderFileOptions{
 		FrontMatter:     LegacyFrontMatterOptions,
 		ValidExtensions: ValidLegacyFileExtensions,
+		MaxFileSize:     v.maxFileSize,
 	}
 
 	var files []string
+
+	linksCheck := check.NewLinksCheck(v.providerDir)
 	err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
 		if err != nil {
 			return fmt.Errorf("error walking directory %q: %w", dir, err)
@@ -313,14 +679,23 @@ func (v *validator) validateLegacyWebsite(dir string) error {
 
 		// Configure FrontMatterOptions based on file type
 		if d.Name() == "index.md" {
-			options.FrontMatter = LegacyIndexFrontMatterOptions
+			options.FrontMatter = v.frontMatterOptions(LegacyIndexFrontMatterOptions)
 		} else if _, relErr := filepath.Rel(rel, "guides"); relErr != nil {
-			options.FrontMatter = LegacyGuideFrontMatterOptions
+			options.FrontMatter = v.frontMatterOptions(LegacyGuideFrontMatterOptions)
 		} else {
-			options.FrontMatter = LegacyFrontMatterOptions
+			options.FrontMatter = v.frontMatterOptions(LegacyFrontMatterOptions)
 		}
 		v.logger.infof("running file checks on %s", rel)
 		result = errors.Join(result, check.NewProviderFileCheck(options).Run(path))
+		result = errors.Join(result, check.PathDepthCheck(rel, v.maxPathDepth))
+
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("%s: error reading file: %w", rel, err)
+		}
+
+		result = errors.Join(result, linksCheck.Run(filepath.ToSlash(rel), content))
+		result = errors.Join(result, check.NewRegistryMarkdownCheck().Run(filepath.ToSlash(rel), content))
 
 		files = append(files, path)
 		return nil
@@ -329,9 +704,31 @@ func (v *validator) validateLegacyWebsite(dir string) error {
 		return fmt.Errorf("error walking directory %q: %w", dir, err)
 	}
 
+	v.logger.infof("running file count check")
+	result = errors.Join(result, check.FileCountCheck(files, v.maxFiles))
+
+	ignoredNames := v.ignore.MatchingNames(v.providerSchema, nil)
+
+	relDir, err := filepath.Rel(v.providerDir, dir)
+	if err != nil {
+		return err
+	}
+	relDir = filepath.ToSlash(relDir)
+
 	mismatchOpt := &check.FileMismatchOptions{
-		ProviderShortName: providerShortName(v.providerName),
-		Schema:            v.providerSchema,
+		ProviderShortName:       providerShortName(v.providerName),
+		DatasourceIgnore:        ignoredNames["data-sources"],
+		ResourceIgnore:          ignoredNames["resources"],
+		EphemeralResourceIgnore: ignoredNames["ephemeral-resources"],
+		ListResourceIgnore:      ignoredNames["list-resources"],
+		FunctionIgnore:          ignoredNames["functions"],
+		DatasourceDir:           relDir + "/d",
+		ResourceDir:             relDir + "/r",
+		EphemeralResourceDir:    relDir + "/ephemeral-resources",
+		ListResourceDir:         relDir + "/list-resources",
+		FunctionDir:             relDir + "/functions",
+		FileExtension:           check.FileExtensionHtmlMarkdown,
+		Schema:                  v.providerSchema,
 	}
 
 	if dirExists(filepath.Join(dir, "d")) {
@@ -342,6 +739,14 @@ func (v *validator) validateLegacyWebsite(dir string) error {
 		resourceFiles, _ := os.ReadDir(filepath.Join(dir, "r"))
 		mismatchOpt.ResourceEntries = resourceFiles
 	}
+	if dirExists(filepath.Join(dir, "ephemeral-resources")) {
+		ephemeralResourceFiles, _ := os.ReadDir(filepath.Join(dir, "ephemeral-resources"))
+		mismatchOpt.EphemeralResourceEntries = ephemeralResourceFiles
+	}
+	if dirExists(filepath.Join(dir, "list-resources")) {
+		listResourceFiles, _ := os.ReadDir(filepath.Join(dir, "list-resources"))
+		mismatchOpt.ListResourceEntries = listResourceFiles
+	}
 	if dirExists(filepath.Join(dir, "functions")) {
 		functionFiles, _ := os.ReadDir(filepath.Join(dir, "functions"))
 		mismatchOpt.FunctionEntries = functionFiles
@@ -352,9 +757,167 @@ func (v *validator) validateLegacyWebsite(dir string) error {
 		result = errors.Join(result, err)
 	}
 
+	v.logger.infof("running schema attributes check")
+	result = errors.Join(result, v.validateSchemaAttributes(dir, ignoredNames, "d", "r"))
+
 	return result
 }
 
+// validateSchemaAttributes checks the attributes documented in the files of
+// the data source, resource, ephemeral resource, and list resource
+// subdirectories of dir against the schemas of the documented resources.
+// Files without a matching schema are reported by the file mismatch check and
+// skipped, as are ignored resources, by rendered website subdirectory.
+func (v *validator) validateSchemaAttributes(dir string, ignoredNames map[string][]string, dataSourceDir, resourceDir string) error {
+	if v.providerSchema == nil {
+		log.Printf("[DEBUG] Skipping schema attributes checks due to missing provider schema")
+		return nil
+	}
+
+	subDirs := []struct {
+		name    string
+		schemas map[string]*tfjson.Schema
+		ignore  []string
+	}{
+		{dataSourceDir, v.providerSchema.DataSourceSchemas, ignoredNames["data-sources"]},
+		{resourceDir, v.providerSchema.ResourceSchemas, ignoredNames["resources"]},
+		{"ephemeral-resources", v.providerSchema.EphemeralResourceSchemas, ignoredNames["ephemeral-resources"]},
+		{"list-resources", v.providerSchema.ListResourceSchemas, ignoredNames["list-resources"]},
+	}
+
+	var result error
+
+	shortName := providerShortName(v.providerName)
+
+	for _, subDir := range subDirs {
+		if !dirExists(filepath.Join(dir, subDir.name)) {
+			continue
+		}
+
+		entries, err := os.ReadDir(filepath.Join(dir, subDir.name))
+		if err != nil {
+			return fmt.Errorf("error reading directory %q: %w", subDir.name, err)
+		}
+
+		for _, entry := range entries {
+			if entry.IsDir() {
+				continue
+			}
+
+			name := check.FileResourceName(shortName, entry.Name())
+
+			schema, ok := subDir.schemas[name]
+			if !ok || slices.Contains(subDir.ignore, name) {
+				continue
+			}
+
+			var identitySchema *tfjson.IdentitySchema
+			if subDir.name == resourceDir {
+				identitySchema = v.providerSchema.ResourceIdentitySchemas[name]
+			}
+
+			path := filepath.Join(dir, subDir.name, entry.Name())
+
+			rel, err := filepath.Rel(v.providerDir, path)
+			if err != nil {
+				return err
+			}
+
+			content, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("%s: error reading file: %w", rel, err)
+			}
+
+			if err := check.NewSchemaAttributesCheck(schema, identitySchema).Run(filepath.ToSlash(rel), content); err != nil {
+				result = errors.Join(result, fmt.Errorf("%s: error checking schema attributes: %w", rel, err))
+			}
+		}
+	}
+
+	return result
+}
+
+// frontMatterOptions returns a copy of the built-in frontmatter options of a
+// file type with the additional frontmatter requirements.
+func (v *validator) frontMatterOptions(options *check.FrontMatterOptions) *check.FrontMatterOptions {
+	result := *options
+	result.RequireFields = v.frontMatter.RequireFields
+	result.NoFields = v.frontMatter.NoFields
+	result.FieldPatterns = v.frontMatter.FieldPatterns
+
+	return &result
+}
+
+// parseFrontMatterPatterns returns the regular expression of each frontmatter
+// key in the comma separated <key>=<pattern> values. As patterns can contain
+// commas, a value without = is part of the pattern of the previous value.
+func parseFrontMatterPatterns(value string) (map[string]*regexp.Regexp, error) {
+	var pairs []string
+
+	for _, part := range strings.Split(value, ",") {
+		if len(pairs) > 0 && !strings.Contains(part, "=") {
+			pairs[len(pairs)-1] += "," + part
+			continue
+		}
+
+		pairs = append(pairs, part)
+	}
+
+	patterns := make(map[string]*regexp.Regexp, len(pairs))
+
+	for _, pair := range pairs {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+
+		key, pattern, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid frontmatter pattern %q, expected <key>=<pattern>", pair)
+		}
+
+		key = strings.TrimSpace(key)
+
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid frontmatter pattern for %q: %w", key, err)
+		}
+
+		patterns[key] = re
+	}
+
+	return patterns, nil
+}
+
+// parseDescriptionStyle returns the description style rules of the uppercase,
+// period, max-length=<n>, and no-todo values.
+func parseDescriptionStyle(values []string) (check.DescriptionStyleOptions, error) {
+	var opts check.DescriptionStyleOptions
+
+	for _, value := range values {
+		name, arg, hasArg := strings.Cut(strings.TrimSpace(value), "=")
+
+		switch {
+		case name == "uppercase" && !hasArg:
+			opts.Uppercase = true
+		case name == "period" && !hasArg:
+			opts.Period = true
+		case name == "no-todo" && !hasArg:
+			opts.NoTODO = true
+		case name == "max-length" && hasArg:
+			maxLength, err := strconv.Atoi(arg)
+			if err != nil || maxLength < 1 {
+				return opts, fmt.Errorf("invalid description style %q, expected a positive maximum length", value)
+			}
+
+			opts.MaxLength = maxLength
+		default:
+			return opts, fmt.Errorf("unknown description style %q, expected one of: uppercase, period, max-length=<n>, no-todo", value)
+		}
+	}
+
+	return opts, nil
+}
+
 func dirExists(name string) bool {
 	if file, err := os.Stat(name); err != nil {
 		return false
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/check"
+)
+
+const (
+	// ValidateFormatText outputs validation progress and errors as text.
+	ValidateFormatText = "text"
+
+	// ValidateFormatJSON outputs validation findings as a JSON document.
+	ValidateFormatJSON = "json"
+
+	// ValidateFormatSARIF outputs validation findings as a SARIF 2.1.0 log.
+	ValidateFormatSARIF = "sarif"
+)
+
+// ValidateFormats are the supported validation output formats.
+var ValidateFormats = []string{
+	ValidateFormatText,
+	ValidateFormatJSON,
+	ValidateFormatSARIF,
+}
+
+const (
+	// ruleValidationError is the rule ID of validation errors which are not
+	// found by a check, such as errors reading a documentation directory.
+	ruleValidationError = "ValidationError"
+
+	// severityError is the severity of validation findings which fail
+	// validation.
+	severityError = "error"
+
+	// severityWarning is the severity of validation findings of check rules
+	// with the warn severity.
+	severityWarning = "warning"
+)
+
+// finding is a single validation error, in machine-readable output.
+type finding struct {
+	// File is the slash-separated path of the file or directory, relative to
+	// the provider directory, if known.
+	File string `json:"file,omitempty"`
+
+	// Line is the 1-based line number in File, if known.
+	Line int `json:"line,omitempty"`
+
+	Rule     string `json:"rule"`
+	Severity string `json:"severity"`
+	Message  string `json:"message"`
+}
+
+// validationFindings returns the findings of each error joined in err.
+func validationFindings(providerDir string, err error) []finding {
+	var findings []finding
+
+	for _, e := range flattenErrors(err) {
+		f := finding{
+			Rule:     ruleValidationError,
+			Severity: severityError,
+			Message:  e.Error(),
+		}
+
+		var ruleErr *check.RuleError
+		if errors.As(e, &ruleErr) {
+			f.Rule = ruleErr.Rule
+			f.File = findingFile(providerDir, ruleErr.File)
+			f.Line = ruleErr.Line
+		}
+
+		findings = append(findings, f)
+	}
+
+	return findings
+}
+
+// findingFile returns the slash-separated path of file relative to the
+// provider directory.
+func findingFile(providerDir, file string) string {
+	if file == "" {
+		return ""
+	}
+
+	if filepath.IsAbs(file) {
+		if rel, err := filepath.Rel(providerDir, file); err == nil {
+			file = rel
+		}
+	}
+
+	return filepath.ToSlash(file)
+}
+
+type findingsOutput struct {
+	Findings []finding `json:"findings"`
+}
+
+// renderFindingsJSON returns the findings as an indented JSON document.
+func renderFindingsJSON(findings []finding) (string, error) {
+	if findings == nil {
+		findings = []finding{}
+	}
+
+	b, err := json.MarshalIndent(findingsOutput{Findings: findings}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal JSON findings: %w", err)
+	}
+
+	return string(b), nil
+}
+
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations,omitempty"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// renderFindingsSARIF returns the findings as an indented SARIF 2.1.0 log,
+// with a rule for each distinct rule ID of the findings.
+func renderFindingsSARIF(findings []finding) (string, error) {
+	ruleIDs := make(map[string]bool)
+	results := make([]sarifResult, 0, len(findings))
+
+	for _, f := range findings {
+		ruleIDs[f.Rule] = true
+
+		result := sarifResult{
+			RuleID:  f.Rule,
+			Level:   f.Severity,
+			Message: sarifMessage{Text: f.Message},
+		}
+
+		if f.File != "" {
+			location := sarifLocation{
+				PhysicalLocation: sarifPhysicalLocation{
+					ArtifactLocation: sarifArtifactLocation{URI: f.File},
+				},
+			}
+
+			if f.Line > 0 {
+				location.PhysicalLocation.Region = &sarifRegion{StartLine: f.Line}
+			}
+
+			result.Locations = []sarifLocation{location}
+		}
+
+		results = append(results, result)
+	}
+
+	rules := make([]sarifRule, 0, len(ruleIDs))
+	for id := range ruleIDs {
+		rules = append(rules, sarifRule{ID: id})
+	}
+	sort.Slice(rules, func(i, j int) bool {
+		return rules[i].ID < rules[j].ID
+	})
+
+	b, err := json.MarshalIndent(sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs: []sarifRun{
+			{
+				Tool: sarifTool{
+					Driver: sarifDriver{
+						Name:           "tfplugindocs",
+						InformationURI: "https://github.com/hashicorp/terraform-plugin-docs",
+						Rules:          rules,
+					},
+				},
+				Results: results,
+			},
+		},
+	}, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal SARIF findings: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// validateFormat returns an error if the validation output format is not
+// supported.
+func validateFormat(format string) error {
+	for _, f := range ValidateFormats {
+		if format == f {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unsupported format %q, expected one of: %s", format, strings.Join(ValidateFormats, ", "))
+}
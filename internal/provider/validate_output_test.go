@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/check"
+)
+
+func Test_validationFindings(t *testing.T) {
+	t.Parallel()
+
+	providerDir := filepath.FromSlash("/provider")
+
+	err := errors.Join(
+		errors.New("error finding documentation files"),
+		errors.Join(
+			&check.RuleError{
+				Rule: check.RuleFrontMatter,
+				File: filepath.Join(providerDir, "docs", "index.md"),
+				Err:  errors.New("docs/index.md: error checking file frontmatter"),
+			},
+			&check.RuleError{
+				Rule: check.RuleSchemaAttributes,
+				File: "docs/resources/example.md",
+				Line: 12,
+				Err:  errors.New("docs/resources/example.md:12: documented attribute not found in schema: removed"),
+			},
+		),
+	)
+
+	expected := []finding{
+		{
+			Rule:     ruleValidationError,
+			Severity: severityError,
+			Message:  "error finding documentation files",
+		},
+		{
+			File:     "docs/index.md",
+			Rule:     check.RuleFrontMatter,
+			Severity: severityError,
+			Message:  "docs/index.md: error checking file frontmatter",
+		},
+		{
+			File:     "docs/resources/example.md",
+			Line:     12,
+			Rule:     check.RuleSchemaAttributes,
+			Severity: severityError,
+			Message:  "docs/resources/example.md:12: documented attribute not found in schema: removed",
+		},
+	}
+
+	actual := validationFindings(providerDir, err)
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func Test_renderFindingsSARIF(t *testing.T) {
+	t.Parallel()
+
+	findings := []finding{
+		{
+			Rule:     check.RuleMixedDirectories,
+			Severity: severityError,
+			Message:  "mixed directories",
+		},
+		{
+			File:     "docs/resources/example.md",
+			Line:     12,
+			Rule:     check.RuleSchemaAttributes,
+			Severity: severityError,
+			Message:  "removed",
+		},
+	}
+
+	expected := `{
+  "$schema": "https://json.schemastore.org/sarif-2.1.0.json",
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {
+        "driver": {
+          "name": "tfplugindocs",
+          "informationUri": "https://github.com/hashicorp/terraform-plugin-docs",
+          "rules": [
+            {
+              "id": "MixedDirectoriesCheck"
+            },
+            {
+              "id": "SchemaAttributesCheck"
+            }
+          ]
+        }
+      },
+      "results": [
+        {
+          "ruleId": "MixedDirectoriesCheck",
+          "level": "error",
+          "message": {
+            "text": "mixed directories"
+          }
+        },
+        {
+          "ruleId": "SchemaAttributesCheck",
+          "level": "error",
+          "message": {
+            "text": "removed"
+          },
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {
+                  "uri": "docs/resources/example.md"
+                },
+                "region": {
+                  "startLine": 12
+                }
+              }
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`
+
+	actual, err := renderFindingsSARIF(findings)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
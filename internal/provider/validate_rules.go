@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/check"
+)
+
+const (
+	// RuleSeverityError reports errors of a check rule as validation errors,
+	// which is the default for every rule.
+	RuleSeverityError = "error"
+
+	// RuleSeverityWarn reports errors of a check rule as warnings, which do
+	// not fail validation.
+	RuleSeverityWarn = "warn"
+
+	// RuleSeverityOff disables a check rule.
+	RuleSeverityOff = "off"
+)
+
+// RuleSeverities are the supported severities of check rules.
+var RuleSeverities = []string{
+	RuleSeverityError,
+	RuleSeverityWarn,
+	RuleSeverityOff,
+}
+
+// ruleDisableComment matches comments in documentation files which disable
+// check rules for the whole file, such as:
+//
+//	<!-- tfplugindocs:disable SchemaAttributesCheck,FileSizeCheck -->
+//
+// The MDX comment syntax is also matched.
+var ruleDisableComment = regexp.MustCompile(`(?:<!--|\{/\*)\s*tfplugindocs:disable\s+([\w,\s]+?)\s*(?:-->|\*/\})`)
+
+// parseRuleSeverities returns the severity of each rule in the list of
+// <rule>=<severity> values.
+func parseRuleSeverities(values []string) (map[string]string, error) {
+	severities := make(map[string]string, len(values))
+
+	for _, value := range values {
+		rule, severity, ok := strings.Cut(value, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid rule severity %q, expected <rule>=<severity>", value)
+		}
+
+		rule = strings.TrimSpace(rule)
+		severity = strings.TrimSpace(severity)
+
+		if !slices.Contains(check.Rules, rule) {
+			return nil, fmt.Errorf("unknown rule %q, expected one of: %s", rule, strings.Join(check.Rules, ", "))
+		}
+
+		if !slices.Contains(RuleSeverities, severity) {
+			return nil, fmt.Errorf("unsupported severity %q for rule %q, expected one of: %s", severity, rule, strings.Join(RuleSeverities, ", "))
+		}
+
+		severities[rule] = severity
+	}
+
+	return severities, nil
+}
+
+// applyRuleSeverities returns the errors joined in err which are reported as
+// warnings, and those which fail validation, by the severity of their check
+// rule. Errors of disabled rules, or of rules disabled by a
+// comment in the file with the error, are dropped. Errors which are not found
+// by a check always fail validation.
+func (v *validator) applyRuleSeverities(err error) ([]error, error) {
+	var errs, warnings []error
+
+	disabledRules := make(map[string][]string)
+
+	for _, e := range flattenErrors(err) {
+		var ruleErr *check.RuleError
+		if !errors.As(e, &ruleErr) {
+			errs = append(errs, e)
+			continue
+		}
+
+		if ruleErr.File != "" {
+			path := ruleErr.File
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(v.providerDir, path)
+			}
+
+			if _, ok := disabledRules[path]; !ok {
+				disabledRules[path] = fileDisabledRules(path)
+			}
+
+			if slices.Contains(disabledRules[path], ruleErr.Rule) {
+				continue
+			}
+		}
+
+		switch v.ruleSeverities[ruleErr.Rule] {
+		case RuleSeverityOff:
+			continue
+		case RuleSeverityWarn:
+			warnings = append(warnings, e)
+		default:
+			errs = append(errs, e)
+		}
+	}
+
+	return warnings, errors.Join(errs...)
+}
+
+// fileDisabledRules returns the rules disabled by comments in the file. Files
+// which cannot be read, such as directories or missing files, disable no
+// rules.
+func fileDisabledRules(path string) []string {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+
+	var rules []string
+
+	for _, match := range ruleDisableComment.FindAllSubmatch(content, -1) {
+		for _, rule := range strings.Split(string(match[1]), ",") {
+			if rule = strings.TrimSpace(rule); rule != "" {
+				rules = append(rules, rule)
+			}
+		}
+	}
+
+	return rules
+}
+
+// flattenErrors returns each error joined in err, recursively.
+func flattenErrors(err error) []error {
+	if err == nil {
+		return nil
+	}
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []error{err}
+	}
+
+	var errs []error
+	for _, e := range joined.Unwrap() {
+		errs = append(errs, flattenErrors(e)...)
+	}
+
+	return errs
+}
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/check"
+)
+
+func Test_parseRuleSeverities(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		values        []string
+		expected      map[string]string
+		expectedError string
+	}{
+		"empty": {
+			expected: map[string]string{},
+		},
+		"severities": {
+			values: []string{"SchemaAttributesCheck=warn", " FileSizeCheck = off "},
+			expected: map[string]string{
+				check.RuleSchemaAttributes: RuleSeverityWarn,
+				check.RuleFileSize:         RuleSeverityOff,
+			},
+		},
+		"missing severity": {
+			values:        []string{"SchemaAttributesCheck"},
+			expectedError: `invalid rule severity "SchemaAttributesCheck", expected <rule>=<severity>`,
+		},
+		"unknown rule": {
+			values:        []string{"UnknownCheck=warn"},
+			expectedError: `unknown rule "UnknownCheck", expected one of: DescriptionStyleCheck, ExampleFormatCheck, FileCountCheck, FileExtensionCheck, FileMismatchCheck, FileSizeCheck, FrontMatterCheck, InvalidDirectoriesCheck, LinksCheck, MixedDirectoriesCheck, PathDepthCheck, RegistryMarkdownCheck, SchemaAttributesCheck`,
+		},
+		"unsupported severity": {
+			values:        []string{"SchemaAttributesCheck=info"},
+			expectedError: `unsupported severity "info" for rule "SchemaAttributesCheck", expected one of: error, warn, off`,
+		},
+	}
+
+	for name, c := range cases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := parseRuleSeverities(c.values)
+
+			if c.expectedError != "" {
+				if err == nil || err.Error() != c.expectedError {
+					t.Fatalf("expected error %q, got: %v", c.expectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_applyRuleSeverities(t *testing.T) {
+	t.Parallel()
+
+	providerDir := t.TempDir()
+
+	err := os.WriteFile(filepath.Join(providerDir, "disabled.md"), []byte("# Example\n\n<!-- tfplugindocs:disable FrontMatterCheck, SchemaAttributesCheck -->\n"), 0644)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	v := &validator{
+		providerDir: providerDir,
+		ruleSeverities: map[string]string{
+			check.RuleFileSize:         RuleSeverityOff,
+			check.RuleSchemaAttributes: RuleSeverityWarn,
+		},
+	}
+
+	validationErr := errors.New("error finding documentation files")
+	frontMatterErr := &check.RuleError{Rule: check.RuleFrontMatter, File: "enabled.md", Err: errors.New("frontmatter")}
+	schemaAttributesErr := &check.RuleError{Rule: check.RuleSchemaAttributes, File: "enabled.md", Err: errors.New("schema attributes")}
+
+	warnings, err := v.applyRuleSeverities(errors.Join(
+		validationErr,
+		errors.Join(
+			frontMatterErr,
+			&check.RuleError{Rule: check.RuleFrontMatter, File: "disabled.md", Err: errors.New("disabled frontmatter")},
+			&check.RuleError{Rule: check.RuleFileSize, File: "enabled.md", Err: errors.New("file size")},
+		),
+		schemaAttributesErr,
+		&check.RuleError{Rule: check.RuleSchemaAttributes, File: filepath.Join(providerDir, "disabled.md"), Err: errors.New("disabled schema attributes")},
+	))
+
+	if diff := cmp.Diff([]error{schemaAttributesErr}, warnings, cmp.Comparer(func(x, y error) bool { return x == y })); diff != "" {
+		t.Errorf("unexpected warnings difference: %s", diff)
+	}
+
+	if diff := cmp.Diff([]error{validationErr, frontMatterErr}, flattenErrors(err), cmp.Comparer(func(x, y error) bool { return x == y })); diff != "" {
+		t.Errorf("unexpected errors difference: %s", diff)
+	}
+}
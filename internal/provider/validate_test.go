@@ -8,7 +8,10 @@ import (
 	"testing"
 
 	"github.com/bmatcuk/doublestar/v4"
+	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/check"
 )
 
 func TestValidateStaticDocs(t *testing.T) {
@@ -166,3 +169,121 @@ func TestDocumentationDirGlobPattern(t *testing.T) {
 		})
 	}
 }
+
+func TestParseFrontMatterPatterns(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		Value         string
+		Expected      map[string]string
+		ExpectedError string
+	}{
+		"empty": {
+			Expected: map[string]string{},
+		},
+		"patterns": {
+			Value: "subcategory=^(Compute|Storage)$, page_title=^[A-Z]",
+			Expected: map[string]string{
+				"page_title":  "^[A-Z]",
+				"subcategory": "^(Compute|Storage)$",
+			},
+		},
+		"pattern with commas": {
+			Value: "description=^.{1,120}$,subcategory=^(A|B)$",
+			Expected: map[string]string{
+				"description": "^.{1,120}$",
+				"subcategory": "^(A|B)$",
+			},
+		},
+		"missing pattern": {
+			Value:         "subcategory",
+			ExpectedError: `invalid frontmatter pattern "subcategory", expected <key>=<pattern>`,
+		},
+		"invalid pattern": {
+			Value:         "subcategory=(",
+			ExpectedError: "invalid frontmatter pattern for \"subcategory\": error parsing regexp: missing closing ): `(`",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseFrontMatterPatterns(testCase.Value)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || err.Error() != testCase.ExpectedError {
+					t.Fatalf("expected error: %s, got error: %v", testCase.ExpectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			actual := make(map[string]string, len(got))
+			for key, pattern := range got {
+				actual[key] = pattern.String()
+			}
+
+			if diff := cmp.Diff(testCase.Expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestParseDescriptionStyle(t *testing.T) {
+	t.Parallel()
+	testCases := map[string]struct {
+		Values        []string
+		Expected      check.DescriptionStyleOptions
+		ExpectedError string
+	}{
+		"empty": {},
+		"all rules": {
+			Values: []string{"uppercase", " period", "max-length=300", "no-todo"},
+			Expected: check.DescriptionStyleOptions{
+				Uppercase: true,
+				Period:    true,
+				MaxLength: 300,
+				NoTODO:    true,
+			},
+		},
+		"invalid max length": {
+			Values:        []string{"max-length=0"},
+			ExpectedError: `invalid description style "max-length=0", expected a positive maximum length`,
+		},
+		"unknown rule": {
+			Values:        []string{"lowercase"},
+			ExpectedError: `unknown description style "lowercase", expected one of: uppercase, period, max-length=<n>, no-todo`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := parseDescriptionStyle(testCase.Values)
+
+			if testCase.ExpectedError != "" {
+				if err == nil || err.Error() != testCase.ExpectedError {
+					t.Fatalf("expected error: %s, got error: %v", testCase.ExpectedError, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.Expected, got); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
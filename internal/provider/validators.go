@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+// attributeValidators are the validators of attributes.
+type attributeValidators = attributeMetadata[[]schemamd.Validator]
+
+// validatorJSON is the JSON format of a validator, such as
+// {"type": "one_of", "values": ["a", "b"]}, {"type": "between", "min": 1,
+// "max": 10}, or {"type": "regex", "pattern": "^[a-z]+$"}. The types are the
+// values of schemamd.ValidatorTypes.
+type validatorJSON struct {
+	Type    string            `json:"type"`
+	Values  []json.RawMessage `json:"values,omitempty"`
+	Min     json.RawMessage   `json:"min,omitempty"`
+	Max     json.RawMessage   `json:"max,omitempty"`
+	Pattern string            `json:"pattern,omitempty"`
+	Message string            `json:"message,omitempty"`
+}
+
+// validators returns the schemamd validators of the JSON validators, or an
+// error if any of them is invalid.
+func validators(validatorsJSON []validatorJSON) ([]schemamd.Validator, error) {
+	result := make([]schemamd.Validator, 0, len(validatorsJSON))
+
+	for _, vj := range validatorsJSON {
+		v := schemamd.Validator{
+			Type:    vj.Type,
+			Pattern: vj.Pattern,
+			Message: vj.Message,
+		}
+
+		for _, raw := range vj.Values {
+			value, _, err := renderJSONValue(raw)
+			if err != nil {
+				return nil, err
+			}
+
+			v.Values = append(v.Values, value)
+		}
+
+		var err error
+
+		v.Min, _, err = renderJSONValue(vj.Min)
+		if err != nil {
+			return nil, err
+		}
+
+		v.Max, _, err = renderJSONValue(vj.Max)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, v)
+	}
+
+	err := schemamd.ValidateValidators(result)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// extractAttributeValidatorsByAddress returns the validators of the
+// attributes of the first of the given provider addresses in the providers
+// schema JSON. No error is returned if the schema has no validators.
+func extractAttributeValidatorsByAddress(schemajson []byte, addresses ...string) (attributeValidators, error) {
+	result := make(attributeValidators)
+
+	err := walkSchemaExtensions(schemajson, func(dir, name string, path []string, att *schemaExtensionsAttribute) error {
+		if len(att.Validators) == 0 {
+			return nil
+		}
+
+		v, err := validators(att.Validators)
+		if err != nil {
+			return fmt.Errorf("invalid validators of %q: %w", strings.Join(path, "."), err)
+		}
+
+		result.set(dir, name, strings.Join(path, "."), v)
+
+		return nil
+	}, addresses...)
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// loadAttributeValidators sets the validators of the attribute validators
+// file at path into attributeValidators, over the validators of the
+// providers schema JSON. The file is an attribute metadata file of validator
+// lists, for example:
+//
+//	{
+//	  "resources": {
+//	    "scaffolding_example": {
+//	      "mode": [{"type": "one_of", "values": ["fast", "slow"]}],
+//	      "retries": [{"type": "between", "min": 1, "max": 10}]
+//	    }
+//	  }
+//	}
+func loadAttributeValidators(path string, attributeValidators attributeValidators) error {
+	values, err := readAttributeMetadataFile[[]validatorJSON](path, "attribute validators")
+	if err != nil {
+		return err
+	}
+
+	for _, dir := range sortedKeys(values) {
+		for _, name := range sortedKeys(values[dir]) {
+			for _, attPath := range sortedKeys(values[dir][name]) {
+				v, err := validators(values[dir][name][attPath])
+				if err != nil {
+					return fmt.Errorf("invalid validators of %q in attribute validators file %q: %w", attPath, path, err)
+				}
+
+				attributeValidators.set(dir, name, attPath, v)
+			}
+		}
+	}
+
+	return nil
+}
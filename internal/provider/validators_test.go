@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+func TestExtractAttributeValidatorsByAddress(t *testing.T) {
+	t.Parallel()
+
+	schemajson := []byte(`{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/scaffolding": {
+      "resource_schemas": {
+        "scaffolding_example": {
+          "version": 0,
+          "block": {
+            "attributes": {
+              "id": {"type": "string", "computed": true},
+              "mode": {"type": "string", "optional": true, "validators": [{"type": "one_of", "values": ["fast", "slow"]}]},
+              "settings": {
+                "nested_type": {
+                  "nesting_mode": "single",
+                  "attributes": {"retries": {"type": "number", "optional": true, "validators": [{"type": "between", "min": 1, "max": 10}]}}
+                },
+                "optional": true
+              }
+            },
+            "block_types": {
+              "timeouts": {
+                "nesting_mode": "single",
+                "block": {"attributes": {"create": {"type": "string", "optional": true, "validators": [{"type": "regex", "pattern": "^[0-9]+m$", "message": "a number of minutes"}]}}}
+              }
+            }
+          }
+        }
+      }
+    }
+  }
+}`)
+
+	actual, err := extractAttributeValidatorsByAddress(schemajson, "scaffolding", "registry.terraform.io/hashicorp/scaffolding")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := attributeValidators{
+		"resources": {
+			"scaffolding_example": {
+				"mode":             {{Type: schemamd.ValidatorOneOf, Values: []string{"fast", "slow"}}},
+				"settings.retries": {{Type: schemamd.ValidatorBetween, Min: "1", Max: "10"}},
+				"timeouts.create":  {{Type: schemamd.ValidatorRegex, Pattern: "^[0-9]+m$", Message: "a number of minutes"}},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(expected, actual); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestLoadAttributeValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		file          string
+		expected      attributeValidators
+		expectedError string
+	}{
+		"overrides": {
+			file: `{
+  "resources": {"scaffolding_example": {"mode": [{"type": "none_of", "values": ["slow"]}]}},
+  "data-sources": {"scaffolding_example": {"name": [{"type": "length_at_most", "max": 64}]}}
+}`,
+			expected: attributeValidators{
+				"resources": {
+					"scaffolding_example": {
+						"mode":    {{Type: schemamd.ValidatorNoneOf, Values: []string{"slow"}}},
+						"retries": {{Type: schemamd.ValidatorAtLeast, Min: "1"}},
+					},
+				},
+				"data-sources": {
+					"scaffolding_example": {
+						"name": {{Type: schemamd.ValidatorLengthAtMost, Max: "64"}},
+					},
+				},
+			},
+		},
+		"invalid validator": {
+			file:          `{"resources": {"scaffolding_example": {"mode": [{"type": "one_of"}]}}}`,
+			expectedError: `invalid validators of "mode" in attribute validators file`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), "validators.json")
+			err := os.WriteFile(path, []byte(testCase.file), 0644)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			validators := attributeValidators{
+				"resources": {
+					"scaffolding_example": {
+						"mode":    {{Type: schemamd.ValidatorOneOf, Values: []string{"fast", "slow"}}},
+						"retries": {{Type: schemamd.ValidatorAtLeast, Min: "1"}},
+					},
+				},
+			}
+
+			err = loadAttributeValidators(path, validators)
+
+			if testCase.expectedError != "" {
+				if err == nil {
+					t.Fatalf("expected error containing %q, got none", testCase.expectedError)
+				}
+
+				if !strings.Contains(err.Error(), testCase.expectedError) {
+					t.Fatalf("expected error containing %q, got: %s", testCase.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, validators); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
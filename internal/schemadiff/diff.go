@@ -0,0 +1,559 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemadiff
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+// Kind describes whether an item was added, removed, or changed between two
+// provider schemas.
+type Kind string
+
+const (
+	KindAdded   Kind = "added"
+	KindRemoved Kind = "removed"
+	KindChanged Kind = "changed"
+)
+
+// Report contains the differences between two provider schemas.
+type Report struct {
+	// Provider contains the changes to the provider configuration schema.
+	Provider []AttributeChange `json:"provider,omitempty"`
+
+	Resources   []Change `json:"resources,omitempty"`
+	DataSources []Change `json:"data_sources,omitempty"`
+	Functions   []Change `json:"functions,omitempty"`
+}
+
+// Empty returns true if the report does not contain any changes.
+func (r *Report) Empty() bool {
+	return len(r.Provider) == 0 && len(r.Resources) == 0 && len(r.DataSources) == 0 && len(r.Functions) == 0
+}
+
+// Change describes an added, removed, or changed resource, data source, or
+// function.
+type Change struct {
+	Name string `json:"name"`
+	Kind Kind   `json:"kind"`
+
+	// Details contains human readable descriptions of changes to the
+	// resource, data source, or function itself, such as a deprecation or
+	// a function signature change.
+	Details []string `json:"details,omitempty"`
+
+	// Attributes contains the changes to attributes and blocks. Only set
+	// for changed resources and data sources.
+	Attributes []AttributeChange `json:"attributes,omitempty"`
+}
+
+// AttributeChange describes an added, removed, or changed attribute or block.
+type AttributeChange struct {
+	// Path is the dot separated path of the attribute or block, e.g.
+	// "timeouts.create".
+	Path string `json:"path"`
+	Kind Kind   `json:"kind"`
+
+	// Details contains human readable descriptions of the changes. For
+	// added attributes and blocks, it describes the new attribute or block.
+	Details []string `json:"details,omitempty"`
+}
+
+// Diff compares the old and new provider schemas and returns a report of the
+// differences. Items in the report are sorted by name and path.
+func Diff(oldSchema, newSchema *tfjson.ProviderSchema) (*Report, error) {
+	if oldSchema == nil {
+		oldSchema = &tfjson.ProviderSchema{}
+	}
+	if newSchema == nil {
+		newSchema = &tfjson.ProviderSchema{}
+	}
+
+	report := &Report{}
+
+	var err error
+
+	report.Provider, err = diffSchema("", oldSchema.ConfigSchema, newSchema.ConfigSchema)
+	if err != nil {
+		return nil, fmt.Errorf("unable to compare provider schema: %w", err)
+	}
+
+	report.Resources, err = diffSchemas(oldSchema.ResourceSchemas, newSchema.ResourceSchemas)
+	if err != nil {
+		return nil, err
+	}
+
+	report.DataSources, err = diffSchemas(oldSchema.DataSourceSchemas, newSchema.DataSourceSchemas)
+	if err != nil {
+		return nil, err
+	}
+
+	report.Functions, err = diffFunctions(oldSchema.Functions, newSchema.Functions)
+	if err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func diffSchemas(oldSchemas, newSchemas map[string]*tfjson.Schema) ([]Change, error) {
+	var changes []Change
+
+	for _, name := range sortedKeys(oldSchemas, newSchemas) {
+		oldSchema, inOld := oldSchemas[name]
+		newSchema, inNew := newSchemas[name]
+
+		switch {
+		case !inOld:
+			changes = append(changes, Change{Name: name, Kind: KindAdded})
+		case !inNew:
+			changes = append(changes, Change{Name: name, Kind: KindRemoved})
+		default:
+			var details []string
+
+			oldDeprecated := oldSchema != nil && oldSchema.Block != nil && oldSchema.Block.Deprecated
+			newDeprecated := newSchema != nil && newSchema.Block != nil && newSchema.Block.Deprecated
+			details = appendFlagChange(details, "deprecated", oldDeprecated, newDeprecated)
+
+			if oldSchema != nil && newSchema != nil && oldSchema.Version != newSchema.Version {
+				details = append(details, fmt.Sprintf("schema version changed from %d to %d", oldSchema.Version, newSchema.Version))
+			}
+
+			attributes, err := diffSchema("", oldSchema, newSchema)
+			if err != nil {
+				return nil, fmt.Errorf("unable to compare schema for %q: %w", name, err)
+			}
+
+			if len(details) == 0 && len(attributes) == 0 {
+				continue
+			}
+
+			changes = append(changes, Change{
+				Name:       name,
+				Kind:       KindChanged,
+				Details:    details,
+				Attributes: attributes,
+			})
+		}
+	}
+
+	return changes, nil
+}
+
+func diffSchema(path string, oldSchema, newSchema *tfjson.Schema) ([]AttributeChange, error) {
+	var oldBlock, newBlock *tfjson.SchemaBlock
+
+	if oldSchema != nil {
+		oldBlock = oldSchema.Block
+	}
+	if newSchema != nil {
+		newBlock = newSchema.Block
+	}
+
+	return diffBlock(path, oldBlock, newBlock)
+}
+
+func diffBlock(path string, oldBlock, newBlock *tfjson.SchemaBlock) ([]AttributeChange, error) {
+	if oldBlock == nil {
+		oldBlock = &tfjson.SchemaBlock{}
+	}
+	if newBlock == nil {
+		newBlock = &tfjson.SchemaBlock{}
+	}
+
+	changes, err := diffAttributes(path, oldBlock.Attributes, newBlock.Attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range sortedKeys(oldBlock.NestedBlocks, newBlock.NestedBlocks) {
+		oldNested, inOld := oldBlock.NestedBlocks[name]
+		newNested, inNew := newBlock.NestedBlocks[name]
+		childPath := joinPath(path, name)
+
+		switch {
+		case !inOld:
+			changes = append(changes, AttributeChange{
+				Path:    childPath,
+				Kind:    KindAdded,
+				Details: []string{describeBlockType(newNested)},
+			})
+			continue
+		case !inNew:
+			changes = append(changes, AttributeChange{Path: childPath, Kind: KindRemoved})
+			continue
+		}
+
+		var details []string
+
+		if oldNested.NestingMode != newNested.NestingMode {
+			details = append(details, fmt.Sprintf("nesting mode changed from %s to %s", oldNested.NestingMode, newNested.NestingMode))
+		}
+		if oldNested.MinItems != newNested.MinItems {
+			details = append(details, fmt.Sprintf("minimum items changed from %d to %d", oldNested.MinItems, newNested.MinItems))
+		}
+		if oldNested.MaxItems != newNested.MaxItems {
+			details = append(details, fmt.Sprintf("maximum items changed from %d to %d", oldNested.MaxItems, newNested.MaxItems))
+		}
+
+		oldDeprecated := oldNested.Block != nil && oldNested.Block.Deprecated
+		newDeprecated := newNested.Block != nil && newNested.Block.Deprecated
+		details = appendFlagChange(details, "deprecated", oldDeprecated, newDeprecated)
+
+		if len(details) > 0 {
+			changes = append(changes, AttributeChange{Path: childPath, Kind: KindChanged, Details: details})
+		}
+
+		nestedChanges, err := diffBlock(childPath, oldNested.Block, newNested.Block)
+		if err != nil {
+			return nil, err
+		}
+
+		changes = append(changes, nestedChanges...)
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+
+	return changes, nil
+}
+
+func diffAttributes(path string, oldAttributes, newAttributes map[string]*tfjson.SchemaAttribute) ([]AttributeChange, error) {
+	var changes []AttributeChange
+
+	for _, name := range sortedKeys(oldAttributes, newAttributes) {
+		oldAttribute, inOld := oldAttributes[name]
+		newAttribute, inNew := newAttributes[name]
+		childPath := joinPath(path, name)
+
+		switch {
+		case !inOld:
+			description, err := describeAttribute(newAttribute)
+			if err != nil {
+				return nil, fmt.Errorf("unable to describe attribute %q: %w", childPath, err)
+			}
+
+			changes = append(changes, AttributeChange{
+				Path:    childPath,
+				Kind:    KindAdded,
+				Details: []string{description},
+			})
+			continue
+		case !inNew:
+			changes = append(changes, AttributeChange{Path: childPath, Kind: KindRemoved})
+			continue
+		}
+
+		var details []string
+
+		oldType, err := attributeTypeString(oldAttribute)
+		if err != nil {
+			return nil, fmt.Errorf("unable to describe attribute %q: %w", childPath, err)
+		}
+
+		newType, err := attributeTypeString(newAttribute)
+		if err != nil {
+			return nil, fmt.Errorf("unable to describe attribute %q: %w", childPath, err)
+		}
+
+		if oldType != newType {
+			details = append(details, fmt.Sprintf("type changed from %s to %s", oldType, newType))
+		}
+
+		oldBehavior := attributeBehavior(oldAttribute)
+		newBehavior := attributeBehavior(newAttribute)
+		if oldBehavior != newBehavior {
+			details = append(details, fmt.Sprintf("changed from %s to %s", oldBehavior, newBehavior))
+		}
+
+		details = appendFlagChange(details, "sensitive", oldAttribute.Sensitive, newAttribute.Sensitive)
+		details = appendFlagChange(details, "deprecated", oldAttribute.Deprecated, newAttribute.Deprecated)
+
+		if len(details) > 0 {
+			changes = append(changes, AttributeChange{Path: childPath, Kind: KindChanged, Details: details})
+		}
+
+		if oldAttribute.AttributeNestedType != nil && newAttribute.AttributeNestedType != nil {
+			nestedChanges, err := diffAttributes(childPath, oldAttribute.AttributeNestedType.Attributes, newAttribute.AttributeNestedType.Attributes)
+			if err != nil {
+				return nil, err
+			}
+
+			changes = append(changes, nestedChanges...)
+		}
+	}
+
+	return changes, nil
+}
+
+func diffFunctions(oldFunctions, newFunctions map[string]*tfjson.FunctionSignature) ([]Change, error) {
+	var changes []Change
+
+	for _, name := range sortedKeys(oldFunctions, newFunctions) {
+		oldFunction, inOld := oldFunctions[name]
+		newFunction, inNew := newFunctions[name]
+
+		switch {
+		case !inOld:
+			changes = append(changes, Change{Name: name, Kind: KindAdded})
+			continue
+		case !inNew:
+			changes = append(changes, Change{Name: name, Kind: KindRemoved})
+			continue
+		}
+
+		details, err := diffFunctionSignature(oldFunction, newFunction)
+		if err != nil {
+			return nil, fmt.Errorf("unable to compare function %q: %w", name, err)
+		}
+
+		if len(details) == 0 {
+			continue
+		}
+
+		changes = append(changes, Change{
+			Name:    name,
+			Kind:    KindChanged,
+			Details: details,
+		})
+	}
+
+	return changes, nil
+}
+
+func diffFunctionSignature(oldFunction, newFunction *tfjson.FunctionSignature) ([]string, error) {
+	if oldFunction == nil {
+		oldFunction = &tfjson.FunctionSignature{}
+	}
+	if newFunction == nil {
+		newFunction = &tfjson.FunctionSignature{}
+	}
+
+	var details []string
+
+	oldParameters := oldFunction.Parameters
+	newParameters := newFunction.Parameters
+
+	for i := 0; i < len(oldParameters) || i < len(newParameters); i++ {
+		switch {
+		case i >= len(oldParameters):
+			description, err := describeParameter(newParameters[i])
+			if err != nil {
+				return nil, err
+			}
+
+			details = append(details, fmt.Sprintf("parameter %d `%s` added (%s)", i+1, newParameters[i].Name, description))
+		case i >= len(newParameters):
+			details = append(details, fmt.Sprintf("parameter %d `%s` removed", i+1, oldParameters[i].Name))
+		default:
+			parameterDetails, err := diffParameter(oldParameters[i], newParameters[i])
+			if err != nil {
+				return nil, err
+			}
+
+			for _, detail := range parameterDetails {
+				details = append(details, fmt.Sprintf("parameter %d `%s` %s", i+1, newParameters[i].Name, detail))
+			}
+		}
+	}
+
+	switch {
+	case oldFunction.VariadicParameter == nil && newFunction.VariadicParameter != nil:
+		description, err := describeParameter(newFunction.VariadicParameter)
+		if err != nil {
+			return nil, err
+		}
+
+		details = append(details, fmt.Sprintf("variadic parameter `%s` added (%s)", newFunction.VariadicParameter.Name, description))
+	case oldFunction.VariadicParameter != nil && newFunction.VariadicParameter == nil:
+		details = append(details, fmt.Sprintf("variadic parameter `%s` removed", oldFunction.VariadicParameter.Name))
+	case oldFunction.VariadicParameter != nil && newFunction.VariadicParameter != nil:
+		parameterDetails, err := diffParameter(oldFunction.VariadicParameter, newFunction.VariadicParameter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, detail := range parameterDetails {
+			details = append(details, fmt.Sprintf("variadic parameter `%s` %s", newFunction.VariadicParameter.Name, detail))
+		}
+	}
+
+	oldReturnType, err := typeString(oldFunction.ReturnType)
+	if err != nil {
+		return nil, err
+	}
+
+	newReturnType, err := typeString(newFunction.ReturnType)
+	if err != nil {
+		return nil, err
+	}
+
+	if oldReturnType != newReturnType {
+		details = append(details, fmt.Sprintf("return type changed from %s to %s", oldReturnType, newReturnType))
+	}
+
+	details = appendFlagChange(details, "deprecated", oldFunction.DeprecationMessage != "", newFunction.DeprecationMessage != "")
+
+	return details, nil
+}
+
+func diffParameter(oldParameter, newParameter *tfjson.FunctionParameter) ([]string, error) {
+	var details []string
+
+	if oldParameter.Name != newParameter.Name {
+		details = append(details, fmt.Sprintf("renamed from `%s`", oldParameter.Name))
+	}
+
+	oldType, err := typeString(oldParameter.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	newType, err := typeString(newParameter.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if oldType != newType {
+		details = append(details, fmt.Sprintf("type changed from %s to %s", oldType, newType))
+	}
+
+	details = appendFlagChange(details, "nullable", oldParameter.IsNullable, newParameter.IsNullable)
+
+	return details, nil
+}
+
+// appendFlagChange appends a "now <flag>" or "no longer <flag>" detail if the
+// flag changed between the old and new schema.
+func appendFlagChange(details []string, flag string, oldValue, newValue bool) []string {
+	switch {
+	case !oldValue && newValue:
+		return append(details, "now "+flag)
+	case oldValue && !newValue:
+		return append(details, "no longer "+flag)
+	}
+
+	return details
+}
+
+func describeAttribute(attribute *tfjson.SchemaAttribute) (string, error) {
+	ty, err := attributeTypeString(attribute)
+	if err != nil {
+		return "", err
+	}
+
+	parts := []string{attributeBehavior(attribute), ty}
+
+	if attribute.Sensitive {
+		parts = append(parts, "sensitive")
+	}
+	if attribute.Deprecated {
+		parts = append(parts, "deprecated")
+	}
+
+	return strings.Join(parts, ", "), nil
+}
+
+func describeBlockType(blockType *tfjson.SchemaBlockType) string {
+	description := fmt.Sprintf("block, %s", blockType.NestingMode)
+
+	switch {
+	case blockType.MinItems > 0 && blockType.MaxItems > 0:
+		description += fmt.Sprintf(", min %d, max %d", blockType.MinItems, blockType.MaxItems)
+	case blockType.MinItems > 0:
+		description += fmt.Sprintf(", min %d", blockType.MinItems)
+	case blockType.MaxItems > 0:
+		description += fmt.Sprintf(", max %d", blockType.MaxItems)
+	}
+
+	return description
+}
+
+func describeParameter(parameter *tfjson.FunctionParameter) (string, error) {
+	description, err := typeString(parameter.Type)
+	if err != nil {
+		return "", err
+	}
+
+	if parameter.IsNullable {
+		description += ", nullable"
+	}
+
+	return description, nil
+}
+
+// attributeBehavior returns whether the attribute is required, optional,
+// computed, or both optional and computed.
+func attributeBehavior(attribute *tfjson.SchemaAttribute) string {
+	switch {
+	case attribute.Required:
+		return "required"
+	case attribute.Optional && attribute.Computed:
+		return "optional and computed"
+	case attribute.Optional:
+		return "optional"
+	case attribute.Computed:
+		return "computed"
+	}
+
+	return "unknown"
+}
+
+func attributeTypeString(attribute *tfjson.SchemaAttribute) (string, error) {
+	if attribute.AttributeNestedType != nil {
+		return fmt.Sprintf("nested %s", attribute.AttributeNestedType.NestingMode), nil
+	}
+
+	return typeString(attribute.AttributeType)
+}
+
+func typeString(ty cty.Type) (string, error) {
+	if ty == cty.NilType {
+		return "none", nil
+	}
+
+	b := &bytes.Buffer{}
+
+	err := schemamd.WriteType(b, ty)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("`%s`", b.String()), nil
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
+
+// sortedKeys returns the sorted union of keys in both maps.
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := make([]string, 0, len(a)+len(b))
+
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,327 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemadiff_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/schemadiff"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	exampleSchema := func(attributes map[string]*tfjson.SchemaAttribute) map[string]*tfjson.Schema {
+		return map[string]*tfjson.Schema{
+			"scaffolding_example": {
+				Block: &tfjson.SchemaBlock{
+					Attributes: attributes,
+				},
+			},
+		}
+	}
+
+	cases := map[string]struct {
+		oldSchema *tfjson.ProviderSchema
+		newSchema *tfjson.ProviderSchema
+		expected  *schemadiff.Report
+	}{
+		"no changes": {
+			oldSchema: &tfjson.ProviderSchema{
+				ResourceSchemas: exampleSchema(map[string]*tfjson.SchemaAttribute{
+					"id": {AttributeType: cty.String, Computed: true},
+				}),
+			},
+			newSchema: &tfjson.ProviderSchema{
+				ResourceSchemas: exampleSchema(map[string]*tfjson.SchemaAttribute{
+					"id": {AttributeType: cty.String, Computed: true, Description: "Changed description"},
+				}),
+			},
+			expected: &schemadiff.Report{},
+		},
+		"nil schemas": {
+			expected: &schemadiff.Report{},
+		},
+		"added and removed resources": {
+			oldSchema: &tfjson.ProviderSchema{
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"scaffolding_legacy": {},
+				},
+			},
+			newSchema: &tfjson.ProviderSchema{
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"scaffolding_example": {},
+				},
+			},
+			expected: &schemadiff.Report{
+				Resources: []schemadiff.Change{
+					{Name: "scaffolding_example", Kind: schemadiff.KindAdded},
+					{Name: "scaffolding_legacy", Kind: schemadiff.KindRemoved},
+				},
+			},
+		},
+		"attribute changes": {
+			oldSchema: &tfjson.ProviderSchema{
+				DataSourceSchemas: exampleSchema(map[string]*tfjson.SchemaAttribute{
+					"behavior": {AttributeType: cty.String, Optional: true},
+					"removed":  {AttributeType: cty.String, Optional: true},
+					"secret":   {AttributeType: cty.String, Computed: true},
+					"type":     {AttributeType: cty.List(cty.String), Optional: true},
+				}),
+			},
+			newSchema: &tfjson.ProviderSchema{
+				DataSourceSchemas: exampleSchema(map[string]*tfjson.SchemaAttribute{
+					"added":    {AttributeType: cty.Bool, Optional: true, Computed: true, Deprecated: true},
+					"behavior": {AttributeType: cty.String, Required: true},
+					"secret":   {AttributeType: cty.String, Computed: true, Sensitive: true},
+					"type":     {AttributeType: cty.Set(cty.String), Optional: true},
+				}),
+			},
+			expected: &schemadiff.Report{
+				DataSources: []schemadiff.Change{
+					{
+						Name: "scaffolding_example",
+						Kind: schemadiff.KindChanged,
+						Attributes: []schemadiff.AttributeChange{
+							{Path: "added", Kind: schemadiff.KindAdded, Details: []string{"optional and computed, `Boolean`, deprecated"}},
+							{Path: "behavior", Kind: schemadiff.KindChanged, Details: []string{"changed from optional to required"}},
+							{Path: "removed", Kind: schemadiff.KindRemoved},
+							{Path: "secret", Kind: schemadiff.KindChanged, Details: []string{"now sensitive"}},
+							{Path: "type", Kind: schemadiff.KindChanged, Details: []string{"type changed from `List of String` to `Set of String`"}},
+						},
+					},
+				},
+			},
+		},
+		"nested attribute and block changes": {
+			oldSchema: &tfjson.ProviderSchema{
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"scaffolding_example": {
+						Block: &tfjson.SchemaBlock{
+							Attributes: map[string]*tfjson.SchemaAttribute{
+								"nested": {
+									AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+										NestingMode: tfjson.SchemaNestingModeSingle,
+										Attributes: map[string]*tfjson.SchemaAttribute{
+											"name": {AttributeType: cty.String, Optional: true},
+										},
+									},
+									Optional: true,
+								},
+							},
+							NestedBlocks: map[string]*tfjson.SchemaBlockType{
+								"rule": {
+									NestingMode: tfjson.SchemaNestingModeList,
+									Block: &tfjson.SchemaBlock{
+										Attributes: map[string]*tfjson.SchemaAttribute{
+											"priority": {AttributeType: cty.Number, Optional: true},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			newSchema: &tfjson.ProviderSchema{
+				ResourceSchemas: map[string]*tfjson.Schema{
+					"scaffolding_example": {
+						Version: 1,
+						Block: &tfjson.SchemaBlock{
+							Attributes: map[string]*tfjson.SchemaAttribute{
+								"nested": {
+									AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+										NestingMode: tfjson.SchemaNestingModeList,
+										Attributes: map[string]*tfjson.SchemaAttribute{
+											"name":  {AttributeType: cty.String, Required: true},
+											"value": {AttributeType: cty.String, Optional: true},
+										},
+									},
+									Optional: true,
+								},
+							},
+							NestedBlocks: map[string]*tfjson.SchemaBlockType{
+								"rule": {
+									NestingMode: tfjson.SchemaNestingModeSet,
+									MaxItems:    10,
+									Block: &tfjson.SchemaBlock{
+										Attributes: map[string]*tfjson.SchemaAttribute{
+											"priority": {AttributeType: cty.Number, Optional: true},
+										},
+										NestedBlocks: map[string]*tfjson.SchemaBlockType{
+											"condition": {
+												NestingMode: tfjson.SchemaNestingModeList,
+												MinItems:    1,
+											},
+										},
+									},
+								},
+							},
+							Deprecated: true,
+						},
+					},
+				},
+			},
+			expected: &schemadiff.Report{
+				Resources: []schemadiff.Change{
+					{
+						Name:    "scaffolding_example",
+						Kind:    schemadiff.KindChanged,
+						Details: []string{"now deprecated", "schema version changed from 0 to 1"},
+						Attributes: []schemadiff.AttributeChange{
+							{Path: "nested", Kind: schemadiff.KindChanged, Details: []string{"type changed from nested single to nested list"}},
+							{Path: "nested.name", Kind: schemadiff.KindChanged, Details: []string{"changed from optional to required"}},
+							{Path: "nested.value", Kind: schemadiff.KindAdded, Details: []string{"optional, `String`"}},
+							{Path: "rule", Kind: schemadiff.KindChanged, Details: []string{"nesting mode changed from list to set", "maximum items changed from 0 to 10"}},
+							{Path: "rule.condition", Kind: schemadiff.KindAdded, Details: []string{"block, list, min 1"}},
+						},
+					},
+				},
+			},
+		},
+		"provider and function changes": {
+			oldSchema: &tfjson.ProviderSchema{
+				ConfigSchema: &tfjson.Schema{
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"endpoint": {AttributeType: cty.String, Optional: true},
+						},
+					},
+				},
+				Functions: map[string]*tfjson.FunctionSignature{
+					"example": {
+						ReturnType: cty.String,
+						Parameters: []*tfjson.FunctionParameter{
+							{Name: "input", Type: cty.String},
+							{Name: "count", Type: cty.Number},
+						},
+						VariadicParameter: &tfjson.FunctionParameter{Name: "suffixes", Type: cty.String},
+					},
+					"removed": {
+						ReturnType: cty.String,
+					},
+				},
+			},
+			newSchema: &tfjson.ProviderSchema{
+				Functions: map[string]*tfjson.FunctionSignature{
+					"added": {
+						ReturnType: cty.String,
+					},
+					"example": {
+						ReturnType: cty.List(cty.String),
+						Parameters: []*tfjson.FunctionParameter{
+							{Name: "value", Type: cty.String, IsNullable: true},
+						},
+						DeprecationMessage: "Use added instead.",
+					},
+				},
+			},
+			expected: &schemadiff.Report{
+				Provider: []schemadiff.AttributeChange{
+					{Path: "endpoint", Kind: schemadiff.KindRemoved},
+				},
+				Functions: []schemadiff.Change{
+					{Name: "added", Kind: schemadiff.KindAdded},
+					{
+						Name: "example",
+						Kind: schemadiff.KindChanged,
+						Details: []string{
+							"parameter 1 `value` renamed from `input`",
+							"parameter 1 `value` now nullable",
+							"parameter 2 `count` removed",
+							"variadic parameter `suffixes` removed",
+							"return type changed from `String` to `List of String`",
+							"now deprecated",
+						},
+					},
+					{Name: "removed", Kind: schemadiff.KindRemoved},
+				},
+			},
+		},
+	}
+
+	for name, c := range cases {
+		name, c := name, c
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			actual, err := schemadiff.Diff(c.oldSchema, c.newSchema)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestRenderMarkdown(t *testing.T) {
+	t.Parallel()
+
+	report := &schemadiff.Report{
+		Provider: []schemadiff.AttributeChange{
+			{Path: "token", Kind: schemadiff.KindAdded, Details: []string{"optional, `String`, sensitive"}},
+		},
+		Resources: []schemadiff.Change{
+			{
+				Name:    "scaffolding_example",
+				Kind:    schemadiff.KindChanged,
+				Details: []string{"schema version changed from 0 to 1"},
+				Attributes: []schemadiff.AttributeChange{
+					{Path: "legacy", Kind: schemadiff.KindRemoved},
+					{Path: "name", Kind: schemadiff.KindChanged, Details: []string{"changed from optional to required", "now sensitive"}},
+				},
+			},
+			{Name: "scaffolding_legacy", Kind: schemadiff.KindRemoved},
+			{Name: "scaffolding_widget", Kind: schemadiff.KindAdded},
+		},
+		Functions: []schemadiff.Change{
+			{Name: "example", Kind: schemadiff.KindAdded},
+		},
+	}
+
+	expected := "## Provider\n" +
+		"\n" +
+		"- `token`: added (optional, `String`, sensitive)\n" +
+		"\n" +
+		"## Resources\n" +
+		"\n" +
+		"### Added\n" +
+		"\n" +
+		"- `scaffolding_widget`\n" +
+		"\n" +
+		"### Removed\n" +
+		"\n" +
+		"- `scaffolding_legacy`\n" +
+		"\n" +
+		"### `scaffolding_example`\n" +
+		"\n" +
+		"- Schema version changed from 0 to 1\n" +
+		"- `legacy`: removed\n" +
+		"- `name`: changed from optional to required; now sensitive\n" +
+		"\n" +
+		"## Functions\n" +
+		"\n" +
+		"### Added\n" +
+		"\n" +
+		"- `example`\n"
+
+	b := &strings.Builder{}
+
+	err := schemadiff.RenderMarkdown(b, report)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(expected, b.String()); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
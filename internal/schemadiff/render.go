@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemadiff
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// RenderMarkdown writes the report as Markdown, suitable for upgrade guides
+// and changelogs.
+func RenderMarkdown(w io.Writer, report *Report) error {
+	b := &strings.Builder{}
+
+	if report.Empty() {
+		b.WriteString("No schema changes.\n")
+		_, err := io.WriteString(w, b.String())
+		return err
+	}
+
+	sections := []struct {
+		title   string
+		changes []Change
+	}{
+		{"Resources", report.Resources},
+		{"Data Sources", report.DataSources},
+		{"Functions", report.Functions},
+	}
+
+	writeHeading := func(heading string) {
+		if b.Len() > 0 && !strings.HasSuffix(b.String(), "\n\n") {
+			b.WriteString("\n")
+		}
+
+		b.WriteString(heading)
+		b.WriteString("\n\n")
+	}
+
+	if len(report.Provider) > 0 {
+		writeHeading("## Provider")
+		writeAttributeChanges(b, report.Provider)
+	}
+
+	for _, section := range sections {
+		if len(section.changes) == 0 {
+			continue
+		}
+
+		writeHeading("## " + section.title)
+
+		for _, kind := range []Kind{KindAdded, KindRemoved} {
+			var names []string
+			for _, change := range section.changes {
+				if change.Kind == kind {
+					names = append(names, change.Name)
+				}
+			}
+
+			if len(names) == 0 {
+				continue
+			}
+
+			writeHeading("### " + kindTitle(kind))
+
+			for _, name := range names {
+				fmt.Fprintf(b, "- `%s`\n", name)
+			}
+		}
+
+		for _, change := range section.changes {
+			if change.Kind != KindChanged {
+				continue
+			}
+
+			writeHeading(fmt.Sprintf("### `%s`", change.Name))
+
+			for _, detail := range change.Details {
+				fmt.Fprintf(b, "- %s\n", capitalize(detail))
+			}
+
+			writeAttributeChanges(b, change.Attributes)
+		}
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// RenderJSON writes the report as indented JSON.
+func RenderJSON(w io.Writer, report *Report) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(report)
+}
+
+func writeAttributeChanges(b *strings.Builder, changes []AttributeChange) {
+	for _, change := range changes {
+		switch change.Kind {
+		case KindAdded:
+			fmt.Fprintf(b, "- `%s`: added (%s)\n", change.Path, strings.Join(change.Details, "; "))
+		case KindRemoved:
+			fmt.Fprintf(b, "- `%s`: removed\n", change.Path)
+		default:
+			fmt.Fprintf(b, "- `%s`: %s\n", change.Path, strings.Join(change.Details, "; "))
+		}
+	}
+}
+
+func kindTitle(kind Kind) string {
+	return capitalize(string(kind))
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
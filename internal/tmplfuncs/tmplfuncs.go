@@ -4,22 +4,108 @@
 package tmplfuncs
 
 import (
+	"errors"
 	"fmt"
 	"os"
+	"regexp"
+	"strconv"
 	"strings"
+	"unicode"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+const (
+	// snippetStartMarker and snippetEndMarker are the comments which
+	// surround a named snippet of a code file, e.g. "# docs-start foo" and
+	// "# docs-end foo".
+	snippetStartMarker = "docs-start"
+	snippetEndMarker   = "docs-end"
+
+	// truncationSuffix is the suffix of text shortened by Truncate.
+	truncationSuffix = "..."
 )
 
+// placeholderPattern matches the placeholder tokens of code files, such as
+// @@VERSION@@, which are replaced by ReplacePlaceholders.
+var placeholderPattern = regexp.MustCompile(`@@([A-Za-z0-9_]+)@@`)
+
+// directiveCommentPrefixes are the prefixes of linter and scanner directive
+// comments, such as "# noqa" or "# tflint-ignore: rule", which are removed
+// from code files when CodeFileOptions.StripHeaders is enabled.
+var directiveCommentPrefixes = []string{
+	"checkov:skip",
+	"noqa",
+	"nosec",
+	"tflint-ignore",
+	"tfsec:ignore",
+	"trivy:ignore",
+}
+
+// CodeFileOptions configures which content of a file is included in the code
+// block rendered by CodeFile. A nil *CodeFileOptions includes all content.
+type CodeFileOptions struct {
+	// StripHeaders removes the leading copyright and license header comments,
+	// and any lines which only contain a directive comment, such as "# noqa".
+	StripHeaders bool
+
+	// Lines is an inclusive, 1-based range of lines of the file to include,
+	// either a single line ("5"), a range ("5-10"), or an open range ("5-").
+	Lines string
+
+	// Snippet is the name of the snippet of the file to include, which is
+	// surrounded by "# docs-start <name>" and "# docs-end <name>" comments.
+	Snippet string
+
+	// FormatTerraform formats the included content of terraform and hcl
+	// code blocks in the canonical style of terraform fmt.
+	FormatTerraform bool
+
+	// Values replaces the @@<name>@@ placeholder tokens of the included
+	// content with the value of the name. Tokens of other names are kept.
+	Values map[string]string
+}
+
 func PrefixLines(prefix, text string) string {
 	return prefix + strings.Join(strings.Split(text, "\n"), "\n"+prefix)
 }
 
-func CodeFile(format, file string) (string, error) {
+func CodeFile(format, file string, opts *CodeFileOptions) (string, error) {
 	content, err := os.ReadFile(file)
 	if err != nil {
 		return "", fmt.Errorf("unable to read content from %q: %w", file, err)
 	}
 
-	sContent := strings.TrimSpace(string(content))
+	sContent := string(content)
+	if opts != nil && opts.Lines != "" {
+		sContent, err = SelectLines(sContent, opts.Lines)
+		if err != nil {
+			return "", fmt.Errorf("unable to select lines from %q: %w", file, err)
+		}
+	}
+
+	if opts != nil && opts.Snippet != "" {
+		sContent, err = SelectSnippet(sContent, opts.Snippet)
+		if err != nil {
+			return "", fmt.Errorf("unable to select snippet from %q: %w", file, err)
+		}
+	}
+
+	if opts != nil && opts.StripHeaders {
+		sContent = StripHeaders(sContent)
+	}
+
+	if opts != nil && len(opts.Values) > 0 {
+		sContent = ReplacePlaceholders(sContent, opts.Values)
+	}
+
+	if opts != nil && opts.FormatTerraform && IsTerraformFormat(format) {
+		sContent = FormatTerraform(sContent)
+	}
+
+	sContent = strings.TrimSpace(sContent)
 	if sContent == "" {
 		return "", fmt.Errorf("no file content in %q", file)
 	}
@@ -42,3 +128,353 @@ func CodeFile(format, file string) (string, error) {
 
 	return md.String(), nil
 }
+
+// licenseHeaderPrefixes and licenseHeaderPhrases identify the comment lines
+// of copyright and license headers, by the prefix of the comment text, e.g.
+// "Copyright (c) HashiCorp, Inc.", or by a phrase within it, e.g. "Licensed
+// under the Apache License, Version 2.0".
+var (
+	licenseHeaderPrefixes = []string{
+		"Copyright",
+		"SPDX-License-Identifier",
+	}
+
+	licenseHeaderPhrases = []string{
+		"Licensed under the",
+		"All rights reserved",
+		"Permission is hereby granted",
+	}
+)
+
+// StripHeaders removes the leading copyright and license header comments,
+// e.g. "# Copyright (c) HashiCorp, Inc." and "# SPDX-License-Identifier:
+// MPL-2.0", and any lines which only contain a directive comment, e.g.
+// "# noqa" or "// tflint-ignore: terraform_unused_declarations", from the
+// content of a code file. Each leading block of consecutive comment lines
+// which contains a license header line is removed as a whole, including
+// multi-line license texts and their empty comment lines.
+func StripHeaders(content string) string {
+	lines := strings.Split(content, "\n")
+	result := make([]string, 0, len(lines))
+
+	start := 0
+	for start < len(lines) {
+		if strings.TrimSpace(lines[start]) == "" {
+			start++
+			continue
+		}
+
+		end := start
+		license := false
+		for end < len(lines) {
+			comment, isComment := commentText(lines[end])
+			if !isComment {
+				break
+			}
+
+			license = license || isLicenseHeader(comment)
+			end++
+		}
+
+		if !license {
+			break
+		}
+
+		start = end
+	}
+
+	for _, line := range lines[start:] {
+		comment, isComment := commentText(line)
+		if isComment && isDirectiveComment(comment) {
+			continue
+		}
+
+		result = append(result, line)
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// isLicenseHeader returns whether the comment text is a line of a copyright
+// or license header.
+func isLicenseHeader(comment string) bool {
+	for _, prefix := range licenseHeaderPrefixes {
+		if strings.HasPrefix(comment, prefix) {
+			return true
+		}
+	}
+
+	for _, phrase := range licenseHeaderPhrases {
+		if strings.Contains(comment, phrase) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsTerraformFormat returns whether a code block of the format, as passed to
+// CodeFile, contains Terraform configuration.
+func IsTerraformFormat(format string) bool {
+	return format == "terraform" || format == "hcl"
+}
+
+// CheckTerraformSyntax returns an error for each syntax error of the
+// Terraform configuration content, prefixed with the filename and line of
+// the error, such as "examples/resource.tf:3: Missing newline after argument;
+// An argument definition must end with a newline.".
+func CheckTerraformSyntax(filename string, content []byte) error {
+	_, diags := hclsyntax.ParseConfig(content, filename, hcl.Pos{Line: 1, Column: 1})
+
+	var result error
+
+	for _, diag := range diags {
+		if diag.Severity != hcl.DiagError {
+			continue
+		}
+
+		location := filename
+		if diag.Subject != nil {
+			location = fmt.Sprintf("%s:%d", filename, diag.Subject.Start.Line)
+		}
+
+		message := diag.Summary
+		if diag.Detail != "" {
+			message += "; " + diag.Detail
+		}
+
+		result = errors.Join(result, fmt.Errorf("%s: %s", location, message))
+	}
+
+	return result
+}
+
+// ReplacePlaceholders returns the content with its @@<name>@@ placeholder
+// tokens replaced with the value of the name. Tokens of names without a
+// value are kept.
+func ReplacePlaceholders(content string, values map[string]string) string {
+	if len(values) == 0 {
+		return content
+	}
+
+	return placeholderPattern.ReplaceAllStringFunc(content, func(token string) string {
+		value, ok := values[strings.Trim(token, "@")]
+		if !ok {
+			return token
+		}
+
+		return value
+	})
+}
+
+// FormatTerraform returns the Terraform configuration content in the
+// canonical style of terraform fmt, such as with aligned equals signs and
+// two space indentation. Formatting is lexical, so partial content, such as
+// a snippet of a file, is formatted as well, and syntax errors are ignored.
+func FormatTerraform(content string) string {
+	return string(hclwrite.Format([]byte(content)))
+}
+
+// commentText returns the text of a line comment, without the comment
+// marker, and whether the line only contains a comment.
+func commentText(line string) (string, bool) {
+	line = strings.TrimSpace(line)
+
+	for _, marker := range []string{"#", "//"} {
+		if strings.HasPrefix(line, marker) {
+			return strings.TrimSpace(strings.TrimPrefix(line, marker)), true
+		}
+	}
+
+	return "", false
+}
+
+func isDirectiveComment(comment string) bool {
+	for _, prefix := range directiveCommentPrefixes {
+		if strings.HasPrefix(comment, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// SelectLines returns the lines of the content in the given inclusive,
+// 1-based range, either a single line ("5"), a range ("5-10"), or an open
+// range to the end of the content ("5-").
+func SelectLines(content, lineRange string) (string, error) {
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+
+	startText, endText, isRange := strings.Cut(lineRange, "-")
+
+	start, err := strconv.Atoi(strings.TrimSpace(startText))
+	if err != nil {
+		return "", fmt.Errorf("invalid line range %q: %w", lineRange, err)
+	}
+
+	end := start
+	if isRange {
+		end = len(lines)
+		if strings.TrimSpace(endText) != "" {
+			end, err = strconv.Atoi(strings.TrimSpace(endText))
+			if err != nil {
+				return "", fmt.Errorf("invalid line range %q: %w", lineRange, err)
+			}
+		}
+	}
+
+	if start < 1 || end < start || end > len(lines) {
+		return "", fmt.Errorf("invalid line range %q for content with %d lines", lineRange, len(lines))
+	}
+
+	return strings.Join(lines[start-1:end], "\n"), nil
+}
+
+// SelectSnippet returns the lines of the content between the
+// "docs-start <name>" and "docs-end <name>" comments, with any other snippet
+// marker comments removed and the common indentation of the lines removed.
+func SelectSnippet(content, name string) (string, error) {
+	var snippet []string
+
+	started, ended := false, false
+	for _, line := range strings.Split(content, "\n") {
+		comment, isComment := commentText(line)
+		marker, markerName, isMarker := strings.Cut(comment, " ")
+		isMarker = isComment && isMarker && (marker == snippetStartMarker || marker == snippetEndMarker)
+
+		switch {
+		case isMarker && marker == snippetStartMarker && strings.TrimSpace(markerName) == name:
+			started = true
+		case isMarker && marker == snippetEndMarker && strings.TrimSpace(markerName) == name:
+			ended = started
+		case isMarker:
+			// markers of other snippets are not part of the snippet
+		case started && !ended:
+			snippet = append(snippet, line)
+		}
+
+		if ended {
+			break
+		}
+	}
+
+	if !started {
+		return "", fmt.Errorf("snippet %q not found, expected a %q comment", name, snippetStartMarker+" "+name)
+	}
+
+	if !ended {
+		return "", fmt.Errorf("snippet %q is not closed, expected a %q comment", name, snippetEndMarker+" "+name)
+	}
+
+	return dedent(snippet), nil
+}
+
+// Dedent removes the common leading whitespace of the non-empty lines of the
+// content.
+func Dedent(content string) string {
+	return dedent(strings.Split(content, "\n"))
+}
+
+// dedent removes the common leading whitespace of the non-empty lines.
+func dedent(lines []string) string {
+	indent := ""
+	first := true
+	for _, line := range lines {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		lineIndent := line[:len(line)-len(strings.TrimLeft(line, " \t"))]
+		if first {
+			indent = lineIndent
+			first = false
+			continue
+		}
+
+		for !strings.HasPrefix(lineIndent, indent) {
+			indent = indent[:len(indent)-1]
+		}
+	}
+
+	result := make([]string, len(lines))
+	for i, line := range lines {
+		result[i] = strings.TrimPrefix(line, indent)
+	}
+
+	return strings.Join(result, "\n")
+}
+
+// FirstLine returns the first non-empty line of the Markdown text, without
+// leading and trailing whitespace.
+func FirstLine(text string) string {
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			return line
+		}
+	}
+
+	return ""
+}
+
+// FirstParagraph returns the first paragraph of the Markdown text, which is
+// the lines up to the first empty line after any leading empty lines, without
+// leading and trailing whitespace.
+func FirstParagraph(text string) string {
+	var paragraph []string
+	for _, line := range strings.Split(text, "\n") {
+		if strings.TrimSpace(line) == "" {
+			if len(paragraph) > 0 {
+				break
+			}
+			continue
+		}
+
+		paragraph = append(paragraph, line)
+	}
+
+	return strings.TrimSpace(strings.Join(paragraph, "\n"))
+}
+
+// Truncate returns the Markdown text limited to length characters. Longer
+// text is cut at the last word boundary which fits with a "..." suffix, and
+// before any code span which would be cut open. Links are not kept intact,
+// so text with links is best rendered with plainmarkdown before it is
+// truncated.
+func Truncate(length int, text string) (string, error) {
+	if length < 0 {
+		return "", fmt.Errorf("expected length to be at least 0, got %d", length)
+	}
+
+	runes := []rune(text)
+	if len(runes) <= length {
+		return text, nil
+	}
+
+	if length <= len(truncationSuffix) {
+		return string(runes[:length]), nil
+	}
+
+	cut := runes[:length-len(truncationSuffix)]
+	for i := len(cut); i > 0; i-- {
+		if unicode.IsSpace(runes[i]) {
+			cut = runes[:i]
+			break
+		}
+	}
+
+	truncated := trimTruncated(string(cut))
+	if strings.Count(truncated, "`")%2 == 1 {
+		truncated = trimTruncated(truncated[:strings.LastIndex(truncated, "`")])
+	}
+
+	return truncated + truncationSuffix, nil
+}
+
+// trimTruncated removes trailing whitespace and punctuation from truncated
+// text.
+func trimTruncated(text string) string {
+	return strings.TrimRightFunc(text, func(r rune) bool {
+		return unicode.IsSpace(r) || unicode.IsPunct(r)
+	})
+}
@@ -0,0 +1,583 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tmplfuncs_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/tmplfuncs"
+)
+
+func TestStripHeaders(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content  string
+		expected string
+	}{
+		"no headers": {
+			content:  "resource \"test_example\" \"example\" {}\n",
+			expected: "resource \"test_example\" \"example\" {}\n",
+		},
+		"copyright headers": {
+			content: `# Copyright (c) HashiCorp, Inc.
+# SPDX-License-Identifier: MPL-2.0
+
+resource "test_example" "example" {}
+`,
+			expected: `resource "test_example" "example" {}
+`,
+		},
+		"double slash copyright headers": {
+			content: `// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+resource "test_example" "example" {}
+`,
+			expected: `resource "test_example" "example" {}
+`,
+		},
+		"other leading comments": {
+			content: `# Copyright (c) HashiCorp, Inc.
+
+# Manages an example.
+resource "test_example" "example" {}
+`,
+			expected: `# Manages an example.
+resource "test_example" "example" {}
+`,
+		},
+		"multi-line license header": {
+			content: `#
+# Copyright 2024 Example Corp.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS.
+
+# Manages an example.
+resource "test_example" "example" {}
+`,
+			expected: `# Manages an example.
+resource "test_example" "example" {}
+`,
+		},
+		"license header without copyright line": {
+			content: `// Licensed under the MIT License.
+// See LICENSE in the project root for license information.
+resource "test_example" "example" {}
+`,
+			expected: `resource "test_example" "example" {}
+`,
+		},
+		"leading comment block without license": {
+			content: `# Manages an example with a license key.
+#
+# The license is read from a file.
+resource "test_example" "example" {}
+`,
+			expected: `# Manages an example with a license key.
+#
+# The license is read from a file.
+resource "test_example" "example" {}
+`,
+		},
+		"copyright comment after header": {
+			content: `resource "test_example" "example" {
+  # Copyright is not a header here
+  name = "example"
+}
+`,
+			expected: `resource "test_example" "example" {
+  # Copyright is not a header here
+  name = "example"
+}
+`,
+		},
+		"directive comments": {
+			content: `# tflint-ignore: terraform_unused_declarations
+variable "example" {}
+
+resource "test_example" "example" {
+  # checkov:skip=CKV_TEST_1: example only
+  # noqa
+  name = "example" # noqa
+}
+`,
+			expected: `variable "example" {}
+
+resource "test_example" "example" {
+  name = "example" # noqa
+}
+`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tmplfuncs.StripHeaders(testCase.content)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectLines(t *testing.T) {
+	t.Parallel()
+
+	content := "line 1\nline 2\nline 3\nline 4\n"
+
+	testCases := map[string]struct {
+		lines       string
+		expected    string
+		expectError bool
+	}{
+		"single line": {
+			lines:    "2",
+			expected: "line 2",
+		},
+		"range": {
+			lines:    "2-3",
+			expected: "line 2\nline 3",
+		},
+		"open range": {
+			lines:    "3-",
+			expected: "line 3\nline 4",
+		},
+		"whole content": {
+			lines:    "1-4",
+			expected: "line 1\nline 2\nline 3\nline 4",
+		},
+		"start after end": {
+			lines:       "3-2",
+			expectError: true,
+		},
+		"end out of range": {
+			lines:       "2-5",
+			expectError: true,
+		},
+		"zero start": {
+			lines:       "0-2",
+			expectError: true,
+		},
+		"not a number": {
+			lines:       "two",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tmplfuncs.SelectLines(content, testCase.lines)
+
+			if err == nil && testCase.expectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFormatTerraform(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content  string
+		expected string
+	}{
+		"formatted": {
+			content: `resource "test_example" "example" {
+  name  = "example"
+  count = 1
+}
+`,
+			expected: `resource "test_example" "example" {
+  name  = "example"
+  count = 1
+}
+`,
+		},
+		"unaligned and indented": {
+			content: `resource "test_example" "example" {
+    name = "example"
+    count   = 1
+}
+`,
+			expected: `resource "test_example" "example" {
+  name  = "example"
+  count = 1
+}
+`,
+		},
+		"snippet": {
+			content:  "name = \"example\"\ncount   = 1",
+			expected: "name  = \"example\"\ncount = 1",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tmplfuncs.FormatTerraform(testCase.content)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestReplacePlaceholders(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]string{
+		"VERSION": "1.2.0",
+		"REGION":  "us-east-1",
+	}
+
+	testCases := map[string]struct {
+		content  string
+		expected string
+	}{
+		"values": {
+			content: `provider "test" {
+  region = "@@REGION@@"
+}
+
+module "example" {
+  version = "~> @@VERSION@@"
+}
+`,
+			expected: `provider "test" {
+  region = "us-east-1"
+}
+
+module "example" {
+  version = "~> 1.2.0"
+}
+`,
+		},
+		"unknown placeholder": {
+			content:  `zone = "@@ZONE@@-@@REGION@@"`,
+			expected: `zone = "@@ZONE@@-us-east-1"`,
+		},
+		"not a placeholder": {
+			content:  `email = "admin@@example.com@@"`,
+			expected: `email = "admin@@example.com@@"`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tmplfuncs.ReplacePlaceholders(testCase.content, values)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckTerraformSyntax(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		content       string
+		expectedError string
+	}{
+		"valid": {
+			content: "resource \"test_example\" \"example\" {\n  name = \"example\"\n}\n",
+		},
+		"unformatted": {
+			content: "resource \"test_example\" \"example\" {\nname=\"example\"\n}\n",
+		},
+		"missing newline": {
+			content:       "resource \"test_example\" \"example\" {\n  name = \"example\" \"other\"\n}\n",
+			expectedError: "examples/resource.tf:2: Missing newline after argument; An argument definition must end with a newline.",
+		},
+		"unclosed block": {
+			content:       "resource \"test_example\" \"example\" {\n  name = \"example\"\n",
+			expectedError: "examples/resource.tf:1: Unclosed configuration block; There is no closing brace for this block before the end of the file. This may be caused by incorrect brace nesting elsewhere in this file.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tmplfuncs.CheckTerraformSyntax("examples/resource.tf", []byte(testCase.content))
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got error: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error %q, got no error", testCase.expectedError)
+			}
+
+			if diff := cmp.Diff(testCase.expectedError, err.Error()); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectSnippet(t *testing.T) {
+	t.Parallel()
+
+	content := `# Copyright (c) HashiCorp, Inc.
+
+# docs-start resource
+resource "test_example" "example" {
+  # docs-start name
+  name = "example"
+  # docs-end name
+
+  tags = {
+    Name = "example"
+  }
+}
+# docs-end resource
+
+// docs-start unclosed
+data "test_example" "example" {}
+`
+
+	testCases := map[string]struct {
+		snippet     string
+		expected    string
+		expectError bool
+	}{
+		"snippet": {
+			snippet: "resource",
+			expected: `resource "test_example" "example" {
+  name = "example"
+
+  tags = {
+    Name = "example"
+  }
+}`,
+		},
+		"nested snippet": {
+			snippet:  "name",
+			expected: `name = "example"`,
+		},
+		"missing snippet": {
+			snippet:     "missing",
+			expectError: true,
+		},
+		"unclosed snippet": {
+			snippet:     "unclosed",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tmplfuncs.SelectSnippet(content, testCase.snippet)
+
+			if err == nil && testCase.expectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFirstLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		text     string
+		expected string
+	}{
+		"empty": {
+			text:     "",
+			expected: "",
+		},
+		"single line": {
+			text:     "Manages an example.",
+			expected: "Manages an example.",
+		},
+		"leading empty lines": {
+			text:     "\n  \n  Manages an example.  \nSecond line.",
+			expected: "Manages an example.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tmplfuncs.FirstLine(testCase.text)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestFirstParagraph(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		text     string
+		expected string
+	}{
+		"empty": {
+			text:     "",
+			expected: "",
+		},
+		"single paragraph": {
+			text:     "Manages an example,\nwhich has two lines.",
+			expected: "Manages an example,\nwhich has two lines.",
+		},
+		"several paragraphs": {
+			text:     "\n\nManages an example,\nwhich has two lines.\n \nSecond paragraph.\n\nThird paragraph.",
+			expected: "Manages an example,\nwhich has two lines.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tmplfuncs.FirstParagraph(testCase.text)
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		length      int
+		text        string
+		expected    string
+		expectError bool
+	}{
+		"shorter": {
+			length:   80,
+			text:     "Manages an example.",
+			expected: "Manages an example.",
+		},
+		"word boundary": {
+			length:   21,
+			text:     "Manages an example resource, which is long.",
+			expected: "Manages an example...",
+		},
+		"trailing punctuation": {
+			length:   32,
+			text:     "Manages an example resource, which is long.",
+			expected: "Manages an example resource...",
+		},
+		"code span": {
+			length:   30,
+			text:     "Manages an `example resource` which is long.",
+			expected: "Manages an...",
+		},
+		"closed code span": {
+			length:   25,
+			text:     "Manages an `example` resource which is long.",
+			expected: "Manages an `example`...",
+		},
+		"shorter than suffix": {
+			length:   2,
+			text:     "Manages an example.",
+			expected: "Ma",
+		},
+		"negative": {
+			length:      -1,
+			text:        "Manages an example.",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name := name
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tmplfuncs.Truncate(testCase.length, testCase.text)
+
+			if err == nil && testCase.expectError {
+				t.Fatalf("expected error, got no error")
+			}
+
+			if err != nil && !testCase.expectError {
+				t.Fatalf("expected no error, got error: %s", err)
+			}
+
+			if diff := cmp.Diff(testCase.expected, got); diff != "" {
+				t.Errorf("unexpected difference (-want +got): %s", diff)
+			}
+		})
+	}
+}
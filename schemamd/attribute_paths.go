@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// SensitiveAttributes returns the sorted, dot separated paths of the
+// sensitive attributes of the schema, including nested attributes and the
+// attributes of nested blocks, such as "credentials.password".
+func SensitiveAttributes(schema *tfjson.Schema) []string {
+	return attributePaths(schema, func(att *tfjson.SchemaAttribute) bool {
+		return att.Sensitive
+	}, nil)
+}
+
+// DeprecatedAttributes returns the sorted, dot separated paths of the
+// deprecated attributes and blocks of the schema, including nested
+// attributes and blocks.
+func DeprecatedAttributes(schema *tfjson.Schema) []string {
+	return attributePaths(schema, func(att *tfjson.SchemaAttribute) bool {
+		return att.Deprecated
+	}, func(block *tfjson.SchemaBlockType) bool {
+		return block.Block != nil && block.Block.Deprecated
+	})
+}
+
+// AttributePaths returns the sorted, dot separated paths of all attributes
+// and nested blocks of the schema, including nested attributes and the
+// attributes of nested blocks.
+func AttributePaths(schema *tfjson.Schema) []string {
+	return attributePaths(schema, func(*tfjson.SchemaAttribute) bool {
+		return true
+	}, func(*tfjson.SchemaBlockType) bool {
+		return true
+	})
+}
+
+// attributePaths returns the sorted, dot separated paths of the attributes,
+// and the nested blocks if blockFilter is set, of the schema which match the
+// filters.
+func attributePaths(schema *tfjson.Schema, attributeFilter func(*tfjson.SchemaAttribute) bool, blockFilter func(*tfjson.SchemaBlockType) bool) []string {
+	if schema == nil {
+		return nil
+	}
+
+	var paths []string
+
+	walkBlockPaths(nil, schema.Block, attributeFilter, blockFilter, &paths)
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+func walkBlockPaths(parents []string, block *tfjson.SchemaBlock, attributeFilter func(*tfjson.SchemaAttribute) bool, blockFilter func(*tfjson.SchemaBlockType) bool, paths *[]string) {
+	if block == nil {
+		return
+	}
+
+	walkAttributePaths(parents, block.Attributes, attributeFilter, paths)
+
+	for name, blockType := range block.NestedBlocks {
+		if blockType == nil {
+			continue
+		}
+
+		path := childPath(parents, name)
+
+		if blockFilter != nil && blockFilter(blockType) {
+			*paths = append(*paths, strings.Join(path, "."))
+		}
+
+		walkBlockPaths(path, blockType.Block, attributeFilter, blockFilter, paths)
+	}
+}
+
+func walkAttributePaths(parents []string, attributes map[string]*tfjson.SchemaAttribute, attributeFilter func(*tfjson.SchemaAttribute) bool, paths *[]string) {
+	for name, att := range attributes {
+		if att == nil {
+			continue
+		}
+
+		path := childPath(parents, name)
+
+		if attributeFilter(att) {
+			*paths = append(*paths, strings.Join(path, "."))
+		}
+
+		if att.AttributeNestedType != nil {
+			walkAttributePaths(path, att.AttributeNestedType.Attributes, attributeFilter, paths)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+const attributePathsSchema = `{
+  "version": 0,
+  "block": {
+    "attributes": {
+      "id": {"type": "string", "computed": true},
+      "token": {"type": "string", "optional": true, "sensitive": true},
+      "region": {"type": "string", "optional": true, "deprecated": true},
+      "credentials": {
+        "nested_type": {
+          "nesting_mode": "single",
+          "attributes": {
+            "username": {"type": "string", "optional": true, "deprecated": true},
+            "password": {"type": "string", "optional": true, "sensitive": true}
+          }
+        },
+        "optional": true
+      }
+    },
+    "block_types": {
+      "replica": {
+        "nesting_mode": "list",
+        "block": {
+          "deprecated": true,
+          "attributes": {
+            "region": {"type": "string", "required": true},
+            "key": {"type": "string", "optional": true, "sensitive": true},
+            "zone": {"type": "string", "optional": true, "deprecated": true}
+          }
+        }
+      }
+    }
+  }
+}`
+
+func TestSensitiveAttributes(t *testing.T) {
+	t.Parallel()
+
+	var schema tfjson.Schema
+
+	err := json.Unmarshal([]byte(attributePathsSchema), &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"credentials.password",
+		"replica.key",
+		"token",
+	}
+
+	if diff := cmp.Diff(expected, schemamd.SensitiveAttributes(&schema)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestDeprecatedAttributes(t *testing.T) {
+	t.Parallel()
+
+	var schema tfjson.Schema
+
+	err := json.Unmarshal([]byte(attributePathsSchema), &schema)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{
+		"credentials.username",
+		"region",
+		"replica",
+		"replica.zone",
+	}
+
+	if diff := cmp.Diff(expected, schemamd.DeprecatedAttributes(&schema)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
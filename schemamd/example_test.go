@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd_test
+
+import (
+	"os"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+func ExampleRender() {
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"name": {
+					AttributeType: cty.String,
+					Description:   "Name of the example.",
+					Required:      true,
+				},
+				"id": {
+					AttributeType: cty.String,
+					Computed:      true,
+				},
+			},
+		},
+	}
+
+	err := schemamd.Render(schema, os.Stdout, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	// Output:
+	// ## Schema
+	//
+	// ### Required
+	//
+	// - `name` (String) Name of the example.
+	//
+	// ### Read-Only
+	//
+	// - `id` (String) The ID of this resource.
+}
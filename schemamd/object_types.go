@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ValidateInlineObjectMaxAttributes returns an error if the maximum number of
+// attributes of inline object types is negative.
+func ValidateInlineObjectMaxAttributes(inlineObjectMaxAttributes int) error {
+	if inlineObjectMaxAttributes < 0 {
+		return fmt.Errorf("expected inline object max attributes to be at least 0, got %d", inlineObjectMaxAttributes)
+	}
+
+	return nil
+}
+
+// inlineObject returns true if the nested type is an object type which is
+// small enough to be listed after the description of its attribute, instead
+// of in a nested schema section. Object types with attributes which contain
+// object types are always rendered in nested schema sections.
+func (o *RenderOptions) inlineObject(nt nestedType) bool {
+	if o == nil || o.InlineObjectMaxAttributes == 0 || nt.object == nil {
+		return false
+	}
+
+	attributeTypes := nt.object.AttributeTypes()
+	if len(attributeTypes) == 0 || len(attributeTypes) > o.InlineObjectMaxAttributes {
+		return false
+	}
+
+	for _, ty := range attributeTypes {
+		if containsStructuralType(ty) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// containsStructuralType returns true if the type is, or has elements of, an
+// object or tuple type.
+func containsStructuralType(ty cty.Type) bool {
+	switch {
+	case ty.IsObjectType(), ty.IsTupleType():
+		return true
+	case ty.IsCollectionType():
+		return containsStructuralType(ty.ElementType())
+	}
+
+	return false
+}
+
+// inlineObjectSentence returns the sentence which lists the attributes of the
+// object type of the nested type, with their types, for example:
+//
+//	Object attributes: `name` (String), `port` (Number).
+func (o *RenderOptions) inlineObjectSentence(nt nestedType) (string, error) {
+	attributeTypes := nt.object.AttributeTypes()
+
+	names := make([]string, 0, len(attributeTypes))
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	o.sortNames(nt.path, names)
+
+	attributes := make([]string, 0, len(names))
+	for _, name := range names {
+		b := &strings.Builder{}
+
+		err := o.writeType(b, childPath(nt.path, name), attributeTypes[name])
+		if err != nil {
+			return "", fmt.Errorf("unable to render attribute %q: %w", name, err)
+		}
+
+		attributes = append(attributes, "`"+name+"` ("+b.String()+")")
+	}
+
+	return "Object attributes: " + strings.Join(attributes, ", ") + ".", nil
+}
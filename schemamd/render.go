@@ -0,0 +1,1440 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package schemamd renders Terraform provider schemas, as exported by the
+// terraform providers schema -json command, as the Markdown of the schema
+// sections of the documentation generated by tfplugindocs. Render renders a
+// whole schema, and RenderOptions configures the layout, such as the style,
+// headings, and nesting of the rendered schema. The output of the same schema
+// and options only changes between releases of tfplugindocs as noted in the
+// changelog.
+package schemamd
+
+import (
+	"bytes"
+	"fmt"
+	"html"
+	"io"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	// StyleDefault renders the root attributes and blocks under a "Schema"
+	// heading, grouped into Required, Optional, and Read-Only sections.
+	StyleDefault = "default"
+
+	// StyleLegacy renders the required and optional root attributes and
+	// blocks under an "Argument Reference" heading and the read-only ones
+	// under an "Attributes Reference" heading, matching the layout of classic
+	// hand-written provider documentation.
+	StyleLegacy = "legacy"
+
+	// StyleTable renders the root attributes and blocks under a "Schema"
+	// heading as a table with name, type, characteristic, and description
+	// columns, ordered by characteristic group. Nested schemas are rendered
+	// as tables in separate sections.
+	StyleTable = "table"
+)
+
+// Styles contains all supported values of RenderOptions.Style.
+var Styles = []string{StyleDefault, StyleLegacy, StyleTable}
+
+// writeOnlyMarker is written in the summary of write-only attributes, which
+// are not persisted to the Terraform plan or state.
+const writeOnlyMarker = "[Write-only](https://developer.hashicorp.com/terraform/language/resources/ephemeral#write-only-arguments)"
+
+// writeOnlyNote is written under the write-only heading of the root block
+// with RenderOptions.WriteOnlySection.
+const writeOnlyNote = "Write-only arguments are not persisted to the Terraform plan or state artifacts. Their values are sent to the provider, but are never stored, so they are not available in outputs or to other resources."
+
+// requiresReplaceNote is written after the description of the attributes of
+// RenderOptions.RequiresReplace.
+const requiresReplaceNote = "Changing this forces a new resource to be created."
+
+const (
+	// SortAlphabetical sorts attributes and blocks by name within each
+	// characteristic group.
+	SortAlphabetical = "alphabetical"
+
+	// SortRequiredFirst renders the attributes and blocks of StyleDefault
+	// as a single list, including the characteristic of each, ordered by
+	// characteristic group and name, instead of a section per group.
+	SortRequiredFirst = "required-first"
+)
+
+// AttributeSorts contains all supported values of RenderOptions.AttributeSort.
+var AttributeSorts = []string{SortAlphabetical, SortRequiredFirst}
+
+// AttributeOrderRest is the entry of RenderOptions.AttributeOrder which
+// stands for the attributes and blocks which are not listed.
+const AttributeOrderRest = "*"
+
+// Names of the headings of RenderOptions.Headings. The required, optional,
+// and read-only headings are also the names of the characteristic groups of
+// RenderOptions.GroupOrder.
+const (
+	HeadingSchema              = "schema"
+	HeadingNestedSchema        = "nested-schema"
+	HeadingRequired            = "required"
+	HeadingOptional            = "optional"
+	HeadingReadOnly            = "read-only"
+	HeadingWriteOnly           = "write-only"
+	HeadingTimeouts            = "timeouts"
+	HeadingArgumentReference   = "argument-reference"
+	HeadingAttributesReference = "attributes-reference"
+)
+
+// DefaultHeadings contains the default text of each heading, by name. The
+// nested schema heading is followed by the path of the nested schema.
+var DefaultHeadings = map[string]string{
+	HeadingSchema:              "Schema",
+	HeadingNestedSchema:        "Nested Schema for",
+	HeadingRequired:            "Required",
+	HeadingOptional:            "Optional",
+	HeadingReadOnly:            "Read-Only",
+	HeadingWriteOnly:           "Write-Only Arguments",
+	HeadingTimeouts:            "Timeouts",
+	HeadingArgumentReference:   "Argument Reference",
+	HeadingAttributesReference: "Attributes Reference",
+}
+
+// Groups contains the names of the characteristic groups of attributes and
+// blocks in their default order.
+var Groups = []string{HeadingRequired, HeadingOptional, HeadingReadOnly}
+
+// RenderOptions configures how a Schema is rendered. A nil *RenderOptions
+// renders a Schema with the defaults.
+type RenderOptions struct {
+	// Style is the layout of the root attributes and blocks, one of Styles.
+	// Empty is equivalent to StyleDefault.
+	Style string
+
+	// InlineNestedDepth is the number of nesting levels for which the
+	// attributes and blocks of a nested schema are rendered inline, as an
+	// indented list under their parent, instead of in a separate "Nested
+	// Schema" section. Nested schemas deeper than this are rendered in
+	// separate sections. The default of 0 renders all nested schemas in
+	// separate sections. It is ignored by StyleTable.
+	InlineNestedDepth int
+
+	// MaxNestedDepth is the number of nesting levels of nested schemas which
+	// are rendered. Nested schemas deeper than this are not rendered, and the
+	// attribute or block is instead followed by a note with a summary of its
+	// type, so deeply nested, or recursive, types do not render huge pages.
+	// The default of 0 renders nested schemas of any depth.
+	MaxNestedDepth int
+
+	// InlineObjectMaxAttributes is the number of attributes of object
+	// attribute types, or of the object elements of collection attribute
+	// types, up to which the attributes of the object are listed, with their
+	// types, after the description of the attribute, instead of in a nested
+	// schema section. Objects with attributes of object types are always
+	// rendered in nested schema sections. The default of 0 renders all
+	// objects in nested schema sections.
+	InlineObjectMaxAttributes int
+
+	// Headings overrides the text of headings, by name, such as
+	// HeadingReadOnly. Headings which are not set use DefaultHeadings.
+	Headings map[string]string
+
+	// GroupOrder is the order of the characteristic groups of StyleDefault and
+	// StyleTable, which must contain each of Groups once. Empty is the order
+	// of Groups.
+	GroupOrder []string
+
+	// AttributeAnchors writes an HTML anchor before every attribute and
+	// block, with the ID returned by AttributeAnchorID, for deep links to
+	// attributes of long pages.
+	AttributeAnchors bool
+
+	// CollapsibleNestedSchemas wraps each nested schema section in an HTML
+	// details element, with the nested schema heading as its summary, so
+	// deeply nested schemas are collapsed by default. It requires an output
+	// target which renders HTML, which the Terraform Registry does not.
+	CollapsibleNestedSchemas bool
+
+	// AttributeSort is the sort order of attributes and blocks, one of
+	// AttributeSorts. Empty is equivalent to SortAlphabetical.
+	AttributeSort string
+
+	// AttributeOrder are the names, or dot separated paths, of attributes
+	// and blocks which are ordered before the others within their group,
+	// in the listed order. Names listed after an AttributeOrderRest entry
+	// are ordered after the others instead, e.g. ["name", "*", "id"].
+	AttributeOrder []string
+
+	// Defaults are the default values of attributes, by dot separated path,
+	// which are written as "Defaults to `<value>`." after the description of
+	// the attribute.
+	Defaults map[string]string
+
+	// Validators are the constraints on the values of attributes, by dot
+	// separated path, which are written as sentences, such as "Allowed
+	// values: `a`, `b`.", after the description of the attribute.
+	Validators map[string][]Validator
+
+	// RequiresReplace are the dot separated paths of the attributes which
+	// force the replacement of the resource when changed, which are written
+	// with a "Changing this forces a new resource to be created." note after
+	// their description.
+	RequiresReplace map[string]bool
+
+	// Deprecations are the replacements of deprecated attributes, by dot
+	// separated path, which are written as a "Use `X` instead." sentence
+	// before the other sentences after the description of the attribute.
+	Deprecations map[string]string
+
+	// TypeSyntax is how the types of attributes are written, one of
+	// TypeSyntaxes. Empty is equivalent to TypeSyntaxDefault.
+	TypeSyntax string
+
+	// TypeNames are the names written instead of the types of attributes,
+	// by dot separated path, such as the name of a custom type of a
+	// provider framework, which the schema only contains the underlying
+	// type of. Nested attributes and blocks have no type to replace.
+	TypeNames map[string]string
+
+	// WriteOnlySection renders the write-only attributes of StyleDefault in
+	// a section of their own, under HeadingWriteOnly, after the
+	// characteristic groups, instead of in their Required or Optional
+	// group. The section of the root block explains that write-only
+	// attributes are not persisted to the plan or state.
+	WriteOnlySection bool
+
+	// TimeoutsSection renders the conventional "timeouts" block, or single
+	// nested attribute, of the root block in a section of its own, under
+	// HeadingTimeouts, after the rest of the schema, which lists each timeout
+	// with its value in Defaults, instead of in a nested schema section.
+	TimeoutsSection bool
+}
+
+// ValidateAttributeOrder returns an error if the attribute order contains an
+// empty entry, or more than one AttributeOrderRest entry.
+func ValidateAttributeOrder(attributeOrder []string) error {
+	rest := 0
+
+	for _, entry := range attributeOrder {
+		if entry == "" {
+			return fmt.Errorf("expected attribute order entries to be attribute names or paths, got an empty entry")
+		}
+
+		if entry == AttributeOrderRest {
+			rest++
+		}
+	}
+
+	if rest > 1 {
+		return fmt.Errorf("expected attribute order to contain %q at most once", AttributeOrderRest)
+	}
+
+	return nil
+}
+
+// AttributeAnchorID returns the ID of the anchor of the attribute or block at
+// path, which is written with RenderOptions.AttributeAnchors. The ID is the
+// lowercase path, with underscores replaced by hyphens and joined by hyphens,
+// prefixed with "attr-", e.g. "attr-versioning-enabled" for the enabled
+// attribute of the versioning block.
+func AttributeAnchorID(path []string) string {
+	id := strings.ToLower(strings.Join(path, "-"))
+
+	return "attr-" + strings.ReplaceAll(id, "_", "-")
+}
+
+// ValidateGroupOrder returns an error if the group order does not contain
+// each of Groups once. An empty group order is valid.
+func ValidateGroupOrder(groupOrder []string) error {
+	if len(groupOrder) == 0 {
+		return nil
+	}
+
+	seen := make(map[string]bool, len(groupOrder))
+	for _, name := range groupOrder {
+		if groupIndex(name) < 0 {
+			return fmt.Errorf("unsupported schema group %q, expected one of: %s", name, strings.Join(Groups, ", "))
+		}
+
+		if seen[name] {
+			return fmt.Errorf("duplicate schema group %q", name)
+		}
+		seen[name] = true
+	}
+
+	if len(seen) != len(Groups) {
+		return fmt.Errorf("expected schema group order to contain each of: %s", strings.Join(Groups, ", "))
+	}
+
+	return nil
+}
+
+// groupIndex returns the index of the named group in groupFilters, or -1.
+func groupIndex(name string) int {
+	for i, gf := range groupFilters {
+		if gf.name == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// style returns the configured Style, defaulting to StyleDefault.
+func (o *RenderOptions) style() string {
+	if o == nil || o.Style == "" {
+		return StyleDefault
+	}
+
+	return o.Style
+}
+
+// inline returns true if the nested schema of the attribute or block at path
+// should be rendered inline.
+func (o *RenderOptions) inline(path []string) bool {
+	return o != nil && len(path) <= o.InlineNestedDepth
+}
+
+// heading returns the configured text of the named heading, defaulting to
+// DefaultHeadings.
+func (o *RenderOptions) heading(name string) string {
+	if o != nil && o.Headings[name] != "" {
+		return o.Headings[name]
+	}
+
+	return DefaultHeadings[name]
+}
+
+// attributeAnchor returns the HTML anchor of the attribute or block at path,
+// or an empty string if AttributeAnchors is not set.
+func (o *RenderOptions) attributeAnchor(path []string) string {
+	if o == nil || !o.AttributeAnchors {
+		return ""
+	}
+
+	return "<a id=\"" + AttributeAnchorID(path) + "\"></a>"
+}
+
+// singleList returns true if the attributes and blocks of StyleDefault are
+// rendered as a single list, instead of a section per characteristic group.
+func (o *RenderOptions) singleList() bool {
+	return o != nil && o.AttributeSort == SortRequiredFirst
+}
+
+// sortNames sorts the names of the attributes and blocks of the nested schema
+// at parents, which is nil for the root block, by name, then by their entry
+// in AttributeOrder.
+func (o *RenderOptions) sortNames(parents []string, names []string) {
+	sort.Strings(names)
+
+	if o == nil || len(o.AttributeOrder) == 0 {
+		return
+	}
+
+	rest := len(o.AttributeOrder)
+	for i, entry := range o.AttributeOrder {
+		if entry == AttributeOrderRest {
+			rest = i
+		}
+	}
+
+	rank := func(name string) int {
+		path := strings.Join(childPath(parents, name), ".")
+
+		for i, entry := range o.AttributeOrder {
+			if entry == name || entry == path {
+				return i
+			}
+		}
+
+		return rest
+	}
+
+	sort.SliceStable(names, func(i, j int) bool {
+		return rank(names[i]) < rank(names[j])
+	})
+}
+
+// attributeSentences returns the sentences of the deprecation, the
+// validators, the default value, and the replacement note of the attribute at
+// path, or an empty string if it has none of them.
+func (o *RenderOptions) attributeSentences(path []string) string {
+	if o == nil {
+		return ""
+	}
+
+	key := strings.Join(path, ".")
+
+	var sentences []string
+	if replacement := o.Deprecations[key]; replacement != "" {
+		sentences = append(sentences, "Use "+code(replacement)+" instead.")
+	}
+
+	for _, v := range o.Validators[key] {
+		sentences = append(sentences, validatorSentence(v))
+	}
+
+	if value, ok := o.Defaults[key]; ok {
+		sentences = append(sentences, "Defaults to "+code(value)+".")
+	}
+
+	if o.RequiresReplace[key] {
+		sentences = append(sentences, requiresReplaceNote)
+	}
+
+	return strings.Join(sentences, " ")
+}
+
+// writeOnlySection returns true if write-only attributes are rendered in a
+// section of their own, which is not the case for a single list.
+func (o *RenderOptions) writeOnlySection() bool {
+	return o != nil && o.WriteOnlySection && !o.singleList()
+}
+
+// collapsible returns true if nested schema sections are wrapped in details
+// elements.
+func (o *RenderOptions) collapsible() bool {
+	return o != nil && o.CollapsibleNestedSchemas
+}
+
+// groupTitle returns the title of the group, which is a heading for root
+// attributes and blocks, otherwise a label.
+func (o *RenderOptions) groupTitle(gf groupFilter, root bool) string {
+	if root {
+		return "### " + o.heading(gf.name)
+	}
+
+	return o.heading(gf.name) + ":"
+}
+
+// groupOrder returns the indexes of groupFilters in the configured
+// GroupOrder, defaulting to the order of groupFilters.
+func (o *RenderOptions) groupOrder() []int {
+	order := make([]int, 0, len(groupFilters))
+
+	if o == nil || len(o.GroupOrder) == 0 {
+		for i := range groupFilters {
+			order = append(order, i)
+		}
+
+		return order
+	}
+
+	for _, name := range o.GroupOrder {
+		order = append(order, groupIndex(name))
+	}
+
+	return order
+}
+
+// Render writes a Markdown formatted Schema definition to the specified writer.
+// A Schema contains a Version and the root Block, for example:
+//
+//	"aws_accessanalyzer_analyzer": {
+//	  "block": {
+//	  },
+//		 "version": 0
+//	},
+//
+// The opts may be nil, which renders the schema with the defaults.
+func Render(schema *tfjson.Schema, w io.Writer, opts *RenderOptions) error {
+	if opts != nil {
+		err := ValidateGroupOrder(opts.GroupOrder)
+		if err != nil {
+			return err
+		}
+
+		err = ValidateAttributeOrder(opts.AttributeOrder)
+		if err != nil {
+			return err
+		}
+
+		paths := make([]string, 0, len(opts.Validators))
+		for path := range opts.Validators {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			err = ValidateValidators(opts.Validators[path])
+			if err != nil {
+				return fmt.Errorf("invalid validator of %q: %w", path, err)
+			}
+		}
+
+		if opts.AttributeSort != "" && !slices.Contains(AttributeSorts, opts.AttributeSort) {
+			return fmt.Errorf("unsupported attribute sort %q, expected one of: %s", opts.AttributeSort, strings.Join(AttributeSorts, ", "))
+		}
+
+		err = ValidateMaxNestedDepth(opts.MaxNestedDepth)
+		if err != nil {
+			return err
+		}
+
+		err = ValidateInlineObjectMaxAttributes(opts.InlineObjectMaxAttributes)
+		if err != nil {
+			return err
+		}
+
+		if opts.TypeSyntax != "" && !slices.Contains(TypeSyntaxes, opts.TypeSyntax) {
+			return fmt.Errorf("unsupported type syntax %q, expected one of: %s", opts.TypeSyntax, strings.Join(TypeSyntaxes, ", "))
+		}
+	}
+
+	block, timeouts := opts.splitTimeouts(schema.Block)
+
+	// The timeouts section follows the schema after a single blank line, so
+	// the schema is rendered first to trim its trailing blank lines.
+	out := w
+	schemaBuffer := &bytes.Buffer{}
+	if timeouts != nil {
+		w = schemaBuffer
+	}
+
+	switch opts.style() {
+	case StyleDefault:
+		_, err := io.WriteString(w, "## "+opts.heading(HeadingSchema)+"\n\n")
+		if err != nil {
+			return err
+		}
+
+		err = writeRootBlock(w, block, opts)
+		if err != nil {
+			return fmt.Errorf("unable to render schema: %w", err)
+		}
+	case StyleLegacy:
+		err := writeLegacyRootBlock(w, block, opts)
+		if err != nil {
+			return fmt.Errorf("unable to render schema: %w", err)
+		}
+	case StyleTable:
+		_, err := io.WriteString(w, "## "+opts.heading(HeadingSchema)+"\n\n")
+		if err != nil {
+			return err
+		}
+
+		err = writeTableBlockChildren(w, nil, block, opts)
+		if err != nil {
+			return fmt.Errorf("unable to render schema: %w", err)
+		}
+	default:
+		return fmt.Errorf("unsupported schema style %q, expected one of: %s", opts.style(), strings.Join(Styles, ", "))
+	}
+
+	if timeouts != nil {
+		_, err := io.WriteString(out, strings.TrimRight(schemaBuffer.String(), "\n")+"\n\n")
+		if err != nil {
+			return err
+		}
+
+		err = writeTimeouts(out, timeouts, opts)
+		if err != nil {
+			return fmt.Errorf("unable to render timeouts: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Group by Attribute/Block characteristics.
+type groupFilter struct {
+	// name is the name of the group in Groups, which is also the name of its
+	// heading.
+	name string
+
+	filterAttribute func(att *tfjson.SchemaAttribute) bool
+	filterBlock     func(block *tfjson.SchemaBlockType) bool
+}
+
+var (
+	// Attributes and Blocks are in one of 3 characteristic groups:
+	// * Required
+	// * Optional
+	// * Read-Only
+	groupFilters = []groupFilter{
+		{HeadingRequired, childAttributeIsRequired, childBlockIsRequired},
+		{HeadingOptional, childAttributeIsOptional, childBlockIsOptional},
+		{HeadingReadOnly, childAttributeIsReadOnly, childBlockIsReadOnly},
+	}
+)
+
+type nestedType struct {
+	anchorID  string
+	pathTitle string
+	path      []string
+	block     *tfjson.SchemaBlock
+	object    *cty.Type
+	attrs     *tfjson.SchemaNestedAttributeType
+
+	group groupFilter
+}
+
+func writeAttribute(w io.Writer, path []string, att *tfjson.SchemaAttribute, group groupFilter, opts *RenderOptions, includeRW bool) ([]nestedType, error) {
+	name := path[len(path)-1]
+
+	_, err := io.WriteString(w, "- "+opts.attributeAnchor(path)+"`"+name+"` ")
+	if err != nil {
+		return nil, err
+	}
+
+	if att.AttributeNestedType == nil {
+		err = writeAttributeDescription(w, path, att, includeRW, opts)
+	} else {
+		err = WriteNestedAttributeTypeDescription(w, att, includeRW)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if sentences := opts.attributeSentences(path); sentences != "" {
+		_, err = io.WriteString(w, " "+sentences)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if att.AttributeType.IsTupleType() {
+		return nil, fmt.Errorf("TODO: tuples are not yet supported")
+	}
+
+	anchorID := "nestedatt--" + strings.Join(path, "--")
+	pathTitle := strings.Join(path, ".")
+	switch {
+	case att.AttributeNestedType != nil:
+		return writeNestedTypeReference(w, nestedType{
+			anchorID:  anchorID,
+			pathTitle: pathTitle,
+			path:      path,
+			attrs:     att.AttributeNestedType,
+
+			group: group,
+		}, opts)
+	case att.AttributeType.IsObjectType():
+		return writeNestedTypeReference(w, nestedType{
+			anchorID:  anchorID,
+			pathTitle: pathTitle,
+			path:      path,
+			object:    &att.AttributeType,
+
+			group: group,
+		}, opts)
+	case att.AttributeType.IsCollectionType() && att.AttributeType.ElementType().IsObjectType():
+		nt := att.AttributeType.ElementType()
+		return writeNestedTypeReference(w, nestedType{
+			anchorID:  anchorID,
+			pathTitle: pathTitle,
+			path:      path,
+			object:    &nt,
+
+			group: group,
+		}, opts)
+	}
+
+	_, err = io.WriteString(w, "\n")
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func writeBlockType(w io.Writer, path []string, block *tfjson.SchemaBlockType, opts *RenderOptions, includeRW bool) ([]nestedType, error) {
+	name := path[len(path)-1]
+
+	_, err := io.WriteString(w, "- "+opts.attributeAnchor(path)+"`"+name+"` ")
+	if err != nil {
+		return nil, err
+	}
+
+	err = writeBlockTypeDescription(w, block, includeRW)
+	if err != nil {
+		return nil, fmt.Errorf("unable to write block description for %q: %w", name, err)
+	}
+
+	anchorID := "nestedblock--" + strings.Join(path, "--")
+	pathTitle := strings.Join(path, ".")
+	nt := nestedType{
+		anchorID:  anchorID,
+		pathTitle: pathTitle,
+		path:      path,
+		block:     block.Block,
+	}
+
+	return writeNestedTypeReference(w, nt, opts)
+}
+
+// writeNestedTypeReference ends the list item of the attribute or block with
+// the nested type. The nested type is either written inline, as an indented
+// list under the item, or linked to, in which case it is returned so its
+// section can be written after the current list. Nested types which are too
+// deeply nested to be written inline are also returned. Nested types deeper
+// than MaxNestedDepth are summarized instead, and small object types are
+// listed after the description with InlineObjectMaxAttributes.
+func writeNestedTypeReference(w io.Writer, nt nestedType, opts *RenderOptions) ([]nestedType, error) {
+	if opts.truncated(nt.path) {
+		return nil, writeTruncatedNestedType(w, nt, opts)
+	}
+
+	if opts.inlineObject(nt) {
+		sentence, err := opts.inlineObjectSentence(nt)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = io.WriteString(w, " "+sentence+"\n")
+		return nil, err
+	}
+
+	if !opts.inline(nt.path) {
+		_, err := io.WriteString(w, " (see [below for nested schema](#"+nt.anchorID+"))\n")
+		if err != nil {
+			return nil, err
+		}
+
+		return []nestedType{nt}, nil
+	}
+
+	_, err := io.WriteString(w, "\n")
+	if err != nil {
+		return nil, err
+	}
+
+	b := &strings.Builder{}
+
+	nestedTypes, err := writeInlineNestedType(b, nt, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, line := range strings.SplitAfter(b.String(), "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		_, err = io.WriteString(w, "  "+line)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return nestedTypes, nil
+}
+
+// writeInlineNestedType writes the attributes and blocks of the nested type
+// as a list, including whether each is required, optional, or read-only, as
+// there are no group titles.
+func writeInlineNestedType(w io.Writer, nt nestedType, opts *RenderOptions) ([]nestedType, error) {
+	nestedTypes := []nestedType{}
+
+	switch {
+	case nt.block != nil:
+		groups, err := groupBlockChildren(nt.path, nt.block)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, i := range opts.groupOrder() {
+			gf := groupFilters[i]
+			sortedNames := groups[i]
+			opts.sortNames(nt.path, sortedNames)
+
+			for _, name := range sortedNames {
+				path := childPath(nt.path, name)
+
+				var childNestedTypes []nestedType
+				if childBlock, ok := nt.block.NestedBlocks[name]; ok {
+					childNestedTypes, err = writeBlockType(w, path, childBlock, opts, false)
+					if err != nil {
+						return nil, fmt.Errorf("unable to render block %q: %w", name, err)
+					}
+				} else {
+					childNestedTypes, err = writeAttribute(w, path, nt.block.Attributes[name], gf, opts, true)
+					if err != nil {
+						return nil, fmt.Errorf("unable to render attribute %q: %w", name, err)
+					}
+				}
+
+				nestedTypes = append(nestedTypes, childNestedTypes...)
+			}
+		}
+	case nt.object != nil:
+		atts := nt.object.AttributeTypes()
+		sortedNames := []string{}
+		for n := range atts {
+			sortedNames = append(sortedNames, n)
+		}
+		opts.sortNames(nt.path, sortedNames)
+
+		for _, name := range sortedNames {
+			childNestedTypes, err := writeObjectAttribute(w, childPath(nt.path, name), atts[name], nt.group, opts)
+			if err != nil {
+				return nil, fmt.Errorf("unable to render attribute %q: %w", name, err)
+			}
+
+			nestedTypes = append(nestedTypes, childNestedTypes...)
+		}
+	case nt.attrs != nil:
+		groups := groupNestedAttributes(nt.path, nt.attrs, opts)
+
+		for _, i := range opts.groupOrder() {
+			for _, name := range groups[i] {
+				childNestedTypes, err := writeAttribute(w, childPath(nt.path, name), nt.attrs.Attributes[name], nt.group, opts, true)
+				if err != nil {
+					return nil, fmt.Errorf("unable to render attribute %q: %w", name, err)
+				}
+
+				nestedTypes = append(nestedTypes, childNestedTypes...)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("missing information on nested block: %s", strings.Join(nt.path, "."))
+	}
+
+	return nestedTypes, nil
+}
+
+// childPath returns a copy of parents with name appended.
+func childPath(parents []string, name string) []string {
+	path := make([]string, len(parents), len(parents)+1)
+	copy(path, parents)
+	return append(path, name)
+}
+
+func writeRootBlock(w io.Writer, block *tfjson.SchemaBlock, opts *RenderOptions) error {
+	return writeBlockChildren(w, nil, block, true, opts)
+}
+
+// writeLegacyRootBlock writes the root attributes and blocks in the
+// StyleLegacy layout. Required and optional attributes and blocks are written
+// as one list, including their characteristic, under an "Argument Reference"
+// heading, followed by the read-only attributes and blocks under an
+// "Attributes Reference" heading. Nested types are written after the list
+// which references them.
+func writeLegacyRootBlock(w io.Writer, block *tfjson.SchemaBlock, opts *RenderOptions) error {
+	groups, err := groupBlockChildren(nil, block)
+	if err != nil {
+		return err
+	}
+
+	hasArguments := len(groups[0]) > 0 || len(groups[1]) > 0
+
+	attributesIntro := "The following attributes are exported:"
+	if hasArguments {
+		attributesIntro = "In addition to all arguments above, the following attributes are exported:"
+	}
+
+	sections := []struct {
+		title     string
+		intro     string
+		groups    []int
+		includeRW bool
+	}{
+		{"## " + opts.heading(HeadingArgumentReference), "The following arguments are supported:", []int{0, 1}, true},
+		{"## " + opts.heading(HeadingAttributesReference), attributesIntro, []int{2}, false},
+	}
+
+	for _, section := range sections {
+		var names []string
+		var nameGroups []groupFilter
+		for _, i := range section.groups {
+			sortedNames := groups[i]
+			opts.sortNames(nil, sortedNames)
+
+			for _, name := range sortedNames {
+				names = append(names, name)
+				nameGroups = append(nameGroups, groupFilters[i])
+			}
+		}
+
+		if len(names) == 0 {
+			continue
+		}
+
+		// Each section is buffered so that the trailing blank lines of its
+		// nested types do not accumulate before the next section heading.
+		b := &strings.Builder{}
+		b.WriteString(section.title + "\n\n" + section.intro + "\n\n")
+
+		nestedTypes := []nestedType{}
+
+		for i, name := range names {
+			path := childPath(nil, name)
+
+			if childBlock, ok := block.NestedBlocks[name]; ok {
+				nt, err := writeBlockType(b, path, childBlock, opts, section.includeRW)
+				if err != nil {
+					return fmt.Errorf("unable to render block %q: %w", name, err)
+				}
+
+				nestedTypes = append(nestedTypes, nt...)
+				continue
+			} else if childAtt, ok := block.Attributes[name]; ok {
+				nt, err := writeAttribute(b, path, childAtt, nameGroups[i], opts, section.includeRW)
+				if err != nil {
+					return fmt.Errorf("unable to render attribute %q: %w", name, err)
+				}
+
+				nestedTypes = append(nestedTypes, nt...)
+				continue
+			}
+
+			return fmt.Errorf("unexpected name in schema render %q", name)
+		}
+
+		b.WriteString("\n")
+
+		err = writeNestedTypes(b, nestedTypes, opts)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(w, strings.TrimRight(b.String(), "\n")+"\n\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// A Block contains:
+// * Attributes (arbitrarily nested)
+// * Nested Blocks (with nesting mode, max and min items)
+// * Description(Kind)
+// * Deprecated flag
+// For example:
+//
+//	"block": {
+//	  "attributes": {
+//	    "certificate_arn": {
+//		     "description_kind": "plain",
+//		     "required": true,
+//		     "type": "string"
+//	    }
+//		 },
+//		 "block_types": {
+//	    "timeouts": {
+//		     "block": {
+//			   "attributes": {
+//			   },
+//			   "description_kind": "plain"
+//		     },
+//		     "nesting_mode": "single"
+//	    }
+//		 },
+//		 "description_kind": "plain"
+//	},
+func writeBlockChildren(w io.Writer, parents []string, block *tfjson.SchemaBlock, root bool, opts *RenderOptions) error {
+	groups, err := groupBlockChildren(parents, block)
+	if err != nil {
+		return err
+	}
+
+	nestedTypes := []nestedType{}
+
+	// For each characteristic group
+	//   If Attribute
+	//     Write out summary including characteristic and type (if primitive type or collection of primitives)
+	//     If NestedAttribute type, Object type or collection of Objects, add to list of nested types
+	//   ElseIf Block
+	//     Write out summary including characteristic
+	//     Add block to list of nested types
+	//   End
+	// End
+	// For each nested type:
+	//   Write out heading
+	//   If Block
+	//     Recursively call this function (writeBlockChildren)
+	//   ElseIf Object
+	//     Call writeObjectChildren, which
+	//       For each Object Attribute
+	//         Write out summary including characteristic and type (if primitive type or collection of primitives)
+	//         If Object type or collection of Objects, add to list of nested types
+	//       End
+	//       Recursively do nested type functionality
+	//   ElseIf NestedAttribute
+	//     Call writeNestedAttributeChildren, which
+	//       For each nested Attribute
+	//         Write out summary including characteristic and type (if primitive type or collection of primitives)
+	//         If NestedAttribute type, Object type or collection of Objects, add to list of nested types
+	//       End
+	//       Recursively do nested type functionality
+	//   End
+	// End
+	//
+	// Nested types within the configured inline depth are instead written
+	// under their parent attribute or block summary (writeNestedTypeReference).
+	//
+	// With a single list, the group titles are omitted and the summaries
+	// include the characteristic instead.
+	singleList := opts.singleList()
+	written := false
+
+	var writeOnlyNames []string
+	if opts.writeOnlySection() {
+		writeOnlyNames = splitWriteOnly(groups, block.Attributes)
+	}
+
+	for _, i := range opts.groupOrder() {
+		gf := groupFilters[i]
+		sortedNames := groups[i]
+		if len(sortedNames) == 0 {
+			continue
+		}
+		opts.sortNames(parents, sortedNames)
+		written = true
+
+		if !singleList {
+			_, err := io.WriteString(w, opts.groupTitle(gf, root)+"\n\n")
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, name := range sortedNames {
+			path := childPath(parents, name)
+
+			if childBlock, ok := block.NestedBlocks[name]; ok {
+				nt, err := writeBlockType(w, path, childBlock, opts, singleList)
+				if err != nil {
+					return fmt.Errorf("unable to render block %q: %w", name, err)
+				}
+
+				nestedTypes = append(nestedTypes, nt...)
+				continue
+			} else if childAtt, ok := block.Attributes[name]; ok {
+				nt, err := writeAttribute(w, path, childAtt, gf, opts, singleList)
+				if err != nil {
+					return fmt.Errorf("unable to render attribute %q: %w", name, err)
+				}
+
+				nestedTypes = append(nestedTypes, nt...)
+				continue
+			}
+
+			return fmt.Errorf("unexpected name in schema render %q", name)
+		}
+
+		if !singleList {
+			_, err := io.WriteString(w, "\n")
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if singleList && written {
+		_, err := io.WriteString(w, "\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(writeOnlyNames) > 0 {
+		nt, err := writeWriteOnlyAttributes(w, parents, writeOnlyNames, block.Attributes, nil, root, opts)
+		if err != nil {
+			return err
+		}
+
+		nestedTypes = append(nestedTypes, nt...)
+	}
+
+	err = writeNestedTypes(w, nestedTypes, opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// groupBlockChildren groups the names of the attributes and blocks of block
+// by the index of their characteristic group in groupFilters.
+func groupBlockChildren(parents []string, block *tfjson.SchemaBlock) (map[int][]string, error) {
+	names := []string{}
+	for n := range block.Attributes {
+		names = append(names, n)
+	}
+	for n := range block.NestedBlocks {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+
+	groups := map[int][]string{}
+
+	// Group Attributes/Blocks by characteristics.
+nameLoop:
+	for _, n := range names {
+		if childBlock, ok := block.NestedBlocks[n]; ok {
+			for i, gf := range groupFilters {
+				if gf.filterBlock(childBlock) {
+					groups[i] = append(groups[i], n)
+					continue nameLoop
+				}
+			}
+		} else if childAtt, ok := block.Attributes[n]; ok {
+			for i, gf := range groupFilters {
+				// By default, the attribute `id` is place in the "Read-Only" group
+				// if the provider schema contained no `.Description` for it.
+				//
+				// If a `.Description` is provided instead, the behaviour will be the
+				// same as for every other attribute.
+				if strings.ToLower(n) == "id" && len(parents) == 0 && childAtt.Description == "" {
+					if gf.name == HeadingReadOnly {
+						childAtt.Description = "The ID of this resource."
+						groups[i] = append(groups[i], n)
+						continue nameLoop
+					}
+				} else if gf.filterAttribute(childAtt) {
+					groups[i] = append(groups[i], n)
+					continue nameLoop
+				}
+			}
+		}
+
+		return nil, fmt.Errorf("no match for %q, this can happen if you have incompatible schema defined, for example an "+
+			"optional block where all the child attributes are computed, in which case the block itself should also "+
+			"be marked computed", n)
+	}
+
+	return groups, nil
+}
+
+// writeNestedTypeStart writes the anchor and heading of the section of the
+// nested type, or with CollapsibleNestedSchemas, the anchor and the start of
+// the details element of the section.
+func writeNestedTypeStart(w io.Writer, nt nestedType, opts *RenderOptions) error {
+	_, err := io.WriteString(w, "<a id=\""+nt.anchorID+"\"></a>\n")
+	if err != nil {
+		return err
+	}
+
+	if opts.collapsible() {
+		_, err = io.WriteString(w, "<details>\n<summary>"+html.EscapeString(opts.heading(HeadingNestedSchema))+" <code>"+html.EscapeString(nt.pathTitle)+"</code></summary>\n\n")
+		return err
+	}
+
+	_, err = io.WriteString(w, "### "+opts.heading(HeadingNestedSchema)+" `"+nt.pathTitle+"`\n\n")
+	return err
+}
+
+// writeNestedTypeEnd writes the end of the details element of the section of
+// a nested type with CollapsibleNestedSchemas.
+func writeNestedTypeEnd(w io.Writer, opts *RenderOptions) error {
+	if !opts.collapsible() {
+		return nil
+	}
+
+	_, err := io.WriteString(w, "</details>\n\n")
+	return err
+}
+
+func writeNestedTypes(w io.Writer, nestedTypes []nestedType, opts *RenderOptions) error {
+	for _, nt := range nestedTypes {
+		err := writeNestedTypeStart(w, nt, opts)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case nt.block != nil:
+			err = writeBlockChildren(w, nt.path, nt.block, false, opts)
+			if err != nil {
+				return err
+			}
+		case nt.object != nil:
+			err = writeObjectChildren(w, nt.path, *nt.object, nt.group, opts)
+			if err != nil {
+				return err
+			}
+		case nt.attrs != nil:
+			err = writeNestedAttributeChildren(w, nt.path, nt.attrs, nt.group, opts)
+			if err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("missing information on nested block: %s", strings.Join(nt.path, "."))
+		}
+
+		err = writeNestedTypeEnd(w, opts)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.WriteString(w, "\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeObjectAttribute(w io.Writer, path []string, att cty.Type, group groupFilter, opts *RenderOptions) ([]nestedType, error) {
+	name := path[len(path)-1]
+
+	_, err := io.WriteString(w, "- "+opts.attributeAnchor(path)+"`"+name+"` (")
+	if err != nil {
+		return nil, err
+	}
+
+	err = opts.writeType(w, path, att)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = io.WriteString(w, ")")
+	if err != nil {
+		return nil, err
+	}
+
+	if att.IsTupleType() {
+		return nil, fmt.Errorf("TODO: tuples are not yet supported")
+	}
+
+	anchorID := "nestedobjatt--" + strings.Join(path, "--")
+	pathTitle := strings.Join(path, ".")
+	switch {
+	case att.IsObjectType():
+		return writeNestedTypeReference(w, nestedType{
+			anchorID:  anchorID,
+			pathTitle: pathTitle,
+			path:      path,
+			object:    &att,
+
+			group: group,
+		}, opts)
+	case att.IsCollectionType() && att.ElementType().IsObjectType():
+		nt := att.ElementType()
+		return writeNestedTypeReference(w, nestedType{
+			anchorID:  anchorID,
+			pathTitle: pathTitle,
+			path:      path,
+			object:    &nt,
+
+			group: group,
+		}, opts)
+	}
+
+	_, err = io.WriteString(w, "\n")
+	if err != nil {
+		return nil, err
+	}
+
+	return nil, nil
+}
+
+func writeObjectChildren(w io.Writer, parents []string, ty cty.Type, group groupFilter, opts *RenderOptions) error {
+	_, err := io.WriteString(w, opts.groupTitle(group, false)+"\n\n")
+	if err != nil {
+		return err
+	}
+
+	atts := ty.AttributeTypes()
+	sortedNames := []string{}
+	for n := range atts {
+		sortedNames = append(sortedNames, n)
+	}
+	opts.sortNames(parents, sortedNames)
+	nestedTypes := []nestedType{}
+
+	for _, name := range sortedNames {
+		att := atts[name]
+		path := make([]string, len(parents), len(parents)+1)
+		copy(path, parents)
+		path = append(path, name)
+
+		nt, err := writeObjectAttribute(w, path, att, group, opts)
+		if err != nil {
+			return fmt.Errorf("unable to render attribute %q: %w", name, err)
+		}
+
+		nestedTypes = append(nestedTypes, nt...)
+	}
+
+	_, err = io.WriteString(w, "\n")
+	if err != nil {
+		return err
+	}
+
+	err = writeNestedTypes(w, nestedTypes, opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func writeNestedAttributeChildren(w io.Writer, parents []string, nestedAttributes *tfjson.SchemaNestedAttributeType, group groupFilter, opts *RenderOptions) error {
+	groups := groupNestedAttributes(parents, nestedAttributes, opts)
+
+	nestedTypes := []nestedType{}
+
+	singleList := opts.singleList()
+	written := false
+
+	var writeOnlyNames []string
+	if opts.writeOnlySection() {
+		writeOnlyNames = splitWriteOnly(groups, nestedAttributes.Attributes)
+	}
+
+	for _, i := range opts.groupOrder() {
+		names, ok := groups[i]
+		if !ok || len(names) == 0 {
+			continue
+		}
+		written = true
+
+		if !singleList {
+			_, err := io.WriteString(w, opts.groupTitle(groupFilters[i], false)+"\n\n")
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, name := range names {
+			att := nestedAttributes.Attributes[name]
+			path := make([]string, len(parents), len(parents)+1)
+			copy(path, parents)
+			path = append(path, name)
+
+			nt, err := writeAttribute(w, path, att, group, opts, singleList)
+			if err != nil {
+				return fmt.Errorf("unable to render attribute %q: %w", name, err)
+			}
+
+			nestedTypes = append(nestedTypes, nt...)
+		}
+
+		if !singleList {
+			_, err := io.WriteString(w, "\n")
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if singleList && written {
+		_, err := io.WriteString(w, "\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(writeOnlyNames) > 0 {
+		nt, err := writeWriteOnlyAttributes(w, parents, writeOnlyNames, nestedAttributes.Attributes, &group, false, opts)
+		if err != nil {
+			return err
+		}
+
+		nestedTypes = append(nestedTypes, nt...)
+	}
+
+	err := writeNestedTypes(w, nestedTypes, opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// splitWriteOnly removes the names of write-only attributes from the groups,
+// and returns them.
+func splitWriteOnly(groups map[int][]string, attributes map[string]*tfjson.SchemaAttribute) []string {
+	var writeOnlyNames []string
+
+	for i, names := range groups {
+		kept := make([]string, 0, len(names))
+
+		for _, name := range names {
+			if att, ok := attributes[name]; ok && att.WriteOnly {
+				writeOnlyNames = append(writeOnlyNames, name)
+				continue
+			}
+
+			kept = append(kept, name)
+		}
+
+		groups[i] = kept
+	}
+
+	return writeOnlyNames
+}
+
+// writeWriteOnlyAttributes writes the section of the write-only attributes at
+// parents, which include their characteristic. The section of the root block
+// is a heading followed by an explanation of write-only attributes. The
+// attributes have the given group, such as the group of their parent nested
+// attribute, or otherwise their own characteristic group.
+func writeWriteOnlyAttributes(w io.Writer, parents []string, names []string, attributes map[string]*tfjson.SchemaAttribute, group *groupFilter, root bool, opts *RenderOptions) ([]nestedType, error) {
+	opts.sortNames(parents, names)
+
+	title := opts.groupTitle(groupFilter{name: HeadingWriteOnly}, root) + "\n\n"
+	if root {
+		title += writeOnlyNote + "\n\n"
+	}
+
+	_, err := io.WriteString(w, title)
+	if err != nil {
+		return nil, err
+	}
+
+	nestedTypes := []nestedType{}
+
+	for _, name := range names {
+		att := attributes[name]
+
+		gf := groupFilters[0]
+		if group != nil {
+			gf = *group
+		} else {
+			for _, f := range groupFilters {
+				if f.filterAttribute(att) {
+					gf = f
+					break
+				}
+			}
+		}
+
+		nt, err := writeAttribute(w, childPath(parents, name), att, gf, opts, true)
+		if err != nil {
+			return nil, fmt.Errorf("unable to render attribute %q: %w", name, err)
+		}
+
+		nestedTypes = append(nestedTypes, nt...)
+	}
+
+	_, err = io.WriteString(w, "\n")
+	if err != nil {
+		return nil, err
+	}
+
+	return nestedTypes, nil
+}
+
+// groupNestedAttributes groups the sorted names of the nested attributes at
+// parents by the index of their characteristic group in groupFilters.
+func groupNestedAttributes(parents []string, nestedAttributes *tfjson.SchemaNestedAttributeType, opts *RenderOptions) map[int][]string {
+	sortedNames := []string{}
+	for n := range nestedAttributes.Attributes {
+		sortedNames = append(sortedNames, n)
+	}
+	sort.Strings(sortedNames)
+
+	groups := map[int][]string{}
+	for _, name := range sortedNames {
+		att := nestedAttributes.Attributes[name]
+
+		for i, gf := range groupFilters {
+			if gf.filterAttribute(att) {
+				groups[i] = append(groups[i], name)
+			}
+		}
+	}
+
+	for _, names := range groups {
+		opts.sortNames(parents, names)
+	}
+
+	return groups
+}
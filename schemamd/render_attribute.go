@@ -0,0 +1,100 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// RenderAttribute writes the documentation of the single attribute, or block,
+// at the dot separated path of the schema, which is its name, type, markers,
+// description, and the sentences of its metadata, without a list marker or
+// its nested schema, so it can be included in prose, for example:
+//
+//	`versioning.enabled` (Boolean, Optional) Whether versioning is enabled. Defaults to `false`.
+func RenderAttribute(schema *tfjson.Schema, path string, w io.Writer, opts *RenderOptions) error {
+	if schema == nil || schema.Block == nil {
+		return fmt.Errorf("expected a schema, got nil")
+	}
+
+	parts := strings.Split(path, ".")
+
+	att, block, err := findAttribute(schema.Block, parts)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, code(path)+" ")
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case block != nil:
+		err = writeBlockTypeDescription(w, block, true)
+	case att.AttributeNestedType != nil:
+		err = WriteNestedAttributeTypeDescription(w, att, true)
+	default:
+		err = writeAttributeDescription(w, parts, att, true, opts)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to render attribute %q: %w", path, err)
+	}
+
+	if sentences := opts.attributeSentences(parts); sentences != "" {
+		_, err = io.WriteString(w, " "+sentences)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// findAttribute returns the attribute, or block, at the path of the block,
+// through nested blocks and nested attributes.
+func findAttribute(block *tfjson.SchemaBlock, path []string) (*tfjson.SchemaAttribute, *tfjson.SchemaBlockType, error) {
+	attributes := block.Attributes
+	blocks := block.NestedBlocks
+
+	for i, name := range path {
+		last := i == len(path)-1
+
+		if att, ok := attributes[name]; ok && att != nil {
+			if last {
+				return att, nil, nil
+			}
+
+			if att.AttributeNestedType == nil {
+				return nil, nil, fmt.Errorf("attribute %q has no nested attributes", strings.Join(path[:i+1], "."))
+			}
+
+			attributes = att.AttributeNestedType.Attributes
+			blocks = nil
+			continue
+		}
+
+		if blockType, ok := blocks[name]; ok && blockType != nil {
+			if last {
+				return nil, blockType, nil
+			}
+
+			if blockType.Block == nil {
+				return nil, nil, fmt.Errorf("block %q has no attributes", strings.Join(path[:i+1], "."))
+			}
+
+			attributes = blockType.Block.Attributes
+			blocks = blockType.Block.NestedBlocks
+			continue
+		}
+
+		break
+	}
+
+	return nil, nil, fmt.Errorf("attribute %q not found", strings.Join(path, "."))
+}
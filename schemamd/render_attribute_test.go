@@ -0,0 +1,134 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+func TestRenderAttribute(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"name": {
+					AttributeType: cty.String,
+					Required:      true,
+					Description:   "The name of the bucket.",
+				},
+				"password": {
+					AttributeType: cty.String,
+					Optional:      true,
+					Sensitive:     true,
+				},
+				"settings": {
+					AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+						NestingMode: tfjson.SchemaNestingModeSingle,
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"region": {
+								AttributeType: cty.String,
+								Computed:      true,
+								Description:   "The region of the bucket.",
+							},
+						},
+					},
+					Optional:    true,
+					Description: "The settings of the bucket.",
+				},
+			},
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"versioning": {
+					NestingMode: tfjson.SchemaNestingModeList,
+					MaxItems:    1,
+					Block: &tfjson.SchemaBlock{
+						Description: "The versioning of the bucket.",
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"enabled": {
+								AttributeType: cty.Bool,
+								Optional:      true,
+								Description:   "Whether versioning is enabled.",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	opts := &schemamd.RenderOptions{
+		Defaults: map[string]string{"versioning.enabled": "false"},
+	}
+
+	for _, c := range []struct {
+		path          string
+		expected      string
+		expectedError string
+	}{
+		{
+			path:     "name",
+			expected: "`name` (String, Required) The name of the bucket.",
+		},
+		{
+			path:     "password",
+			expected: "`password` (String, Optional, Sensitive)",
+		},
+		{
+			path:     "settings",
+			expected: "`settings` (Attributes, Optional) The settings of the bucket.",
+		},
+		{
+			path:     "settings.region",
+			expected: "`settings.region` (String, Read-only) The region of the bucket.",
+		},
+		{
+			path:     "versioning",
+			expected: "`versioning` (Block List, Optional, Max: 1) The versioning of the bucket.",
+		},
+		{
+			path:     "versioning.enabled",
+			expected: "`versioning.enabled` (Boolean, Optional) Whether versioning is enabled. Defaults to `false`.",
+		},
+		{
+			path:          "versioning.missing",
+			expectedError: `attribute "versioning.missing" not found`,
+		},
+		{
+			path:          "name.first",
+			expectedError: `attribute "name" has no nested attributes`,
+		},
+	} {
+		c := c
+		t.Run(c.path, func(t *testing.T) {
+			t.Parallel()
+
+			b := &strings.Builder{}
+
+			err := schemamd.RenderAttribute(schema, c.path, b, opts)
+
+			if c.expectedError != "" {
+				if err == nil || err.Error() != c.expectedError {
+					t.Fatalf("expected error %q, got: %v", c.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(c.expected, b.String()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
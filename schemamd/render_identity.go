@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// Identity attributes are in one of 2 import groups:
+// * Required
+// * Optional
+var identityGroupFilters = []struct {
+	title  string
+	filter func(att *tfjson.IdentityAttribute) bool
+}{
+	{"#### Required", func(att *tfjson.IdentityAttribute) bool { return att.RequiredForImport }},
+	{"#### Optional", func(att *tfjson.IdentityAttribute) bool { return !att.RequiredForImport }},
+}
+
+// RenderIdentitySchema writes a Markdown formatted resource identity schema
+// definition to the specified writer. The identity attributes are grouped by
+// whether they are required or optional when importing a resource by
+// identity, for example:
+//
+//	"aws_s3_bucket": {
+//	  "attributes": {
+//	    "bucket": {
+//	      "type": "string",
+//	      "required_for_import": true
+//	    }
+//	  },
+//	  "version": 0
+//	},
+func RenderIdentitySchema(schema *tfjson.IdentitySchema, w io.Writer) error {
+	_, err := io.WriteString(w, "### Identity Schema\n\n")
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(schema.Attributes))
+	for name := range schema.Attributes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, gf := range identityGroupFilters {
+		var groupNames []string
+		for _, name := range names {
+			if gf.filter(schema.Attributes[name]) {
+				groupNames = append(groupNames, name)
+			}
+		}
+
+		if len(groupNames) == 0 {
+			continue
+		}
+
+		_, err = io.WriteString(w, gf.title+"\n\n")
+		if err != nil {
+			return err
+		}
+
+		for _, name := range groupNames {
+			err = writeIdentityAttribute(w, name, schema.Attributes[name])
+			if err != nil {
+				return fmt.Errorf("unable to render identity attribute %q: %w", name, err)
+			}
+		}
+
+		_, err = io.WriteString(w, "\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeIdentityAttribute(w io.Writer, name string, att *tfjson.IdentityAttribute) error {
+	_, err := io.WriteString(w, "- `"+name+"` (")
+	if err != nil {
+		return err
+	}
+
+	err = WriteType(w, att.IdentityType)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, ")")
+	if err != nil {
+		return err
+	}
+
+	if att.Description != "" {
+		_, err = io.WriteString(w, " "+att.Description)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return err
+}
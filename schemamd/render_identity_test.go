@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+func TestRenderIdentitySchema(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		name     string
+		input    string
+		expected string
+	}{
+		{
+			"required and optional",
+			`{
+				"version": 0,
+				"attributes": {
+					"region": {
+						"type": "string",
+						"description": "The region of the bucket.",
+						"optional_for_import": true
+					},
+					"bucket": {
+						"type": "string",
+						"description": "The name of the bucket.",
+						"required_for_import": true
+					},
+					"account_id": {
+						"type": "number",
+						"optional_for_import": true
+					}
+				}
+			}`,
+			"### Identity Schema\n\n" +
+				"#### Required\n\n" +
+				"- `bucket` (String) The name of the bucket.\n\n" +
+				"#### Optional\n\n" +
+				"- `account_id` (Number)\n" +
+				"- `region` (String) The region of the bucket.\n\n",
+		},
+		{
+			"required only",
+			`{
+				"version": 0,
+				"attributes": {
+					"id": {
+						"type": "string",
+						"required_for_import": true
+					}
+				}
+			}`,
+			"### Identity Schema\n\n" +
+				"#### Required\n\n" +
+				"- `id` (String)\n\n",
+		},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			var schema tfjson.IdentitySchema
+
+			err := json.Unmarshal([]byte(c.input), &schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b := &strings.Builder{}
+			err = schemamd.RenderIdentitySchema(&schema, b)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(c.expected, b.String()); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
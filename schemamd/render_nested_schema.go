@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// RenderNestedSchema writes the nested schema section of the nested block, or
+// nested attribute, at the dot separated path of the schema, with the
+// sections of its own nested types, the same as they are rendered within the
+// whole schema, so a page can split a large schema into several sections, or
+// a schema across several pages.
+func RenderNestedSchema(schema *tfjson.Schema, path string, w io.Writer, opts *RenderOptions) error {
+	if schema == nil || schema.Block == nil {
+		return fmt.Errorf("expected a schema, got nil")
+	}
+
+	parts := strings.Split(path, ".")
+
+	att, block, err := findAttribute(schema.Block, parts)
+	if err != nil {
+		return err
+	}
+
+	nt := nestedType{
+		pathTitle: path,
+		path:      parts,
+	}
+
+	switch {
+	case block != nil:
+		nt.anchorID = "nestedblock--" + strings.Join(parts, "--")
+		nt.block = block.Block
+	case att.AttributeNestedType != nil:
+		nt.anchorID = "nestedatt--" + strings.Join(parts, "--")
+		nt.attrs = att.AttributeNestedType
+		nt.group = attributeGroup(att)
+	case att.AttributeType.IsObjectType():
+		nt.anchorID = "nestedatt--" + strings.Join(parts, "--")
+		nt.object = &att.AttributeType
+		nt.group = attributeGroup(att)
+	case att.AttributeType.IsCollectionType() && att.AttributeType.ElementType().IsObjectType():
+		elementType := att.AttributeType.ElementType()
+		nt.anchorID = "nestedatt--" + strings.Join(parts, "--")
+		nt.object = &elementType
+		nt.group = attributeGroup(att)
+	default:
+		return fmt.Errorf("attribute %q has no nested schema", path)
+	}
+
+	b := &bytes.Buffer{}
+
+	switch opts.style() {
+	case StyleTable:
+		err = writeTableNestedTypes(b, []nestedType{nt}, opts)
+	case StyleDefault, StyleLegacy:
+		err = writeNestedTypes(b, []nestedType{nt}, opts)
+	default:
+		return fmt.Errorf("unsupported schema style %q, expected one of: %s", opts.style(), strings.Join(Styles, ", "))
+	}
+	if err != nil {
+		return fmt.Errorf("unable to render nested schema %q: %w", path, err)
+	}
+
+	_, err = io.WriteString(w, strings.TrimRight(b.String(), "\n")+"\n")
+	return err
+}
+
+// attributeGroup returns the characteristic group of the attribute, which
+// applies to the attributes of its object type.
+func attributeGroup(att *tfjson.SchemaAttribute) groupFilter {
+	for _, group := range groupFilters {
+		if group.filterAttribute(att) {
+			return group
+		}
+	}
+
+	return groupFilters[len(groupFilters)-1]
+}
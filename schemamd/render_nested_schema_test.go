@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+func TestRenderNestedSchema(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"name": {
+					AttributeType: cty.String,
+					Required:      true,
+				},
+				"endpoint": {
+					AttributeType: cty.Object(map[string]cty.Type{
+						"url": cty.String,
+					}),
+					Computed: true,
+				},
+			},
+			NestedBlocks: map[string]*tfjson.SchemaBlockType{
+				"versioning": {
+					NestingMode: tfjson.SchemaNestingModeList,
+					MaxItems:    1,
+					Block: &tfjson.SchemaBlock{
+						Attributes: map[string]*tfjson.SchemaAttribute{
+							"enabled": {
+								AttributeType: cty.Bool,
+								Optional:      true,
+								Description:   "Whether versioning is enabled.",
+							},
+						},
+						NestedBlocks: map[string]*tfjson.SchemaBlockType{
+							"rule": {
+								NestingMode: tfjson.SchemaNestingModeSet,
+								Block: &tfjson.SchemaBlock{
+									Attributes: map[string]*tfjson.SchemaAttribute{
+										"days": {
+											AttributeType: cty.Number,
+											Required:      true,
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, c := range []struct {
+		name          string
+		path          string
+		opts          *schemamd.RenderOptions
+		expected      string
+		expectedError string
+	}{
+		{
+			name: "block",
+			path: "versioning",
+			expected: "<a id=\"nestedblock--versioning\"></a>\n" +
+				"### Nested Schema for `versioning`\n\n" +
+				"Optional:\n\n" +
+				"- `enabled` (Boolean) Whether versioning is enabled.\n" +
+				"- `rule` (Block Set) (see [below for nested schema](#nestedblock--versioning--rule))\n\n" +
+				"<a id=\"nestedblock--versioning--rule\"></a>\n" +
+				"### Nested Schema for `versioning.rule`\n\n" +
+				"Required:\n\n" +
+				"- `days` (Number)\n",
+		},
+		{
+			name: "deeper block",
+			path: "versioning.rule",
+			opts: &schemamd.RenderOptions{Style: schemamd.StyleTable},
+			expected: "<a id=\"nestedblock--versioning--rule\"></a>\n" +
+				"### Nested Schema for `versioning.rule`\n\n" +
+				"| Name | Type | Required | Description |\n" +
+				"|------|------|----------|-------------|\n" +
+				"| `days` | Number | Required |  |\n",
+		},
+		{
+			name: "object",
+			path: "endpoint",
+			expected: "<a id=\"nestedatt--endpoint\"></a>\n" +
+				"### Nested Schema for `endpoint`\n\n" +
+				"Read-Only:\n\n" +
+				"- `url` (String)\n",
+		},
+		{
+			name:          "attribute without nested schema",
+			path:          "name",
+			expectedError: `attribute "name" has no nested schema`,
+		},
+		{
+			name:          "missing",
+			path:          "versioning.missing",
+			expectedError: `attribute "versioning.missing" not found`,
+		},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := &strings.Builder{}
+
+			err := schemamd.RenderNestedSchema(schema, c.path, b, c.opts)
+
+			if c.expectedError != "" {
+				if err == nil || err.Error() != c.expectedError {
+					t.Fatalf("expected error %q, got: %v", c.expectedError, err)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(c.expected, b.String()); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
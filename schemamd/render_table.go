@@ -0,0 +1,377 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// tableRow is the row of an attribute or block in a StyleTable schema table.
+type tableRow struct {
+	name        string
+	typ         string
+	group       string
+	description string
+}
+
+// tableCharacteristics are removed from the type column of a table, as the
+// characteristic of an attribute or block is in its own column.
+var tableCharacteristics = strings.NewReplacer(", Required", "", ", Optional", "", ", Read-only", "")
+
+// tableCell escapes the text of a table cell, which cannot contain pipes or
+// line breaks.
+var tableCell = strings.NewReplacer("|", `\|`, "\r\n", "<br>", "\n", "<br>")
+
+// writeTableBlockChildren writes the attributes and blocks of block as a
+// table in the StyleTable layout, ordered by characteristic group and name,
+// followed by the sections of their nested types, which are also written as
+// tables. Nested types are never written inline.
+func writeTableBlockChildren(w io.Writer, parents []string, block *tfjson.SchemaBlock, opts *RenderOptions) error {
+	groups, err := groupBlockChildren(parents, block)
+	if err != nil {
+		return err
+	}
+
+	rows := []tableRow{}
+	nestedTypes := []nestedType{}
+
+	for _, i := range opts.groupOrder() {
+		gf := groupFilters[i]
+		sortedNames := groups[i]
+		opts.sortNames(parents, sortedNames)
+
+		for _, name := range sortedNames {
+			path := childPath(parents, name)
+
+			var row tableRow
+			var nt []nestedType
+
+			if childBlock, ok := block.NestedBlocks[name]; ok {
+				row, nt, err = tableBlockTypeRow(path, childBlock, gf, opts)
+				if err != nil {
+					return fmt.Errorf("unable to render block %q: %w", name, err)
+				}
+			} else if childAtt, ok := block.Attributes[name]; ok {
+				row, nt, err = tableAttributeRow(path, childAtt, gf, opts)
+				if err != nil {
+					return fmt.Errorf("unable to render attribute %q: %w", name, err)
+				}
+			} else {
+				return fmt.Errorf("unexpected name in schema render %q", name)
+			}
+
+			rows = append(rows, row)
+			nestedTypes = append(nestedTypes, nt...)
+		}
+	}
+
+	err = writeTable(w, rows)
+	if err != nil {
+		return err
+	}
+
+	return writeTableNestedTypes(w, nestedTypes, opts)
+}
+
+// tableAttributeRow returns the table row of the attribute at path, and its
+// nested type, if any.
+func tableAttributeRow(path []string, att *tfjson.SchemaAttribute, group groupFilter, opts *RenderOptions) (tableRow, []nestedType, error) {
+	b := &bytes.Buffer{}
+
+	var err error
+	if att.AttributeNestedType == nil {
+		err = writeAttributeDescription(b, path, att, false, opts)
+	} else {
+		err = WriteNestedAttributeTypeDescription(b, att, false)
+	}
+	if err != nil {
+		return tableRow{}, nil, err
+	}
+
+	if sentences := opts.attributeSentences(path); sentences != "" {
+		b.WriteString(" " + sentences)
+	}
+
+	if att.AttributeType.IsTupleType() {
+		return tableRow{}, nil, fmt.Errorf("TODO: tuples are not yet supported")
+	}
+
+	nt := nestedType{
+		anchorID:  "nestedatt--" + strings.Join(path, "--"),
+		pathTitle: strings.Join(path, "."),
+		path:      path,
+
+		group: group,
+	}
+
+	switch {
+	case att.AttributeNestedType != nil:
+		nt.attrs = att.AttributeNestedType
+	case att.AttributeType.IsObjectType():
+		nt.object = &att.AttributeType
+	case att.AttributeType.IsCollectionType() && att.AttributeType.ElementType().IsObjectType():
+		elementType := att.AttributeType.ElementType()
+		nt.object = &elementType
+	default:
+		return newTableRow(path, b.String(), group, opts), nil, nil
+	}
+
+	return newTableRow(path, b.String(), group, opts).withNestedType(nt, opts)
+}
+
+// tableBlockTypeRow returns the table row of the block at path, and its
+// nested type.
+func tableBlockTypeRow(path []string, block *tfjson.SchemaBlockType, group groupFilter, opts *RenderOptions) (tableRow, []nestedType, error) {
+	b := &bytes.Buffer{}
+
+	err := writeBlockTypeDescription(b, block, false)
+	if err != nil {
+		return tableRow{}, nil, err
+	}
+
+	nt := nestedType{
+		anchorID:  "nestedblock--" + strings.Join(path, "--"),
+		pathTitle: strings.Join(path, "."),
+		path:      path,
+		block:     block.Block,
+	}
+
+	return newTableRow(path, b.String(), group, opts).withNestedType(nt, opts)
+}
+
+// tableObjectAttributeRow returns the table row of the object attribute at
+// path, and its nested type, if any.
+func tableObjectAttributeRow(path []string, att cty.Type, group groupFilter, opts *RenderOptions) (tableRow, []nestedType, error) {
+	b := &bytes.Buffer{}
+
+	_, err := io.WriteString(b, "(")
+	if err != nil {
+		return tableRow{}, nil, err
+	}
+
+	err = opts.writeType(b, path, att)
+	if err != nil {
+		return tableRow{}, nil, err
+	}
+
+	_, err = io.WriteString(b, ")")
+	if err != nil {
+		return tableRow{}, nil, err
+	}
+
+	if att.IsTupleType() {
+		return tableRow{}, nil, fmt.Errorf("TODO: tuples are not yet supported")
+	}
+
+	nt := nestedType{
+		anchorID:  "nestedobjatt--" + strings.Join(path, "--"),
+		pathTitle: strings.Join(path, "."),
+		path:      path,
+
+		group: group,
+	}
+
+	switch {
+	case att.IsObjectType():
+		nt.object = &att
+	case att.IsCollectionType() && att.ElementType().IsObjectType():
+		elementType := att.ElementType()
+		nt.object = &elementType
+	default:
+		return newTableRow(path, b.String(), group, opts), nil, nil
+	}
+
+	return newTableRow(path, b.String(), group, opts).withNestedType(nt, opts)
+}
+
+// newTableRow returns the table row of the attribute or block at path from
+// its description, as written by the description writers of the list
+// layouts, in the "(<type>, <flags>) <description>" format.
+func newTableRow(path []string, description string, group groupFilter, opts *RenderOptions) tableRow {
+	typ, desc := cutCharacteristics(description)
+
+	return tableRow{
+		name:        opts.attributeAnchor(path) + "`" + path[len(path)-1] + "`",
+		typ:         tableCharacteristics.Replace(typ),
+		group:       opts.heading(group.name),
+		description: strings.TrimSpace(desc),
+	}
+}
+
+// cutCharacteristics splits an attribute description into the text of its
+// leading parenthesized type and characteristics, which may contain links,
+// and the rest of the description.
+func cutCharacteristics(description string) (string, string) {
+	description = strings.TrimPrefix(description, "(")
+	depth := 0
+
+	for i, r := range description {
+		switch r {
+		case '(':
+			depth++
+		case ')':
+			if depth == 0 {
+				return description[:i], description[i+1:]
+			}
+			depth--
+		}
+	}
+
+	return description, ""
+}
+
+// withNestedType returns the row with a link to the section of the nested
+// type appended to its description, and the nested type. Nested types deeper
+// than MaxNestedDepth are summarized instead, and small object types are
+// listed with InlineObjectMaxAttributes, and neither is returned.
+func (r tableRow) withNestedType(nt nestedType, opts *RenderOptions) (tableRow, []nestedType, error) {
+	link := "(see [below for nested schema](#" + nt.anchorID + "))"
+	nestedTypes := []nestedType{nt}
+
+	switch {
+	case opts.truncated(nt.path):
+		link = opts.truncationNote(nt)
+		nestedTypes = nil
+	case opts.inlineObject(nt):
+		sentence, err := opts.inlineObjectSentence(nt)
+		if err != nil {
+			return tableRow{}, nil, err
+		}
+
+		link = sentence
+		nestedTypes = nil
+	}
+
+	if r.description == "" {
+		r.description = link
+	} else {
+		r.description += " " + link
+	}
+
+	return r, nestedTypes, nil
+}
+
+// writeTable writes the rows as a Markdown table.
+func writeTable(w io.Writer, rows []tableRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+
+	_, err := io.WriteString(w, "| Name | Type | Required | Description |\n|------|------|----------|-------------|\n")
+	if err != nil {
+		return err
+	}
+
+	for _, row := range rows {
+		cells := []string{row.name, row.typ, row.group, row.description}
+		for i, cell := range cells {
+			cells[i] = tableCell.Replace(cell)
+		}
+
+		_, err = io.WriteString(w, "| "+strings.Join(cells, " | ")+" |\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = io.WriteString(w, "\n")
+	return err
+}
+
+// writeTableNestedTypes writes the section of each nested type, with its
+// attributes and blocks as a table.
+func writeTableNestedTypes(w io.Writer, nestedTypes []nestedType, opts *RenderOptions) error {
+	for _, nt := range nestedTypes {
+		err := writeNestedTypeStart(w, nt, opts)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case nt.block != nil:
+			err = writeTableBlockChildren(w, nt.path, nt.block, opts)
+		case nt.object != nil:
+			err = writeTableObjectChildren(w, nt.path, *nt.object, nt.group, opts)
+		case nt.attrs != nil:
+			err = writeTableNestedAttributeChildren(w, nt.path, nt.attrs, opts)
+		default:
+			err = fmt.Errorf("missing information on nested block: %s", strings.Join(nt.path, "."))
+		}
+		if err != nil {
+			return err
+		}
+
+		err = writeNestedTypeEnd(w, opts)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writeTableObjectChildren writes the attributes of an object type as a
+// table, which all have the characteristic group of the object.
+func writeTableObjectChildren(w io.Writer, parents []string, ty cty.Type, group groupFilter, opts *RenderOptions) error {
+	atts := ty.AttributeTypes()
+	sortedNames := []string{}
+	for n := range atts {
+		sortedNames = append(sortedNames, n)
+	}
+	opts.sortNames(parents, sortedNames)
+
+	rows := []tableRow{}
+	nestedTypes := []nestedType{}
+
+	for _, name := range sortedNames {
+		row, nt, err := tableObjectAttributeRow(childPath(parents, name), atts[name], group, opts)
+		if err != nil {
+			return fmt.Errorf("unable to render attribute %q: %w", name, err)
+		}
+
+		rows = append(rows, row)
+		nestedTypes = append(nestedTypes, nt...)
+	}
+
+	err := writeTable(w, rows)
+	if err != nil {
+		return err
+	}
+
+	return writeTableNestedTypes(w, nestedTypes, opts)
+}
+
+// writeTableNestedAttributeChildren writes the nested attributes as a table,
+// ordered by characteristic group.
+func writeTableNestedAttributeChildren(w io.Writer, parents []string, nestedAttributes *tfjson.SchemaNestedAttributeType, opts *RenderOptions) error {
+	groups := groupNestedAttributes(parents, nestedAttributes, opts)
+
+	rows := []tableRow{}
+	nestedTypes := []nestedType{}
+
+	for _, i := range opts.groupOrder() {
+		for _, name := range groups[i] {
+			row, nt, err := tableAttributeRow(childPath(parents, name), nestedAttributes.Attributes[name], groupFilters[i], opts)
+			if err != nil {
+				return fmt.Errorf("unable to render attribute %q: %w", name, err)
+			}
+
+			rows = append(rows, row)
+			nestedTypes = append(nestedTypes, nt...)
+		}
+	}
+
+	err := writeTable(w, rows)
+	if err != nil {
+		return err
+	}
+
+	return writeTableNestedTypes(w, nestedTypes, opts)
+}
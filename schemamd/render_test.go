@@ -0,0 +1,649 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd_test
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
+)
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		name         string
+		inputFile    string
+		expectedFile string
+		opts         *schemamd.RenderOptions
+	}{
+		{
+			"aws_route_table_association",
+			"testdata/aws_route_table_association.schema.json",
+			"testdata/aws_route_table_association.md",
+			nil,
+		},
+		{
+			"aws_acm_certificate",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate.md",
+			nil,
+		},
+		{
+			"awscc_logs_log_group",
+			"testdata/awscc_logs_log_group.schema.json",
+			"testdata/awscc_logs_log_group.md",
+			nil,
+		},
+		{
+			"awscc_acmpca_certificate",
+			"testdata/awscc_acmpca_certificate.schema.json",
+			"testdata/awscc_acmpca_certificate.md",
+			nil,
+		},
+		{
+			"framework_types",
+			"testdata/framework_types.schema.json",
+			"testdata/framework_types.md",
+			nil,
+		},
+		{
+			// Reference: https://github.com/hashicorp/terraform-plugin-docs/issues/380
+			"deep_nested_attributes",
+			"testdata/deep_nested_attributes.schema.json",
+			"testdata/deep_nested_attributes.md",
+			nil,
+		},
+		{
+			"aws_acm_certificate_inline_nested",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_inline_nested.md",
+			&schemamd.RenderOptions{
+				InlineNestedDepth: 1,
+			},
+		},
+		{
+			"framework_types_inline_nested",
+			"testdata/framework_types.schema.json",
+			"testdata/framework_types_inline_nested.md",
+			&schemamd.RenderOptions{
+				InlineNestedDepth: 1,
+			},
+		},
+		{
+			"deep_nested_attributes_inline_nested",
+			"testdata/deep_nested_attributes.schema.json",
+			"testdata/deep_nested_attributes_inline_nested.md",
+			&schemamd.RenderOptions{
+				InlineNestedDepth: 2,
+			},
+		},
+		{
+			"aws_acm_certificate_legacy",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_legacy.md",
+			&schemamd.RenderOptions{
+				Style: schemamd.StyleLegacy,
+			},
+		},
+		{
+			"framework_types_legacy",
+			"testdata/framework_types.schema.json",
+			"testdata/framework_types_legacy.md",
+			&schemamd.RenderOptions{
+				Style: schemamd.StyleLegacy,
+			},
+		},
+		{
+			"aws_acm_certificate_headings",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_headings.md",
+			&schemamd.RenderOptions{
+				Headings: map[string]string{
+					schemamd.HeadingSchema:       "Arguments and Attributes",
+					schemamd.HeadingNestedSchema: "Nested Arguments for",
+					schemamd.HeadingReadOnly:     "Exported Attributes",
+				},
+				GroupOrder: []string{schemamd.HeadingReadOnly, schemamd.HeadingRequired, schemamd.HeadingOptional},
+			},
+		},
+		{
+			"aws_acm_certificate_legacy_headings",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_legacy_headings.md",
+			&schemamd.RenderOptions{
+				Style: schemamd.StyleLegacy,
+				Headings: map[string]string{
+					schemamd.HeadingArgumentReference:   "Arguments",
+					schemamd.HeadingAttributesReference: "Exported Attributes",
+				},
+			},
+		},
+		{
+			"framework_types_attribute_anchors",
+			"testdata/framework_types.schema.json",
+			"testdata/framework_types_attribute_anchors.md",
+			&schemamd.RenderOptions{
+				AttributeAnchors: true,
+			},
+		},
+		{
+			"aws_acm_certificate_legacy_inline_nested_attribute_anchors",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_legacy_inline_nested_attribute_anchors.md",
+			&schemamd.RenderOptions{
+				Style:             schemamd.StyleLegacy,
+				InlineNestedDepth: 1,
+				AttributeAnchors:  true,
+			},
+		},
+		{
+			"aws_acm_certificate_table",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_table.md",
+			&schemamd.RenderOptions{
+				Style: schemamd.StyleTable,
+			},
+		},
+		{
+			"framework_types_table",
+			"testdata/framework_types.schema.json",
+			"testdata/framework_types_table.md",
+			&schemamd.RenderOptions{
+				Style: schemamd.StyleTable,
+			},
+		},
+		{
+			"deep_nested_attributes_table",
+			"testdata/deep_nested_attributes.schema.json",
+			"testdata/deep_nested_attributes_table.md",
+			&schemamd.RenderOptions{
+				Style: schemamd.StyleTable,
+			},
+		},
+		{
+			"deep_nested_attributes_collapsible",
+			"testdata/deep_nested_attributes.schema.json",
+			"testdata/deep_nested_attributes_collapsible.md",
+			&schemamd.RenderOptions{
+				CollapsibleNestedSchemas: true,
+			},
+		},
+		{
+			"aws_acm_certificate_table_collapsible",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_table_collapsible.md",
+			&schemamd.RenderOptions{
+				Style:                    schemamd.StyleTable,
+				CollapsibleNestedSchemas: true,
+			},
+		},
+		{
+			"aws_acm_certificate_required_first",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_required_first.md",
+			&schemamd.RenderOptions{
+				AttributeSort: schemamd.SortRequiredFirst,
+			},
+		},
+		{
+			"aws_acm_certificate_attribute_order",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_attribute_order.md",
+			&schemamd.RenderOptions{
+				AttributeOrder: []string{"domain_name", "options.certificate_transparency_logging_preference", "*", "tags", "id"},
+			},
+		},
+		{
+			"deep_nested_attributes_required_first",
+			"testdata/deep_nested_attributes.schema.json",
+			"testdata/deep_nested_attributes_required_first.md",
+			&schemamd.RenderOptions{
+				AttributeSort: schemamd.SortRequiredFirst,
+			},
+		},
+		{
+			"aws_acm_certificate_defaults",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_defaults.md",
+			&schemamd.RenderOptions{
+				Defaults: map[string]string{
+					"options.certificate_transparency_logging_preference": "ENABLED",
+					"subject_alternative_names":                           "[]",
+					"tags":                                                "{}",
+					"validation_method":                                   "DNS",
+				},
+			},
+		},
+		{
+			"aws_acm_certificate_table_defaults",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_table_defaults.md",
+			&schemamd.RenderOptions{
+				Style: schemamd.StyleTable,
+				Defaults: map[string]string{
+					"options.certificate_transparency_logging_preference": "ENABLED",
+					"subject_alternative_names":                           "[]",
+					"tags":                                                "{}",
+					"validation_method":                                   "DNS",
+				},
+			},
+		},
+		{
+			"aws_acm_certificate_validators",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_validators.md",
+			&schemamd.RenderOptions{
+				Defaults: map[string]string{
+					"validation_method": "DNS",
+				},
+				Validators: map[string][]schemamd.Validator{
+					"domain_name": {
+						{Type: schemamd.ValidatorLengthBetween, Min: "1", Max: "253"},
+						{Type: schemamd.ValidatorRegex, Pattern: `^(\*\.)?[a-z0-9.-]+$`, Message: "a domain name, optionally with a leading wildcard"},
+					},
+					"options.certificate_transparency_logging_preference": {
+						{Type: schemamd.ValidatorOneOf, Values: []string{"ENABLED", "DISABLED"}},
+					},
+					"subject_alternative_names": {
+						{Type: schemamd.ValidatorSizeAtMost, Max: "100"},
+					},
+					"validation_method": {
+						{Type: schemamd.ValidatorOneOf, Values: []string{"DNS", "EMAIL", "NONE"}},
+					},
+				},
+			},
+		},
+		{
+			"aws_acm_certificate_requires_replace",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_requires_replace.md",
+			&schemamd.RenderOptions{
+				Defaults: map[string]string{
+					"validation_method": "DNS",
+				},
+				RequiresReplace: map[string]bool{
+					"domain_name": true,
+					"options.certificate_transparency_logging_preference": true,
+					"private_key":       false,
+					"validation_method": true,
+				},
+			},
+		},
+		{
+			"aws_acm_certificate_deprecations",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_deprecations.md",
+			&schemamd.RenderOptions{
+				Defaults: map[string]string{
+					"validation_method": "DNS",
+				},
+				Deprecations: map[string]string{
+					"options.certificate_transparency_logging_preference": "options.transparency_logging",
+					"subject_alternative_names":                           "alternative_names",
+				},
+			},
+		},
+		{
+			"write_only",
+			"testdata/write_only.schema.json",
+			"testdata/write_only.md",
+			nil,
+		},
+		{
+			"write_only_section",
+			"testdata/write_only.schema.json",
+			"testdata/write_only_section.md",
+			&schemamd.RenderOptions{
+				WriteOnlySection: true,
+			},
+		},
+		{
+			"write_only_table",
+			"testdata/write_only.schema.json",
+			"testdata/write_only_table.md",
+			&schemamd.RenderOptions{
+				Style: schemamd.StyleTable,
+			},
+		},
+		{
+			"timeouts",
+			"testdata/timeouts.schema.json",
+			"testdata/timeouts.md",
+			nil,
+		},
+		{
+			"timeouts_section",
+			"testdata/timeouts.schema.json",
+			"testdata/timeouts_section.md",
+			&schemamd.RenderOptions{
+				TimeoutsSection: true,
+				Defaults: map[string]string{
+					"timeouts.create": "30m",
+					"timeouts.delete": "20m",
+				},
+			},
+		},
+		{
+			"timeouts_section_legacy",
+			"testdata/timeouts.schema.json",
+			"testdata/timeouts_section_legacy.md",
+			&schemamd.RenderOptions{
+				Style:           schemamd.StyleLegacy,
+				TimeoutsSection: true,
+				Defaults: map[string]string{
+					"timeouts.create": "30m",
+					"timeouts.delete": "20m",
+				},
+			},
+		},
+		{
+			"timeouts_section_table",
+			"testdata/timeouts.schema.json",
+			"testdata/timeouts_section_table.md",
+			&schemamd.RenderOptions{
+				Style:           schemamd.StyleTable,
+				TimeoutsSection: true,
+				Defaults: map[string]string{
+					"timeouts.create": "30m",
+					"timeouts.delete": "20m",
+				},
+			},
+		},
+		{
+			"deep_nested_attributes_max_nested_depth",
+			"testdata/deep_nested_attributes.schema.json",
+			"testdata/deep_nested_attributes_max_nested_depth.md",
+			&schemamd.RenderOptions{
+				MaxNestedDepth: 2,
+			},
+		},
+		{
+			"deep_nested_attributes_max_nested_depth_table",
+			"testdata/deep_nested_attributes.schema.json",
+			"testdata/deep_nested_attributes_max_nested_depth_table.md",
+			&schemamd.RenderOptions{
+				Style:          schemamd.StyleTable,
+				MaxNestedDepth: 1,
+			},
+		},
+		{
+			"framework_types_terraform_type_syntax",
+			"testdata/framework_types.schema.json",
+			"testdata/framework_types_terraform_type_syntax.md",
+			&schemamd.RenderOptions{
+				TypeSyntax: schemamd.TypeSyntaxTerraform,
+				TypeNames: map[string]string{
+					"string_attribute": "RFC3339 Timestamp",
+					"object_attribute.object_attribute_attribute": "ARN",
+				},
+			},
+		},
+		{
+			"framework_types_table_terraform_type_syntax",
+			"testdata/framework_types.schema.json",
+			"testdata/framework_types_table_terraform_type_syntax.md",
+			&schemamd.RenderOptions{
+				Style:      schemamd.StyleTable,
+				TypeSyntax: schemamd.TypeSyntaxTerraform,
+			},
+		},
+		{
+			"framework_types_inline_objects",
+			"testdata/framework_types.schema.json",
+			"testdata/framework_types_inline_objects.md",
+			&schemamd.RenderOptions{
+				InlineObjectMaxAttributes: 1,
+			},
+		},
+		{
+			"aws_acm_certificate_table_inline_objects",
+			"testdata/aws_acm_certificate.schema.json",
+			"testdata/aws_acm_certificate_table_inline_objects.md",
+			&schemamd.RenderOptions{
+				Style:                     schemamd.StyleTable,
+				InlineObjectMaxAttributes: 4,
+			},
+		},
+		{
+			"deep_nested_attributes_legacy_inline_nested",
+			"testdata/deep_nested_attributes.schema.json",
+			"testdata/deep_nested_attributes_legacy_inline_nested.md",
+			&schemamd.RenderOptions{
+				Style:             schemamd.StyleLegacy,
+				InlineNestedDepth: 1,
+			},
+		},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			input, err := os.ReadFile(c.inputFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expected, err := os.ReadFile(c.expectedFile)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var schema tfjson.Schema
+
+			err = json.Unmarshal(input, &schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			b := &strings.Builder{}
+			err = schemamd.Render(&schema, b, c.opts)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Remove \r characters so tests don't fail on windows
+			expectedStr := strings.ReplaceAll(string(expected), "\r", "")
+
+			// Remove trailing newlines before comparing (some text editors remove them).
+			expectedStr = strings.TrimRight(expectedStr, "\n")
+			actual := strings.TrimRight(b.String(), "\n")
+			if diff := cmp.Diff(expectedStr, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateGroupOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		groupOrder    []string
+		expectedError string
+	}{
+		"empty": {},
+		"reordered": {
+			groupOrder: []string{"read-only", "required", "optional"},
+		},
+		"unknown": {
+			groupOrder:    []string{"read-only", "required", "computed"},
+			expectedError: `unsupported schema group "computed", expected one of: required, optional, read-only`,
+		},
+		"duplicate": {
+			groupOrder:    []string{"required", "required", "optional"},
+			expectedError: `duplicate schema group "required"`,
+		},
+		"missing": {
+			groupOrder:    []string{"required", "optional"},
+			expectedError: "expected schema group order to contain each of: required, optional, read-only",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := schemamd.ValidateGroupOrder(testCase.groupOrder)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestValidateAttributeOrder(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeOrder []string
+		expectedError  string
+	}{
+		"empty": {},
+		"names and paths": {
+			attributeOrder: []string{"name", "settings.enabled", "*", "id"},
+		},
+		"empty entry": {
+			attributeOrder: []string{"name", ""},
+			expectedError:  "expected attribute order entries to be attribute names or paths, got an empty entry",
+		},
+		"duplicate rest": {
+			attributeOrder: []string{"*", "name", "*"},
+			expectedError:  `expected attribute order to contain "*" at most once`,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := schemamd.ValidateAttributeOrder(testCase.attributeOrder)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestValidateValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validators    []schemamd.Validator
+		expectedError string
+	}{
+		"empty": {},
+		"valid": {
+			validators: []schemamd.Validator{
+				{Type: schemamd.ValidatorOneOf, Values: []string{"a", "b"}},
+				{Type: schemamd.ValidatorBetween, Min: "1", Max: "10"},
+				{Type: schemamd.ValidatorLengthAtMost, Max: "255"},
+				{Type: schemamd.ValidatorRegex, Pattern: "^[a-z]+$"},
+			},
+		},
+		"unsupported type": {
+			validators:    []schemamd.Validator{{Type: "exactly"}},
+			expectedError: `unsupported validator type "exactly", expected one of: one_of, none_of, between, at_least, at_most, length_between, length_at_least, length_at_most, size_between, size_at_least, size_at_most, regex`,
+		},
+		"missing values": {
+			validators:    []schemamd.Validator{{Type: schemamd.ValidatorOneOf}},
+			expectedError: "expected one_of validator to have values",
+		},
+		"missing max": {
+			validators:    []schemamd.Validator{{Type: schemamd.ValidatorBetween, Min: "1"}},
+			expectedError: "expected between validator to have max",
+		},
+		"missing pattern": {
+			validators:    []schemamd.Validator{{Type: schemamd.ValidatorRegex, Message: "lowercase"}},
+			expectedError: "expected regex validator to have pattern",
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := schemamd.ValidateValidators(testCase.validators)
+
+			if testCase.expectedError == "" {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+
+				return
+			}
+
+			if err == nil || err.Error() != testCase.expectedError {
+				t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestAttributeAnchorID(t *testing.T) {
+	t.Parallel()
+
+	actual := schemamd.AttributeAnchorID([]string{"versioning", "Enabled_At"})
+	expected := "attr-versioning-enabled-at"
+
+	if actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestRender_TableCellEscaping(t *testing.T) {
+	t.Parallel()
+
+	schema := &tfjson.Schema{
+		Block: &tfjson.SchemaBlock{
+			Attributes: map[string]*tfjson.SchemaAttribute{
+				"mode": {
+					AttributeType: cty.String,
+					Optional:      true,
+					Description:   "One of `a|b` or `c`.\nDefaults to `a|b`.",
+				},
+			},
+		},
+	}
+
+	expected := "## Schema\n\n" +
+		"| Name | Type | Required | Description |\n" +
+		"|------|------|----------|-------------|\n" +
+		"| `mode` | String | Optional | One of `a\\|b` or `c`.<br>Defaults to `a\\|b`. |\n"
+
+	b := &strings.Builder{}
+	err := schemamd.Render(schema, b, &schemamd.RenderOptions{Style: schemamd.StyleTable})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(expected, strings.TrimRight(b.String(), "\n")+"\n"); diff != "" {
+		t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+)
+
+// timeoutsName is the conventional name of the block, or single nested
+// attribute, which configures the operation timeouts of a resource.
+const timeoutsName = "timeouts"
+
+// timeoutsLink links to the Terraform documentation of operation timeouts.
+const timeoutsLink = "https://developer.hashicorp.com/terraform/language/resources/syntax#operation-timeouts"
+
+// timeoutOperations are the conventional timeouts, in the order they are
+// listed before any others.
+var timeoutOperations = []string{"create", "read", "update", "delete"}
+
+// timeouts is the timeouts block, or attribute, of a root block.
+type timeouts struct {
+	// kind is "block" or "attribute".
+	kind string
+
+	attributes map[string]*tfjson.SchemaAttribute
+}
+
+// splitTimeouts returns the block without its timeouts block, or single
+// nested attribute, and the timeouts, if they are rendered in a section of
+// their own. Otherwise, the block is returned unchanged with nil timeouts.
+func (o *RenderOptions) splitTimeouts(block *tfjson.SchemaBlock) (*tfjson.SchemaBlock, *timeouts) {
+	if o == nil || !o.TimeoutsSection || block == nil {
+		return block, nil
+	}
+
+	if blockType, ok := block.NestedBlocks[timeoutsName]; ok && blockType != nil && blockType.Block != nil &&
+		blockType.NestingMode == tfjson.SchemaNestingModeSingle {
+		rest := *block
+		rest.NestedBlocks = withoutKey(block.NestedBlocks, timeoutsName)
+
+		return &rest, &timeouts{kind: "block", attributes: blockType.Block.Attributes}
+	}
+
+	if att, ok := block.Attributes[timeoutsName]; ok && att != nil && att.AttributeNestedType != nil &&
+		att.AttributeNestedType.NestingMode == tfjson.SchemaNestingModeSingle {
+		rest := *block
+		rest.Attributes = withoutKey(block.Attributes, timeoutsName)
+
+		return &rest, &timeouts{kind: "attribute", attributes: att.AttributeNestedType.Attributes}
+	}
+
+	return block, nil
+}
+
+// withoutKey returns a copy of the map without the key.
+func withoutKey[V any](m map[string]V, key string) map[string]V {
+	rest := make(map[string]V, len(m))
+	for k, v := range m {
+		if k != key {
+			rest[k] = v
+		}
+	}
+
+	return rest
+}
+
+// writeTimeouts writes the section of the timeouts, which lists each timeout
+// with its default value and description, for example:
+//
+//	### Timeouts
+//
+//	The `timeouts` block allows you to specify [timeouts](...) for certain operations:
+//
+//	- `create` - (Default `20m`) Timeout of creating the resource.
+//	- `delete` - (Default `10m`)
+func writeTimeouts(w io.Writer, t *timeouts, opts *RenderOptions) error {
+	level := "###"
+	if opts.style() == StyleLegacy {
+		level = "##"
+	}
+
+	_, err := fmt.Fprintf(w, "%s %s\n\nThe `%s` %s allows you to specify [timeouts](%s) for certain operations:\n\n", level, opts.heading(HeadingTimeouts), timeoutsName, t.kind, timeoutsLink)
+	if err != nil {
+		return err
+	}
+
+	for _, name := range timeoutNames(t.attributes) {
+		line := "- " + code(name)
+
+		var parts []string
+		if value, ok := opts.Defaults[timeoutsName+"."+name]; ok {
+			parts = append(parts, "(Default "+code(value)+")")
+		}
+
+		if description := strings.TrimSpace(t.attributes[name].Description); description != "" {
+			parts = append(parts, description)
+		}
+
+		if len(parts) > 0 {
+			line += " - " + strings.Join(parts, " ")
+		}
+
+		_, err = io.WriteString(w, line+"\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// timeoutNames returns the names of the timeouts, with the conventional
+// operations first, in their order, then the others alphabetically.
+func timeoutNames(attributes map[string]*tfjson.SchemaAttribute) []string {
+	names := make([]string, 0, len(attributes))
+	for name := range attributes {
+		names = append(names, name)
+	}
+
+	rank := func(name string) int {
+		for i, operation := range timeoutOperations {
+			if name == operation {
+				return i
+			}
+		}
+
+		return len(timeoutOperations)
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if rank(names[i]) != rank(names[j]) {
+			return rank(names[i]) < rank(names[j])
+		}
+
+		return names[i] < names[j]
+	})
+
+	return names
+}
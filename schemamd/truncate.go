@@ -0,0 +1,177 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// ValidateMaxNestedDepth returns an error if the maximum nested depth is
+// negative.
+func ValidateMaxNestedDepth(maxNestedDepth int) error {
+	if maxNestedDepth < 0 {
+		return fmt.Errorf("expected max nested depth to be at least 0, got %d", maxNestedDepth)
+	}
+
+	return nil
+}
+
+// truncated returns true if the nested schema of the attribute or block at
+// path is deeper than MaxNestedDepth, and is summarized instead of rendered.
+func (o *RenderOptions) truncated(path []string) bool {
+	return o != nil && o.MaxNestedDepth > 0 && len(path) > o.MaxNestedDepth
+}
+
+// truncationNote returns the sentence which replaces the nested schema of a
+// nested type which is deeper than MaxNestedDepth, with a summary of its
+// type, for example:
+//
+//	Nested schema not rendered beyond a depth of 2, of type `object({ name = string, rule = list(object({...})) })`.
+func (o *RenderOptions) truncationNote(nt nestedType) string {
+	return "Nested schema not rendered beyond a depth of " + strconv.Itoa(o.MaxNestedDepth) + ", of type " + code(nestedTypeSummary(nt)) + "."
+}
+
+// nestedTypeSummary returns the Terraform type constraint of the nested type,
+// with the attributes of its own object, and any deeper object abbreviated
+// as "object({...})".
+func nestedTypeSummary(nt nestedType) string {
+	switch {
+	case nt.block != nil:
+		return blockSummary(nt.block)
+	case nt.object != nil:
+		return objectSummary(nt.object.AttributeTypes())
+	case nt.attrs != nil:
+		return attributesSummary(nt.attrs.Attributes)
+	}
+
+	return "object({...})"
+}
+
+// blockSummary returns the type constraint of the attributes and nested
+// blocks of the block.
+func blockSummary(block *tfjson.SchemaBlock) string {
+	fields := make(map[string]string, len(block.Attributes)+len(block.NestedBlocks))
+
+	for name, att := range block.Attributes {
+		fields[name] = attributeSummary(att)
+	}
+
+	for name, blockType := range block.NestedBlocks {
+		fields[name] = nestingSummary(blockType.NestingMode)
+	}
+
+	return fieldsSummary(fields)
+}
+
+// attributesSummary returns the type constraint of the nested attributes.
+func attributesSummary(attributes map[string]*tfjson.SchemaAttribute) string {
+	fields := make(map[string]string, len(attributes))
+
+	for name, att := range attributes {
+		fields[name] = attributeSummary(att)
+	}
+
+	return fieldsSummary(fields)
+}
+
+// objectSummary returns the type constraint of the attribute types of an
+// object.
+func objectSummary(attributeTypes map[string]cty.Type) string {
+	fields := make(map[string]string, len(attributeTypes))
+
+	for name, ty := range attributeTypes {
+		fields[name] = typeSummary(ty)
+	}
+
+	return fieldsSummary(fields)
+}
+
+// attributeSummary returns the abbreviated type constraint of an attribute.
+func attributeSummary(att *tfjson.SchemaAttribute) string {
+	if att == nil {
+		return "any"
+	}
+
+	if att.AttributeNestedType != nil {
+		return nestingSummary(att.AttributeNestedType.NestingMode)
+	}
+
+	return typeSummary(att.AttributeType)
+}
+
+// nestingSummary returns the abbreviated type constraint of a nested block,
+// or nested attribute, with the nesting mode.
+func nestingSummary(mode tfjson.SchemaNestingMode) string {
+	switch mode {
+	case tfjson.SchemaNestingModeList:
+		return "list(object({...}))"
+	case tfjson.SchemaNestingModeSet:
+		return "set(object({...}))"
+	case tfjson.SchemaNestingModeMap:
+		return "map(object({...}))"
+	}
+
+	return "object({...})"
+}
+
+// typeSummary returns the type constraint of the type, with objects
+// abbreviated.
+func typeSummary(ty cty.Type) string {
+	switch {
+	case ty == cty.NilType || ty == cty.DynamicPseudoType:
+		return "any"
+	case ty == cty.String:
+		return "string"
+	case ty == cty.Number:
+		return "number"
+	case ty == cty.Bool:
+		return "bool"
+	case ty.IsListType():
+		return "list(" + typeSummary(ty.ElementType()) + ")"
+	case ty.IsSetType():
+		return "set(" + typeSummary(ty.ElementType()) + ")"
+	case ty.IsMapType():
+		return "map(" + typeSummary(ty.ElementType()) + ")"
+	case ty.IsTupleType():
+		return "tuple([...])"
+	}
+
+	return "object({...})"
+}
+
+// fieldsSummary returns the object type constraint of the fields, by name,
+// in alphabetical order.
+func fieldsSummary(fields map[string]string) string {
+	if len(fields) == 0 {
+		return "object({})"
+	}
+
+	names := make([]string, 0, len(fields))
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	parts := make([]string, 0, len(names))
+	for _, name := range names {
+		parts = append(parts, name+" = "+fields[name])
+	}
+
+	return "object({ " + strings.Join(parts, ", ") + " })"
+}
+
+// writeTruncatedNestedType ends the list item of the attribute or block with
+// the nested type, which is deeper than MaxNestedDepth, with its truncation
+// note instead of a link to its section.
+func writeTruncatedNestedType(w io.Writer, nt nestedType, opts *RenderOptions) error {
+	_, err := io.WriteString(w, " "+opts.truncationNote(nt)+"\n")
+	return err
+}
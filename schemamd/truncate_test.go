@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	tfjson "github.com/hashicorp/terraform-json"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestNestedTypeSummary(t *testing.T) {
+	t.Parallel()
+
+	objectType := cty.Object(map[string]cty.Type{
+		"name":  cty.String,
+		"ports": cty.List(cty.Number),
+		"rules": cty.Set(cty.Object(map[string]cty.Type{"action": cty.String})),
+		"extra": cty.DynamicPseudoType,
+	})
+
+	for _, c := range []struct {
+		name     string
+		nt       nestedType
+		expected string
+	}{
+		{
+			"block",
+			nestedType{block: &tfjson.SchemaBlock{
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"enabled": {AttributeType: cty.Bool},
+					"labels":  {AttributeType: cty.Map(cty.String)},
+				},
+				NestedBlocks: map[string]*tfjson.SchemaBlockType{
+					"rule":     {NestingMode: tfjson.SchemaNestingModeList},
+					"settings": {NestingMode: tfjson.SchemaNestingModeSingle},
+				},
+			}},
+			"object({ enabled = bool, labels = map(string), rule = list(object({...})), settings = object({...}) })",
+		},
+		{
+			"object",
+			nestedType{object: &objectType},
+			"object({ extra = any, name = string, ports = list(number), rules = set(object({...})) })",
+		},
+		{
+			"nested attributes",
+			nestedType{attrs: &tfjson.SchemaNestedAttributeType{
+				Attributes: map[string]*tfjson.SchemaAttribute{
+					"id": {AttributeType: cty.String},
+					"children": {AttributeNestedType: &tfjson.SchemaNestedAttributeType{
+						NestingMode: tfjson.SchemaNestingModeMap,
+					}},
+				},
+			}},
+			"object({ children = map(object({...})), id = string })",
+		},
+		{
+			"empty",
+			nestedType{block: &tfjson.SchemaBlock{}},
+			"object({})",
+		},
+	} {
+		c := c
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := nestedTypeSummary(c.nt)
+
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateMaxNestedDepth(t *testing.T) {
+	t.Parallel()
+
+	if err := ValidateMaxNestedDepth(0); err != nil {
+		t.Errorf("unexpected error: %s", err)
+	}
+
+	if err := ValidateMaxNestedDepth(-1); err == nil {
+		t.Error("expected error, got none")
+	}
+}
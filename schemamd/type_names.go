@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+const (
+	// TypeSyntaxDefault writes the types of attributes as words, such as
+	// "Map of String".
+	TypeSyntaxDefault = "default"
+
+	// TypeSyntaxTerraform writes the types of attributes as Terraform type
+	// constraints, such as "map(string)".
+	TypeSyntaxTerraform = "terraform"
+)
+
+// TypeSyntaxes contains all supported values of RenderOptions.TypeSyntax.
+var TypeSyntaxes = []string{TypeSyntaxDefault, TypeSyntaxTerraform}
+
+// typeSyntax returns the configured type syntax, defaulting to
+// TypeSyntaxDefault.
+func (o *RenderOptions) typeSyntax() string {
+	if o == nil || o.TypeSyntax == "" {
+		return TypeSyntaxDefault
+	}
+
+	return o.TypeSyntax
+}
+
+// writeType writes the type of the attribute at path, which is its name in
+// TypeNames, if any, or the type in the configured type syntax.
+func (o *RenderOptions) writeType(w io.Writer, path []string, ty cty.Type) error {
+	if o != nil {
+		if name, ok := o.TypeNames[strings.Join(path, ".")]; ok {
+			_, err := io.WriteString(w, name)
+			return err
+		}
+	}
+
+	if o.typeSyntax() == TypeSyntaxTerraform {
+		return WriteTerraformType(w, ty)
+	}
+
+	return WriteType(w, ty)
+}
+
+// WriteTerraformType writes the type as a Terraform type constraint, such as
+// "list(string)". Object and tuple types are written without their attribute
+// and element types, which are rendered in nested schema sections.
+func WriteTerraformType(w io.Writer, ty cty.Type) error {
+	switch {
+	case ty == cty.DynamicPseudoType:
+		_, err := io.WriteString(w, "any")
+		return err
+	case ty.IsPrimitiveType():
+		switch ty {
+		case cty.String:
+			_, err := io.WriteString(w, "string")
+			return err
+		case cty.Bool:
+			_, err := io.WriteString(w, "bool")
+			return err
+		case cty.Number:
+			_, err := io.WriteString(w, "number")
+			return err
+		}
+		return fmt.Errorf("unexpected primitive type %q", ty.FriendlyName())
+	case ty.IsCollectionType():
+		var collection string
+		switch {
+		case ty.IsListType():
+			collection = "list"
+		case ty.IsSetType():
+			collection = "set"
+		case ty.IsMapType():
+			collection = "map"
+		default:
+			return fmt.Errorf("unexpected collection type %q", ty.FriendlyName())
+		}
+
+		_, err := io.WriteString(w, collection+"(")
+		if err != nil {
+			return err
+		}
+
+		err = WriteTerraformType(w, ty.ElementType())
+		if err != nil {
+			return fmt.Errorf("unable to write element type for %q: %w", ty.FriendlyName(), err)
+		}
+
+		_, err = io.WriteString(w, ")")
+		return err
+	case ty.IsTupleType():
+		_, err := io.WriteString(w, "tuple")
+		return err
+	case ty.IsObjectType():
+		_, err := io.WriteString(w, "object")
+		return err
+	}
+	return fmt.Errorf("unexpected type %q", ty.FriendlyName())
+}
@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package schemamd
+
+import (
+	"fmt"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// Types of Validator, which are named after the validators of the
+// terraform-plugin-framework-validators module.
+const (
+	// ValidatorOneOf allows only the values of Validator.Values.
+	ValidatorOneOf = "one_of"
+
+	// ValidatorNoneOf disallows the values of Validator.Values.
+	ValidatorNoneOf = "none_of"
+
+	// ValidatorBetween allows numbers from Validator.Min to Validator.Max.
+	ValidatorBetween = "between"
+
+	// ValidatorAtLeast allows numbers of at least Validator.Min.
+	ValidatorAtLeast = "at_least"
+
+	// ValidatorAtMost allows numbers of at most Validator.Max.
+	ValidatorAtMost = "at_most"
+
+	// ValidatorLengthBetween allows strings with a length from Validator.Min
+	// to Validator.Max.
+	ValidatorLengthBetween = "length_between"
+
+	// ValidatorLengthAtLeast allows strings with a length of at least
+	// Validator.Min.
+	ValidatorLengthAtLeast = "length_at_least"
+
+	// ValidatorLengthAtMost allows strings with a length of at most
+	// Validator.Max.
+	ValidatorLengthAtMost = "length_at_most"
+
+	// ValidatorSizeBetween allows collections with a number of elements from
+	// Validator.Min to Validator.Max.
+	ValidatorSizeBetween = "size_between"
+
+	// ValidatorSizeAtLeast allows collections with at least Validator.Min
+	// elements.
+	ValidatorSizeAtLeast = "size_at_least"
+
+	// ValidatorSizeAtMost allows collections with at most Validator.Max
+	// elements.
+	ValidatorSizeAtMost = "size_at_most"
+
+	// ValidatorRegex allows strings which match Validator.Pattern, which is
+	// described by the optional Validator.Message.
+	ValidatorRegex = "regex"
+)
+
+// ValidatorTypes contains all supported values of Validator.Type.
+var ValidatorTypes = []string{
+	ValidatorOneOf,
+	ValidatorNoneOf,
+	ValidatorBetween,
+	ValidatorAtLeast,
+	ValidatorAtMost,
+	ValidatorLengthBetween,
+	ValidatorLengthAtLeast,
+	ValidatorLengthAtMost,
+	ValidatorSizeBetween,
+	ValidatorSizeAtLeast,
+	ValidatorSizeAtMost,
+	ValidatorRegex,
+}
+
+// Validator is a constraint on the values of an attribute, such as the
+// allowed values of a string attribute, which is written as a sentence after
+// the description of the attribute.
+type Validator struct {
+	// Type is the type of the constraint, one of ValidatorTypes.
+	Type string
+
+	// Values are the allowed, or disallowed, values of ValidatorOneOf and
+	// ValidatorNoneOf.
+	Values []string
+
+	// Min and Max are the bounds of the between, at least, and at most
+	// types.
+	Min string
+	Max string
+
+	// Pattern is the regular expression of ValidatorRegex, and Message
+	// optionally describes it.
+	Pattern string
+	Message string
+}
+
+// ValidateValidators returns an error if any of the validators has an
+// unsupported type, or lacks the fields of its type.
+func ValidateValidators(validators []Validator) error {
+	for _, v := range validators {
+		if !slices.Contains(ValidatorTypes, v.Type) {
+			return fmt.Errorf("unsupported validator type %q, expected one of: %s", v.Type, strings.Join(ValidatorTypes, ", "))
+		}
+
+		var missing string
+
+		switch v.Type {
+		case ValidatorOneOf, ValidatorNoneOf:
+			if len(v.Values) == 0 {
+				missing = "values"
+			}
+		case ValidatorBetween, ValidatorLengthBetween, ValidatorSizeBetween:
+			if v.Min == "" {
+				missing = "min"
+			} else if v.Max == "" {
+				missing = "max"
+			}
+		case ValidatorAtLeast, ValidatorLengthAtLeast, ValidatorSizeAtLeast:
+			if v.Min == "" {
+				missing = "min"
+			}
+		case ValidatorAtMost, ValidatorLengthAtMost, ValidatorSizeAtMost:
+			if v.Max == "" {
+				missing = "max"
+			}
+		case ValidatorRegex:
+			if v.Pattern == "" {
+				missing = "pattern"
+			}
+		}
+
+		if missing != "" {
+			return fmt.Errorf("expected %s validator to have %s", v.Type, missing)
+		}
+	}
+
+	return nil
+}
+
+// validatorSentence returns the sentence which describes the constraint of
+// the validator.
+func validatorSentence(v Validator) string {
+	switch v.Type {
+	case ValidatorOneOf:
+		return "Allowed values: " + codeList(v.Values) + "."
+	case ValidatorNoneOf:
+		return "Disallowed values: " + codeList(v.Values) + "."
+	case ValidatorBetween:
+		return "Must be between " + code(v.Min) + " and " + code(v.Max) + "."
+	case ValidatorAtLeast:
+		return "Must be at least " + code(v.Min) + "."
+	case ValidatorAtMost:
+		return "Must be at most " + code(v.Max) + "."
+	case ValidatorLengthBetween:
+		return "Must be between " + code(v.Min) + " and " + code(v.Max) + " characters long."
+	case ValidatorLengthAtLeast:
+		return "Must be at least " + code(v.Min) + " characters long."
+	case ValidatorLengthAtMost:
+		return "Must be at most " + code(v.Max) + " characters long."
+	case ValidatorSizeBetween:
+		return "Must contain between " + code(v.Min) + " and " + code(v.Max) + " elements."
+	case ValidatorSizeAtLeast:
+		return "Must contain at least " + code(v.Min) + " elements."
+	case ValidatorSizeAtMost:
+		return "Must contain at most " + code(v.Max) + " elements."
+	case ValidatorRegex:
+		if v.Message != "" {
+			return "Must match the regular expression " + code(v.Pattern) + " (" + strings.TrimSuffix(v.Message, ".") + ")."
+		}
+
+		return "Must match the regular expression " + code(v.Pattern) + "."
+	}
+
+	return ""
+}
+
+// code returns the value as a Markdown code span.
+func code(value string) string {
+	if strings.Contains(value, "`") {
+		return "`` " + value + " ``"
+	}
+
+	return "`" + value + "`"
+}
+
+// codeList returns the values as a comma separated list of Markdown code
+// spans.
+func codeList(values []string) string {
+	spans := make([]string, 0, len(values))
+	for _, value := range values {
+		spans = append(spans, code(value))
+	}
+
+	return strings.Join(spans, ", ")
+}
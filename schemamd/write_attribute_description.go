@@ -12,12 +12,18 @@ import (
 )
 
 func WriteAttributeDescription(w io.Writer, att *tfjson.SchemaAttribute, includeRW bool) error {
+	return writeAttributeDescription(w, nil, att, includeRW, nil)
+}
+
+// writeAttributeDescription writes the description of the attribute at path,
+// with its type written as configured by the options.
+func writeAttributeDescription(w io.Writer, path []string, att *tfjson.SchemaAttribute, includeRW bool, opts *RenderOptions) error {
 	_, err := io.WriteString(w, "(")
 	if err != nil {
 		return err
 	}
 
-	err = WriteType(w, att.AttributeType)
+	err = opts.writeType(w, path, att.AttributeType)
 	if err != nil {
 		return err
 	}
@@ -51,6 +57,13 @@ func WriteAttributeDescription(w io.Writer, att *tfjson.SchemaAttribute, include
 		}
 	}
 
+	if att.WriteOnly {
+		_, err := io.WriteString(w, ", "+writeOnlyMarker)
+		if err != nil {
+			return err
+		}
+	}
+
 	if att.Deprecated {
 		_, err := io.WriteString(w, ", Deprecated")
 		if err != nil {
@@ -11,7 +11,7 @@ import (
 	tfjson "github.com/hashicorp/terraform-json"
 	"github.com/zclconf/go-cty/cty"
 
-	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
 )
 
 func TestWriteAttributeDescription(t *testing.T) {
@@ -99,6 +99,28 @@ func TestWriteAttributeDescription(t *testing.T) {
 			},
 		},
 
+		// write-only
+		{
+			"(String, Optional, [Write-only](https://developer.hashicorp.com/terraform/language/resources/ephemeral#write-only-arguments)) This is an attribute.",
+			&tfjson.SchemaAttribute{
+				AttributeType: cty.String,
+				Optional:      true,
+				WriteOnly:     true,
+				Description:   "This is an attribute.",
+			},
+		},
+		{
+			"(String, Required, Sensitive, [Write-only](https://developer.hashicorp.com/terraform/language/resources/ephemeral#write-only-arguments), Deprecated) This is an attribute.",
+			&tfjson.SchemaAttribute{
+				AttributeType: cty.String,
+				Required:      true,
+				WriteOnly:     true,
+				Description:   "This is an attribute.",
+				Deprecated:    true,
+				Sensitive:     true,
+			},
+		},
+
 		// computed
 		{
 			"(String, Read-only) This is an attribute.",
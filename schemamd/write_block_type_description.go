@@ -12,6 +12,14 @@ import (
 )
 
 func WriteBlockTypeDescription(w io.Writer, block *tfjson.SchemaBlockType) error {
+	return writeBlockTypeDescription(w, block, false)
+}
+
+// writeBlockTypeDescription writes the block description. If includeRW is
+// true, the required or optional characteristic is also written for list, set,
+// and map blocks, as it is for attributes, when blocks are not grouped by
+// characteristic.
+func writeBlockTypeDescription(w io.Writer, block *tfjson.SchemaBlockType, includeRW bool) error {
 	_, err := io.WriteString(w, "(Block")
 	if err != nil {
 		return err
@@ -60,6 +68,21 @@ func WriteBlockTypeDescription(w io.Writer, block *tfjson.SchemaBlockType) error
 			return fmt.Errorf("block does not match any filter states")
 		}
 	} else {
+		if includeRW {
+			switch {
+			case childBlockIsRequired(block):
+				_, err = io.WriteString(w, ", Required")
+				if err != nil {
+					return err
+				}
+			case childBlockIsOptional(block):
+				_, err = io.WriteString(w, ", Optional")
+				if err != nil {
+					return err
+				}
+			}
+		}
+
 		if block.MinItems > 0 {
 			_, err = io.WriteString(w, fmt.Sprintf(", Min: %d", block.MinItems))
 			if err != nil {
@@ -90,6 +90,13 @@ func WriteNestedAttributeTypeDescription(w io.Writer, att *tfjson.SchemaAttribut
 		}
 	}
 
+	if att.WriteOnly {
+		_, err := io.WriteString(w, ", "+writeOnlyMarker)
+		if err != nil {
+			return err
+		}
+	}
+
 	if att.Deprecated {
 		_, err = io.WriteString(w, ", Deprecated")
 		if err != nil {
@@ -11,7 +11,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/zclconf/go-cty/cty"
 
-	"github.com/hashicorp/terraform-plugin-docs/internal/schemamd"
+	"github.com/hashicorp/terraform-plugin-docs/schemamd"
 )
 
 func TestWriteType(t *testing.T) {
@@ -65,3 +65,51 @@ func TestWriteType(t *testing.T) {
 		})
 	}
 }
+
+func TestWriteTerraformType(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []struct {
+		expected string
+		ty       cty.Type
+	}{
+		{"bool", cty.Bool},
+		{"any", cty.DynamicPseudoType},
+		{"number", cty.Number},
+		{"string", cty.String},
+
+		{"list(bool)", cty.List(cty.Bool)},
+		{"list(any)", cty.List(cty.DynamicPseudoType)},
+
+		{"map(bool)", cty.Map(cty.Bool)},
+
+		{"object", cty.EmptyObject},
+		{"object", cty.Object(map[string]cty.Type{
+			"bool": cty.Bool,
+		})},
+
+		{"set(bool)", cty.Set(cty.Bool)},
+
+		{"tuple", cty.EmptyTuple},
+		{"tuple", cty.Tuple([]cty.Type{cty.Bool})},
+
+		{"list(map(set(object)))", cty.List(cty.Map(cty.Set(cty.Object(map[string]cty.Type{
+			"bool": cty.Bool,
+		}))))},
+	} {
+		c := c
+		t.Run(fmt.Sprintf("%s %s", c.ty.FriendlyName(), c.expected), func(t *testing.T) {
+			t.Parallel()
+
+			b := &strings.Builder{}
+			err := schemamd.WriteTerraformType(b, c.ty)
+			if err != nil {
+				t.Fatal(err)
+			}
+			actual := b.String()
+			if diff := cmp.Diff(c.expected, actual); diff != "" {
+				t.Fatalf("Unexpected diff (-wanted, +got): %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tfplugindocs generates the documentation of Terraform providers, as
+// the generate command of the tfplugindocs CLI, for provider tools and tests
+// which embed the generation instead of running the CLI.
+package tfplugindocs
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-docs/internal/provider"
+)
+
+// Logger receives the log messages of the generation.
+type Logger interface {
+	Info(message string)
+	Warn(message string)
+}
+
+// Options are the options of a Generator. The zero value generates the
+// documentation of the provider in the working directory, as the generate
+// command without flags.
+type Options struct {
+	// ProviderDir is the path to the root provider directory. Defaults to the
+	// working directory.
+	ProviderDir string
+
+	// ProviderName is the name of the provider. Defaults to the name of the
+	// provider directory.
+	ProviderName string
+
+	// RenderedProviderName is the name of the provider in the rendered
+	// documentation. Defaults to the ProviderName.
+	RenderedProviderName string
+
+	// ProvidersSchemaPath is the path, or URL, of a providers schema JSON
+	// file, as exported by the terraform providers schema -json command,
+	// which is used instead of building the provider and running Terraform.
+	ProvidersSchemaPath string
+
+	// ProvidersSchemaJSON is the providers schema JSON, which is used instead
+	// of building the provider and running Terraform. It cannot be used with
+	// ProvidersSchemaPath.
+	ProvidersSchemaJSON []byte
+
+	// TemplatesDir is the templates directory, relative to the provider
+	// directory. Defaults to "templates".
+	TemplatesDir string
+
+	// TemplatesFS is the file system which templates are read from instead
+	// of the TemplatesDir, such as an embed.FS or fstest.MapFS, with the
+	// layout of the templates directory at its root.
+	TemplatesFS fs.FS
+
+	// ExamplesDir is the examples directory, relative to the provider
+	// directory. Defaults to "examples".
+	ExamplesDir string
+
+	// RenderedWebsiteDir is the directory which the documentation is written
+	// to, relative to the provider directory. Defaults to "docs".
+	RenderedWebsiteDir string
+
+	// TemplateFuncs are additional template functions. They take precedence
+	// over Sprig functions, but not over the built-in functions.
+	TemplateFuncs template.FuncMap
+
+	// TemplateFuncsPlugin is the path, or name in PATH, of an external
+	// binary which provides additional template functions, as with the
+	// --template-funcs-plugin flag. TemplateFuncs take precedence over its
+	// functions.
+	TemplateFuncsPlugin string
+
+	// PostProcessors rewrite the content of each rendered page, in order,
+	// before it is written.
+	PostProcessors []PostProcessor
+
+	// PostProcessCmd is a command which rewrites the content of each
+	// rendered page after the PostProcessors, as with the --post-process-cmd
+	// flag.
+	PostProcessCmd string
+
+	// PreGenerateCmd and PostGenerateCmd are commands which are run in the
+	// provider directory before the provider schema is read and after the
+	// website is written, as with the --pre-generate-cmd and
+	// --post-generate-cmd flags.
+	PreGenerateCmd  string
+	PostGenerateCmd string
+
+	// Logger receives the log messages of the generation. They are discarded
+	// if nil.
+	Logger Logger
+}
+
+// PostProcessor rewrites the content of a rendered page before it is written,
+// such as to inject snippets or convert admonition syntax. The page is the
+// slash separated path of the page relative to the rendered website
+// directory, such as "resources/example.md". Pages may be rendered, and post
+// processed, concurrently.
+type PostProcessor func(page string, content []byte) ([]byte, error)
+
+// Generator generates the documentation of a Terraform provider.
+type Generator struct {
+	opts Options
+}
+
+// NewGenerator returns a Generator with the given options.
+func NewGenerator(opts Options) *Generator {
+	return &Generator{
+		opts: opts,
+	}
+}
+
+// Generate generates the documentation. When the context is done, such as
+// when its deadline expires, exporting the provider schema, such as building
+// the provider and running Terraform, is stopped, no more files are rendered
+// or written, and the context error is returned.
+func (g *Generator) Generate(ctx context.Context) error {
+	opts := provider.DefaultGenerateOptions()
+
+	opts.ProviderDir = g.opts.ProviderDir
+	opts.ProviderName = g.opts.ProviderName
+	opts.RenderedProviderName = g.opts.RenderedProviderName
+	opts.ProvidersSchemaPath = g.opts.ProvidersSchemaPath
+	opts.ProvidersSchemaJSON = g.opts.ProvidersSchemaJSON
+	opts.TemplatesDir = defaultString(g.opts.TemplatesDir, opts.TemplatesDir)
+	opts.TemplatesFS = g.opts.TemplatesFS
+	opts.ExamplesDir = defaultString(g.opts.ExamplesDir, opts.ExamplesDir)
+	opts.RenderedWebsiteDir = defaultString(g.opts.RenderedWebsiteDir, opts.RenderedWebsiteDir)
+	opts.TemplateFuncs = g.opts.TemplateFuncs
+	opts.TemplateFuncsPlugin = g.opts.TemplateFuncsPlugin
+	opts.PostProcessors = g.postProcessors()
+	opts.PostProcessCmd = g.opts.PostProcessCmd
+	opts.PreGenerateCmd = g.opts.PreGenerateCmd
+	opts.PostGenerateCmd = g.opts.PostGenerateCmd
+
+	return provider.GenerateContext(ctx, &loggerUi{logger: g.opts.Logger}, opts)
+}
+
+func (g *Generator) postProcessors() []provider.PostProcessor {
+	postProcessors := make([]provider.PostProcessor, 0, len(g.opts.PostProcessors))
+	for _, postProcessor := range g.opts.PostProcessors {
+		postProcessors = append(postProcessors, provider.PostProcessor(postProcessor))
+	}
+
+	return postProcessors
+}
+
+func defaultString(value, defaultValue string) string {
+	if value == "" {
+		return defaultValue
+	}
+
+	return value
+}
+
+// loggerUi is the cli.Ui of the generation, which passes messages to the
+// Logger. Output, such as reports, is passed as info messages, and errors as
+// warnings, as they do not stop the generation.
+type loggerUi struct {
+	logger Logger
+}
+
+func (u *loggerUi) Ask(string) (string, error) {
+	return "", errors.New("input is not supported")
+}
+
+func (u *loggerUi) AskSecret(string) (string, error) {
+	return "", errors.New("input is not supported")
+}
+
+func (u *loggerUi) Output(message string) {
+	u.Info(message)
+}
+
+func (u *loggerUi) Info(message string) {
+	if u.logger != nil {
+		u.logger.Info(message)
+	}
+}
+
+func (u *loggerUi) Error(message string) {
+	u.Warn(message)
+}
+
+func (u *loggerUi) Warn(message string) {
+	if u.logger != nil {
+		u.logger.Warn(message)
+	}
+}
@@ -0,0 +1,193 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfplugindocs_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"testing/fstest"
+	"text/template"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-docs/tfplugindocs"
+)
+
+const testProvidersSchema = `{
+  "format_version": "1.0",
+  "provider_schemas": {
+    "registry.terraform.io/hashicorp/scaffolding": {
+      "provider": {
+        "version": 0,
+        "block": {
+          "description_kind": "plain"
+        }
+      },
+      "resource_schemas": {
+        "scaffolding_example": {
+          "version": 0,
+          "block": {
+            "attributes": {
+              "id": {
+                "type": "string",
+                "description": "Example identifier",
+                "description_kind": "markdown",
+                "computed": true
+              }
+            },
+            "description": "Example resource",
+            "description_kind": "markdown"
+          }
+        }
+      }
+    }
+  }
+}`
+
+type testLogger struct {
+	messages []string
+}
+
+func (l *testLogger) Info(message string) {
+	l.messages = append(l.messages, "info: "+message)
+}
+
+func (l *testLogger) Warn(message string) {
+	l.messages = append(l.messages, "warn: "+message)
+}
+
+func TestGenerator_Generate(t *testing.T) {
+	t.Parallel()
+
+	providerDir := t.TempDir()
+	logger := &testLogger{}
+
+	g := tfplugindocs.NewGenerator(tfplugindocs.Options{
+		ProviderDir:         providerDir,
+		ProviderName:        "terraform-provider-scaffolding",
+		ProvidersSchemaJSON: []byte(testProvidersSchema),
+		TemplatesFS: fstest.MapFS{
+			"resources/example.md.tmpl": {
+				Data: []byte("# {{ .Name | shout }}\n\n{{ .Description }}\n"),
+			},
+		},
+		TemplateFuncs: template.FuncMap{
+			"shout": func(s string) string {
+				return strings.ToUpper(s) + "!"
+			},
+		},
+		Logger: logger,
+	})
+
+	err := g.Generate(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual, err := os.ReadFile(filepath.Join(providerDir, "docs", "resources", "example.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "# SCAFFOLDING_EXAMPLE!\n\nExample resource\n"
+
+	if diff := cmp.Diff(expected, string(actual)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if _, err := os.Stat(filepath.Join(providerDir, "docs", "index.md")); err != nil {
+		t.Errorf("expected the provider page to be generated: %s", err)
+	}
+
+	if len(logger.messages) == 0 {
+		t.Errorf("expected log messages")
+	}
+}
+
+func TestGenerator_Generate_ProvidersSchemaConflict(t *testing.T) {
+	t.Parallel()
+
+	g := tfplugindocs.NewGenerator(tfplugindocs.Options{
+		ProviderDir:         t.TempDir(),
+		ProvidersSchemaPath: "schema.json",
+		ProvidersSchemaJSON: []byte(testProvidersSchema),
+	})
+
+	err := g.Generate(context.Background())
+
+	expected := "providers schema JSON cannot be used with a providers schema path or a registry provider"
+	if err == nil || err.Error() != expected {
+		t.Fatalf("expected error %q, got: %v", expected, err)
+	}
+}
+
+func TestGenerator_Generate_Canceled(t *testing.T) {
+	t.Parallel()
+
+	providerDir := t.TempDir()
+
+	g := tfplugindocs.NewGenerator(tfplugindocs.Options{
+		ProviderDir:         providerDir,
+		ProviderName:        "terraform-provider-scaffolding",
+		ProvidersSchemaJSON: []byte(testProvidersSchema),
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err := g.Generate(ctx)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context canceled error, got: %v", err)
+	}
+
+	if _, err := os.Stat(filepath.Join(providerDir, "docs", "resources", "example.md")); !os.IsNotExist(err) {
+		t.Errorf("expected no pages to be rendered, got: %v", err)
+	}
+}
+
+func TestGenerator_Generate_PostProcessors(t *testing.T) {
+	t.Parallel()
+
+	providerDir := t.TempDir()
+
+	g := tfplugindocs.NewGenerator(tfplugindocs.Options{
+		ProviderDir:         providerDir,
+		ProviderName:        "terraform-provider-scaffolding",
+		ProvidersSchemaJSON: []byte(testProvidersSchema),
+		TemplatesFS: fstest.MapFS{
+			"resources/example.md.tmpl": {
+				Data: []byte("# {{ .Name }}\n\n!> {{ .Description }}\n"),
+			},
+		},
+		PostProcessors: []tfplugindocs.PostProcessor{
+			func(page string, content []byte) ([]byte, error) {
+				return bytes.ReplaceAll(content, []byte("!> "), []byte(":::warning ")), nil
+			},
+			func(page string, content []byte) ([]byte, error) {
+				return append(content, []byte("<!-- "+page+" -->\n")...), nil
+			},
+		},
+	})
+
+	err := g.Generate(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	actual, err := os.ReadFile(filepath.Join(providerDir, "docs", "resources", "example.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "# scaffolding_example\n\n:::warning Example resource\n<!-- resources/example.md -->\n"
+
+	if diff := cmp.Diff(expected, string(actual)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}